@@ -13,9 +13,12 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.mondoo.com/cnquery"
 	"go.mondoo.com/cnquery/cli/config"
 	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers"
+	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
 	"go.mondoo.com/cnquery/providers-sdk/v1/upstream"
 	"go.mondoo.com/cnquery/utils/stringx"
 )
@@ -25,6 +28,7 @@ func init() {
 	packBundlesCmd.AddCommand(queryPackInitCmd)
 
 	// bundle lint
+	queryPackLintCmd.Flags().StringP("output", "o", "cli", "Set output format: cli, sarif")
 	packBundlesCmd.AddCommand(queryPackLintCmd)
 
 	// publish
@@ -109,24 +113,73 @@ func validate(queryPackBundle *explorer.Bundle) []string {
 	return errors
 }
 
+// allProvidersSchema combines the schemas of all installed providers, so that
+// bundles can be checked for all the platforms they target
+func allProvidersSchema() llx.Schema {
+	res := &resources.Schema{Resources: map[string]*resources.ResourceInfo{}}
+
+	active, err := providers.ListActive()
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not list providers")
+	}
+
+	for id := range active {
+		schema, err := providers.Coordinator.LoadSchema(id)
+		if err != nil {
+			log.Warn().Err(err).Str("provider", id).Msg("could not load provider schema, skipping it")
+			continue
+		}
+		res.Add(schema)
+	}
+
+	return res
+}
+
 var queryPackLintCmd = &cobra.Command{
 	Use:     "lint [path]",
 	Aliases: []string{"validate"},
-	Short:   "Apply style formatting to a query pack.",
-	Args:    cobra.ExactArgs(1),
+	Short:   "Lint a query pack bundle.",
+	Long: `Lint a query pack bundle.
+
+All queries are compiled against the schemas of all installed providers.
+Besides errors, this reports deprecated resources and fields, comparisons
+that are always false, filters that target other platforms than their
+query pack, and queries that return the default fields of large lists.`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		log.Info().Str("file", args[0]).Msg("validate query pack")
-		queryPackBundle, err := explorer.BundleFromPaths(args[0])
+		output, _ := cmd.Flags().GetString("output")
+		if output != "cli" && output != "sarif" {
+			log.Fatal().Str("output", output).Msg("unsupported output format, use cli or sarif")
+		}
+
+		log.Info().Str("file", args[0]).Msg("lint query pack")
+		results, err := explorer.Lint(allProvidersSchema(), args[0])
 		if err != nil {
 			log.Fatal().Err(err).Msg("could not load query pack")
 		}
 
-		errors := validate(queryPackBundle)
-		if len(errors) > 0 {
-			log.Error().Msg("could not validate query pack")
-			for i := range errors {
-				fmt.Fprintf(os.Stderr, stringx.Indent(2, errors[i]))
+		if output == "sarif" {
+			data, err := results.ToSarif(cnquery.GetVersion())
+			if err != nil {
+				log.Fatal().Err(err).Msg("could not generate SARIF report")
 			}
+			fmt.Println(string(data))
+		} else {
+			for i := range results.Entries {
+				entry := results.Entries[i]
+				pos := entry.File
+				if entry.Line > 0 {
+					pos += ":" + strconv.Itoa(entry.Line)
+					if entry.Column > 0 {
+						pos += ":" + strconv.Itoa(entry.Column)
+					}
+				}
+				fmt.Fprintln(os.Stderr, pos+": "+entry.Level+": "+entry.Message+" ("+entry.RuleID+")")
+			}
+		}
+
+		if results.HasErrors() {
+			log.Error().Msg("could not validate query pack")
 			os.Exit(1)
 		}
 
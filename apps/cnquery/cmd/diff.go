@@ -0,0 +1,57 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"go.mondoo.com/cnquery/cli/reporter"
+)
+
+func init() {
+	diffCmd.Flags().StringP("output", "o", "compact", "Set output format: compact, summary, full, json, yaml")
+	rootCmd.AddCommand(diffCmd)
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff OLD NEW",
+	Short: "Compare two JSON reports.",
+	Long: `Compare two reports that were written by "cnquery scan --output json".
+
+Assets are matched by their MRN and queries by their MRN or checksum. The diff
+lists assets that were added or removed, queries that fail now, and the values
+of all queries whose data changed.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		old, err := readJSONReport(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("file", args[0]).Msg("failed to read report")
+		}
+		cur, err := readJSONReport(args[1])
+		if err != nil {
+			log.Fatal().Err(err).Str("file", args[1]).Msg("failed to read report")
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		r, err := reporter.New(output)
+		if err != nil {
+			log.Fatal().Msg(err.Error())
+		}
+
+		diff := reporter.DiffReports(old, cur)
+		if err := r.PrintDiff(diff, os.Stdout); err != nil {
+			log.Fatal().Err(err).Msg("failed to print diff")
+		}
+	},
+}
+
+func readJSONReport(path string) (*reporter.JSONReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return reporter.ParseJSONReport(data)
+}
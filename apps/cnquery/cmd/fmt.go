@@ -0,0 +1,137 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/mqlc/parser"
+)
+
+func init() {
+	fmtCmd.Flags().Bool("check", false, "Don't write files, only list the ones that aren't formatted")
+	rootCmd.AddCommand(fmtCmd)
+}
+
+var fmtCmd = &cobra.Command{
+	Use:   "fmt [path...]",
+	Short: "Format MQL files and query packs.",
+	Long: `Format MQL files (.mql) and query packs (.mql.yaml) in their canonical style.
+
+Files are rewritten in place. Directories are searched for MQL files and
+query packs. Without any paths, MQL is read from stdin and the formatted
+result is printed to stdout.
+
+In query packs, fields are sorted in a fixed order and the MQL of all
+queries, props, filters, and functions is formatted.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		check, _ := cmd.Flags().GetBool("check")
+
+		if len(args) == 0 {
+			data, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to read from stdin")
+			}
+			res, err := formatFile("", data)
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to format MQL")
+			}
+			fmt.Print(string(res))
+			return
+		}
+
+		filenames, err := mqlFiles(args)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to find files")
+		}
+
+		unformatted := false
+		for _, filename := range filenames {
+			data, err := os.ReadFile(filename)
+			if err != nil {
+				log.Fatal().Err(err).Str("file", filename).Msg("failed to read file")
+			}
+
+			res, err := formatFile(filename, data)
+			if err != nil {
+				log.Fatal().Err(err).Str("file", filename).Msg("failed to format file")
+			}
+			if bytes.Equal(data, res) {
+				continue
+			}
+
+			unformatted = true
+			if check {
+				fmt.Println(filename)
+				continue
+			}
+			if err := os.WriteFile(filename, res, 0o644); err != nil {
+				log.Fatal().Err(err).Str("file", filename).Msg("failed to write file")
+			}
+		}
+
+		if check && unformatted {
+			os.Exit(1)
+		}
+	},
+}
+
+func isBundleFile(filename string) bool {
+	return strings.HasSuffix(filename, ".yaml") || strings.HasSuffix(filename, ".yml")
+}
+
+// formatFile formats a query pack or an MQL file
+func formatFile(filename string, data []byte) ([]byte, error) {
+	if isBundleFile(filename) {
+		return explorer.FormatBundle(data)
+	}
+
+	res, err := parser.Format(string(data))
+	if err != nil {
+		return nil, err
+	}
+	if res == "" {
+		return []byte{}, nil
+	}
+	return []byte(res + "\n"), nil
+}
+
+// mqlFiles finds all MQL files and query packs in the paths
+func mqlFiles(paths []string) ([]string, error) {
+	res := []string{}
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			res = append(res, path)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			name := d.Name()
+			if !d.IsDir() && (strings.HasSuffix(name, ".mql") || strings.HasSuffix(name, ".mql.yaml") || strings.HasSuffix(name, ".mql.yml")) {
+				res = append(res, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,35 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/cli/lsp"
+)
+
+func init() {
+	rootCmd.AddCommand(lspCmd)
+}
+
+var lspCmd = &cobra.Command{
+	Use:   "lsp",
+	Short: "Run a language server for MQL.",
+	Long: `Run a language server for MQL, which communicates via stdio.
+
+Editors can use it for query packs (.mql.yaml) and MQL files (.mql). It
+offers completion, hover docs for resources and fields, go-to-definition
+for props, and diagnostics from the compiler. All MQL is compiled against
+the schemas of all installed providers.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		server := lsp.NewServer(allProvidersSchema(), cnquery.DefaultFeatures)
+		if err := server.Serve(os.Stdin, os.Stdout); err != nil {
+			log.Fatal().Err(err).Msg("language server failed")
+		}
+	},
+}
@@ -157,6 +157,13 @@ func (c *cnqueryPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Ru
 			sh.Close()
 		}()
 
+		if conf.Explain != "" {
+			if err := sh.Explain(conf.Command, conf.Explain); err != nil {
+				return errors.Wrap(err, "failed to run")
+			}
+			continue
+		}
+
 		code, results, err := sh.RunOnce(conf.Command)
 		if err != nil {
 			return errors.Wrap(err, "failed to run")
@@ -0,0 +1,157 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cmd
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"go.mondoo.com/cnquery/providers"
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{recordingMergeCmd, recordingFilterCmd, recordingRedactCmd} {
+		cmd.Flags().StringP("output", "o", "", "Path of the new recording")
+		cmd.MarkFlagRequired("output")
+		cmd.Flags().Bool("pretty", false, "Pretty-print JSON")
+		recordingCmd.AddCommand(cmd)
+	}
+
+	recordingFilterCmd.Flags().StringSlice("asset", nil, "Keep assets by their ID, name, or platform ID")
+	recordingFilterCmd.Flags().StringSlice("resource", nil, "Keep resources by their name, e.g. file or aws.*")
+
+	recordingRedactCmd.Flags().String("rules", "", "Path to a YAML file with redaction rules")
+	recordingRedactCmd.Flags().StringSlice("field", nil, "Redact all values of a field, e.g. file.content or *.env")
+	recordingRedactCmd.Flags().StringSlice("regex", nil, "Redact all matches of a regular expression in all values")
+	recordingRedactCmd.Flags().String("replacement", providers.DefaultRedaction, "Replacement for values that are redacted via --field or --regex")
+
+	rootCmd.AddCommand(recordingCmd)
+}
+
+var recordingCmd = &cobra.Command{
+	Use:   "recording",
+	Short: "Merge, filter, and redact recordings.",
+	Long: `Work with recordings that were created via --record.
+
+Recordings contain all data that was collected from an asset, which may include
+secrets from file contents, command output, or environment variables. Use
+"cnquery recording redact" to scrub them before you share a recording.`,
+}
+
+var recordingMergeCmd = &cobra.Command{
+	Use:   "merge RECORDING RECORDING...",
+	Short: "Combine several recordings into one.",
+	Long: `Combine several recordings into one. Assets that are in multiple recordings
+are merged, with later recordings taking precedence for data that was
+recorded more than once.`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		res, err := providers.LoadRecordingFile(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("file", args[0]).Msg("failed to load recording")
+		}
+		for _, path := range args[1:] {
+			other, err := providers.LoadRecordingFile(path)
+			if err != nil {
+				log.Fatal().Err(err).Str("file", path).Msg("failed to load recording")
+			}
+			res.Merge(other)
+		}
+		saveRecording(cmd, res)
+	},
+}
+
+var recordingFilterCmd = &cobra.Command{
+	Use:   "filter RECORDING",
+	Short: "Keep only some assets and resources of a recording.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		assets, _ := cmd.Flags().GetStringSlice("asset")
+		resources, _ := cmd.Flags().GetStringSlice("resource")
+		if len(assets) == 0 && len(resources) == 0 {
+			log.Fatal().Msg("please provide --asset or --resource to filter the recording")
+		}
+
+		res, err := providers.LoadRecordingFile(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("file", args[0]).Msg("failed to load recording")
+		}
+		err = res.Filter(providers.RecordingFilter{
+			Assets:    assets,
+			Resources: resources,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to filter recording")
+		}
+		saveRecording(cmd, res)
+	},
+}
+
+var recordingRedactCmd = &cobra.Command{
+	Use:   "redact RECORDING",
+	Short: "Scrub sensitive values from a recording.",
+	Long: `Scrub sensitive values from a recording before you share it.
+
+Rules either redact entire fields or all matches of a regular expression:
+
+  rules:
+    - field: file.content
+    - field: "*.env"
+    - regex: "AKIA[0-9A-Z]{16}"
+      replacement: "<aws-access-key>"
+    - field: command.stdout
+      regex: "password=\\S+"
+
+The same rules can be applied while recording via --record-redact.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		rules, err := redactionRulesFromFlags(cmd)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load redaction rules")
+		}
+		if len(rules.Rules) == 0 {
+			log.Fatal().Msg("please provide --rules, --field, or --regex to redact the recording")
+		}
+
+		res, err := providers.LoadRecordingFile(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("file", args[0]).Msg("failed to load recording")
+		}
+		res.Redact(rules)
+		saveRecording(cmd, res)
+	},
+}
+
+func redactionRulesFromFlags(cmd *cobra.Command) (*providers.RedactionRules, error) {
+	rules := &providers.RedactionRules{}
+	if path, _ := cmd.Flags().GetString("rules"); path != "" {
+		var err error
+		rules, err = providers.LoadRedactionRules(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	replacement, _ := cmd.Flags().GetString("replacement")
+	fields, _ := cmd.Flags().GetStringSlice("field")
+	for _, field := range fields {
+		rules.Rules = append(rules.Rules, &providers.RedactionRule{Field: field, Replacement: replacement})
+	}
+	regexes, _ := cmd.Flags().GetStringSlice("regex")
+	for _, regex := range regexes {
+		rules.Rules = append(rules.Rules, &providers.RedactionRule{Regex: regex, Replacement: replacement})
+	}
+
+	return rules, rules.Compile()
+}
+
+func saveRecording(cmd *cobra.Command, rec interface {
+	SaveAs(path string, prettyPrintJSON bool) error
+},
+) {
+	output, _ := cmd.Flags().GetString("output")
+	pretty, _ := cmd.Flags().GetBool("pretty")
+	if err := rec.SaveAs(output, pretty); err != nil {
+		log.Fatal().Err(err).Msg("failed to save recording")
+	}
+}
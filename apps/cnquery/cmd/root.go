@@ -32,6 +32,11 @@ const (
 	ConfigurationErrorCode = 78
 )
 
+// exitCode is set by commands that succeed but still need to signal a
+// failure, e.g. scans with unreachable assets. We exit with it only after
+// the command returned, so providers get shut down properly.
+var exitCode int
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "cnquery",
@@ -62,6 +67,11 @@ func Execute() {
 			Run:     scanCmdRun,
 			Action:  "Scan ",
 		},
+		&providers.Command{
+			Command: sbomCmd,
+			Run:     sbomCmdRun,
+			Action:  "Generate an SBOM for ",
+		},
 	)
 	if err != nil {
 		log.Error().Msg(err.Error())
@@ -72,6 +82,10 @@ func Execute() {
 		log.Error().Msg(err.Error())
 		os.Exit(1)
 	}
+
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
 }
 
 func init() {
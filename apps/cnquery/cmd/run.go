@@ -24,6 +24,8 @@ func init() {
 	RunCmd.Flags().Bool("ast", false, "Parse the query and return the abstract syntax tree (AST).")
 	RunCmd.Flags().BoolP("json", "j", false, "Run the query and return the object in a JSON structure.")
 	RunCmd.Flags().String("platform-id", "", "Select a specific target asset by providing its platform ID.")
+	RunCmd.Flags().String("explain", "", "Explain how the query is executed, with its providers, timings, and cache hits (text, dot).")
+	RunCmd.Flags().Lookup("explain").NoOptDefVal = "text"
 }
 
 var RunCmd = &cobra.Command{
@@ -45,6 +47,13 @@ var RunCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *plu
 		conf.Format = "json"
 	}
 	conf.PlatformId, _ = cmd.Flags().GetString("platform-id")
+	conf.Explain, _ = cmd.Flags().GetString("explain")
+	if conf.Explain != "" && conf.Explain != "text" && conf.Explain != "dot" {
+		log.Fatal().Str("explain", conf.Explain).Msg("unsupported explain format, please use text or dot")
+	}
+	if conf.Explain != "" && conf.Format == "json" {
+		log.Fatal().Msg("cannot combine --explain with --json")
+	}
 	conf.Inventory = &inventory.Inventory{
 		Spec: &inventory.InventorySpec{
 			Assets: []*inventory.Asset{cliRes.Asset},
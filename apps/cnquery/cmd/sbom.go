@@ -0,0 +1,175 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cmd
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.mondoo.com/cnquery/cli/config"
+	"go.mondoo.com/cnquery/providers"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers-sdk/v1/upstream"
+	"go.mondoo.com/cnquery/sbom"
+)
+
+func init() {
+	rootCmd.AddCommand(sbomCmd)
+
+	sbomCmd.Flags().StringP("output", "o", sbom.FormatCycloneDxJson, "Set output format: "+sbom.AllFormats())
+	sbomCmd.Flags().String("output-target", "", "Write the SBOM to this file instead of stdout. Required for multiple assets, which get one file each with the asset name added to the file name.")
+	sbomCmd.Flags().String("platform-id", "", "Select a specific target asset by providing its platform ID.")
+}
+
+var sbomCmd = &cobra.Command{
+	Use:   "sbom",
+	Short: "Generate a software bill of materials (SBOM).",
+	Long: `Generate a software bill of materials (SBOM) for an asset.
+
+The SBOM includes all OS packages as well as Python and npm packages. It is
+written as CycloneDX 1.5 JSON or SPDX 2.3 JSON.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		viper.BindPFlag("platform-id", cmd.Flags().Lookup("platform-id"))
+		viper.BindPFlag("sbom-output", cmd.Flags().Lookup("output"))
+		viper.BindPFlag("sbom-output-target", cmd.Flags().Lookup("output-target"))
+	},
+}
+
+var sbomCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *plugin.ParseCLIRes) {
+	format := viper.GetString("sbom-output")
+	if format != sbom.FormatCycloneDxJson && format != sbom.FormatSpdxJson {
+		log.Fatal().Str("output", format).Msg("unsupported SBOM format, please use one of: " + sbom.AllFormats())
+	}
+	target := viper.GetString("sbom-output-target")
+
+	opts, err := config.Read()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load config")
+	}
+	config.DisplayUsedConfig()
+
+	var upstreamConfig *upstream.UpstreamConfig
+	if serviceAccount := opts.GetServiceCredential(); serviceAccount != nil {
+		upstreamConfig = &upstream.UpstreamConfig{
+			SpaceMrn:    opts.GetParentMrn(),
+			ApiEndpoint: opts.UpstreamApiEndpoint(),
+			Incognito:   true,
+			Creds:       serviceAccount,
+		}
+	}
+
+	err = runtime.Connect(&plugin.ConnectReq{
+		Features: config.Features,
+		Asset:    cliRes.Asset,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not connect to asset")
+	}
+
+	assets, err := providers.ProcessAssetCandidates(runtime, runtime.Provider.Connection, upstreamConfig, viper.GetString("platform-id"))
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not process assets")
+	}
+	if len(assets) == 0 {
+		log.Fatal().Msg("could not find an asset that we can connect to")
+	}
+	if len(assets) > 1 && target == "" {
+		// multiple documents on stdout would not be valid JSON
+		log.Fatal().Int("assets", len(assets)).Msg("found multiple assets, please use --output-target to write one SBOM file per asset or --platform-id to select one asset")
+	}
+
+	targets := sbomTargets(target, assets)
+	for i := range assets {
+		asset := assets[i]
+		res, err := collectSbom(asset, upstreamConfig)
+		if err != nil {
+			log.Fatal().Err(err).Str("asset", asset.Name).Msg("failed to generate SBOM")
+		}
+
+		if err := writeSbom(res, format, targets[i]); err != nil {
+			log.Fatal().Err(err).Str("asset", asset.Name).Msg("failed to write SBOM")
+		}
+	}
+}
+
+// collectSbom connects to an asset and collects all of its packages
+func collectSbom(asset *inventory.Asset, upstreamConfig *upstream.UpstreamConfig) (*sbom.Sbom, error) {
+	runtime := providers.Coordinator.NewRuntime()
+	defer runtime.Close()
+
+	if err := runtime.DetectProvider(asset); err != nil {
+		return nil, err
+	}
+	err := runtime.Connect(&plugin.ConnectReq{
+		Features: config.Features,
+		Asset:    asset,
+		Upstream: upstreamConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the connection fills in the asset's platform
+	return sbom.Collect(runtime, runtime.Provider.Connection.Asset, config.Features)
+}
+
+func writeSbom(res *sbom.Sbom, format string, target string) error {
+	var out io.Writer = os.Stdout
+	if target != "" {
+		f, err := os.Create(target)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if err := res.Write(format, out); err != nil {
+		return err
+	}
+	if target != "" {
+		log.Info().Str("file", target).Int("packages", len(res.Packages)).Msg("wrote SBOM")
+	}
+	return nil
+}
+
+var invalidFilenameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// sbomTargets returns the files the assets' SBOMs are written to. Every asset
+// gets its own file when we write SBOMs for multiple assets, and assets with
+// the same name are numbered so they don't overwrite each other.
+func sbomTargets(target string, assets []*inventory.Asset) []string {
+	res := make([]string, len(assets))
+	if target == "" || len(assets) < 2 {
+		for i := range res {
+			res[i] = target
+		}
+		return res
+	}
+
+	ext := filepath.Ext(target)
+	base := strings.TrimSuffix(target, ext)
+	used := map[string]struct{}{}
+	for i := range assets {
+		name := strings.Trim(invalidFilenameChars.ReplaceAllString(assets[i].Name, "-"), "-")
+		file := base + "-" + name + ext
+		for n := 2; ; n++ {
+			if _, ok := used[file]; !ok {
+				break
+			}
+			file = base + "-" + name + "-" + strconv.Itoa(n) + ext
+		}
+		used[file] = struct{}{}
+		res[i] = file
+	}
+	return res
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
@@ -31,6 +33,7 @@ func init() {
 
 	scanCmd.Flags().StringP("output", "o", "compact", "Set output format: "+reporter.AllFormats())
 	scanCmd.Flags().BoolP("json", "j", false, "Run the query and return the object in a JSON structure.")
+	scanCmd.Flags().String("output-target", "", "Write the report to this file instead of stdout.")
 	scanCmd.Flags().String("platform-id", "", "Select a specific target asset by providing its platform ID.")
 
 	scanCmd.Flags().String("inventory-file", "", "Set the path to the inventory file.")
@@ -48,6 +51,9 @@ func init() {
 	scanCmd.Flags().String("asset-name", "", "User-override for the asset name")
 	scanCmd.Flags().StringToString("annotation", nil, "Add an annotation to the asset.") // user-added, editable
 	scanCmd.Flags().StringToString("props", nil, "Custom values for properties")
+	scanCmd.Flags().Int("parallel", 1, "Set the number of assets to connect to and scan in parallel.")
+	scanCmd.Flags().Duration("query-timeout", 0, "Cancel queries that run longer than this, e.g. 30s. Queries may set their own timeout. (default no timeout)")
+	scanCmd.Flags().Bool("profile-queries", false, "Print the most expensive queries and fields after the scan. JSON and YAML reports include the full profile.")
 
 	// v6 should make detect-cicd and category flag public
 	scanCmd.Flags().Bool("detect-cicd", true, "Try to detect CI/CD environments. If detected, set the asset category to 'cicd'.")
@@ -94,8 +100,12 @@ To manually configure a query pack, use this:
 		viper.BindPFlag("querypacks", cmd.Flags().Lookup("querypack"))
 		viper.BindPFlag("sudo.active", cmd.Flags().Lookup("sudo"))
 		viper.BindPFlag("record", cmd.Flags().Lookup("record"))
+		viper.BindPFlag("parallel", cmd.Flags().Lookup("parallel"))
+		viper.BindPFlag("query-timeout", cmd.Flags().Lookup("query-timeout"))
+		viper.BindPFlag("profile-queries", cmd.Flags().Lookup("profile-queries"))
 
 		viper.BindPFlag("output", cmd.Flags().Lookup("output"))
+		viper.BindPFlag("output-target", cmd.Flags().Lookup("output-target"))
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) == 0 {
@@ -110,6 +120,9 @@ var scanCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *pl
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to prepare config")
 	}
+	if conf.out != os.Stdout {
+		defer conf.out.Close()
+	}
 
 	err = conf.loadBundles()
 	if err != nil {
@@ -122,6 +135,11 @@ var scanCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *pl
 	}
 
 	printReports(report, conf, cmd)
+
+	// partial failures, e.g. hosts we couldn't connect to, should fail CI runs
+	if report != nil && len(report.FailedAssets()) > 0 {
+		exitCode = 1
+	}
 }
 
 // helper method to retrieve the list of query packs for autocomplete
@@ -142,7 +160,14 @@ type scanConfig struct {
 	QueryPackNames []string
 	Props          map[string]string
 	Bundle         *explorer.Bundle
+	Parallel       int
+	QueryTimeout   time.Duration
+	ProfileQueries bool
 	runtime        *providers.Runtime
+	// out is where reports are written to, stdout unless users set a file
+	out *os.File
+	// stream receives asset results while the scan is running
+	stream scan.Reporter
 
 	IsIncognito bool
 }
@@ -171,6 +196,9 @@ func getCobraScanConfig(cmd *cobra.Command, runtime *providers.Runtime, cliRes *
 		QueryPackPaths: viper.GetStringSlice("querypack-bundle"),
 		QueryPackNames: viper.GetStringSlice("querypacks"),
 		Props:          props,
+		Parallel:       viper.GetInt("parallel"),
+		QueryTimeout:   viper.GetDuration("query-timeout"),
+		ProfileQueries: viper.GetBool("profile-queries"),
 		runtime:        runtime,
 	}
 
@@ -188,6 +216,17 @@ func getCobraScanConfig(cmd *cobra.Command, runtime *providers.Runtime, cliRes *
 	}
 	conf.Output = output
 
+	conf.out = os.Stdout
+	if target := viper.GetString("output-target"); target != "" {
+		conf.out, err = os.Create(target)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create output target")
+		}
+	}
+	if strings.EqualFold(output, "ndjson") {
+		conf.stream = reporter.NewNdjsonStream(conf.out)
+	}
+
 	// detect CI/CD runs and read labels from runtime and apply them to all assets in the inventory
 	runtimeEnv := execruntime.Detect()
 	if opts.AutoDetectCICDCategory && runtimeEnv.IsAutomatedEnv() || opts.Category == "cicd" {
@@ -263,13 +302,22 @@ func (c *scanConfig) loadBundles() error {
 }
 
 func RunScan(config *scanConfig) (*explorer.ReportCollection, error) {
-	opts := []scan.ScannerOption{}
+	opts := []scan.ScannerOption{
+		scan.WithParallel(config.Parallel),
+		scan.WithQueryTimeout(config.QueryTimeout),
+	}
 	if config.runtime.UpstreamConfig != nil {
 		opts = append(opts, scan.WithUpstream(config.runtime.UpstreamConfig))
 	}
 	if config.runtime.Recording != nil {
 		opts = append(opts, scan.WithRecording(config.runtime.Recording))
 	}
+	if config.stream != nil {
+		opts = append(opts, scan.WithStreamReporter(config.stream))
+	}
+	if config.ProfileQueries {
+		opts = append(opts, scan.WithProfiling())
+	}
 
 	scanner := scan.NewLocalScanner(opts...)
 	ctx := cnquery.SetFeatures(context.Background(), config.Features)
@@ -303,7 +351,21 @@ func printReports(report *explorer.ReportCollection, conf *scanConfig, cmd *cobr
 
 	r.IsIncognito = conf.IsIncognito
 
-	if err = r.Print(report, os.Stdout); err != nil {
-		log.Fatal().Err(err).Msg("failed to print")
+	// streamed results have already been printed while scanning
+	if conf.stream == nil {
+		if err = r.Print(report, conf.out); err != nil {
+			log.Fatal().Err(err).Msg("failed to print")
+		}
+	}
+
+	if conf.ProfileQueries {
+		// keep machine-readable output parseable
+		out := conf.out
+		if r.Format != reporter.Compact && r.Format != reporter.Summary && r.Format != reporter.Full {
+			out = os.Stderr
+		}
+		if err = r.PrintProfiles(report, out, reporter.DefaultProfileLimit); err != nil {
+			log.Fatal().Err(err).Msg("failed to print profiles")
+		}
 	}
 }
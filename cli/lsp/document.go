@@ -0,0 +1,390 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package lsp
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/types"
+	"gopkg.in/yaml.v3"
+)
+
+// document is an open file in the editor. It is either a plain MQL file or
+// a query pack bundle, which has MQL embedded in its queries, props, and filters.
+type document struct {
+	uri      string
+	lines    []string
+	snippets []*snippet
+	// problems with the document itself, e.g. invalid YAML
+	diagnostics []Diagnostic
+	// functions of the query pack that is parsed, if any
+	functions *explorer.PackFunctions
+}
+
+// snippet is a piece of MQL within a document
+type snippet struct {
+	mql string
+	// position of the snippet's first character in the document
+	line int
+	col  int
+	// column at which all following lines of the snippet start
+	indent int
+	// properties this snippet can use, by name
+	props map[string]*prop
+	// functions of the query pack that this snippet can call
+	functions *explorer.PackFunctions
+}
+
+// prop is a property that is defined in a bundle
+type prop struct {
+	name string
+	// location of the property's uid
+	location Range
+	snippet  *snippet
+	// type is set once the property is compiled
+	typ types.Type
+}
+
+func isBundle(uri string) bool {
+	return strings.HasSuffix(uri, ".yaml") || strings.HasSuffix(uri, ".yml")
+}
+
+func parseDocument(uri string, text string) *document {
+	doc := &document{
+		uri:   uri,
+		lines: strings.Split(text, "\n"),
+	}
+
+	if !isBundle(uri) {
+		doc.snippets = []*snippet{{mql: text}}
+		return doc
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(text), &root); err != nil {
+		doc.diagnostics = append(doc.diagnostics, Diagnostic{
+			Range:    doc.lineRange(yamlErrorLine(err)),
+			Severity: severityError,
+			Source:   "cnquery",
+			Message:  err.Error(),
+		})
+		return doc
+	}
+	if len(root.Content) == 0 {
+		return doc
+	}
+
+	bundle := root.Content[0]
+	props := doc.addProps(explorer.YamlValue(bundle, "props"), nil)
+	for _, query := range explorer.YamlItems(explorer.YamlValue(bundle, "queries")) {
+		doc.addQuery(query, props)
+	}
+
+	for _, pack := range explorer.YamlItems(explorer.YamlValue(bundle, "packs")) {
+		doc.functions = doc.addFunctions(explorer.YamlValue(pack, "functions"))
+		doc.addFilters(explorer.YamlValue(pack, "filters"))
+		packProps := doc.addProps(explorer.YamlValue(pack, "props"), props)
+		for _, query := range explorer.YamlItems(explorer.YamlValue(pack, "queries")) {
+			doc.addQuery(query, packProps)
+		}
+		for _, group := range explorer.YamlItems(explorer.YamlValue(pack, "groups")) {
+			doc.addFilters(explorer.YamlValue(group, "filters"))
+			for _, query := range explorer.YamlItems(explorer.YamlValue(group, "queries")) {
+				doc.addQuery(query, packProps)
+			}
+		}
+		doc.functions = nil
+	}
+
+	return doc
+}
+
+var yamlErrorLineRegex = regexp.MustCompile(`line (\d+)`)
+
+// yamlErrorLine returns the zero-based line of a YAML parser error
+func yamlErrorLine(err error) int {
+	m := yamlErrorLineRegex.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0
+	}
+	line, _ := strconv.Atoi(m[1])
+	if line > 0 {
+		line--
+	}
+	return line
+}
+
+func (d *document) addQuery(query *yaml.Node, props map[string]*prop) {
+	d.addFilters(explorer.YamlValue(query, "filters"))
+	props = d.addProps(explorer.YamlValue(query, "props"), props)
+	if mql := explorer.YamlValue(query, "mql"); mql != nil {
+		d.addSnippet(mql, props)
+	}
+}
+
+// addFunctions parses the functions of a query pack, which all of its
+// MQL is linked to before it is compiled
+func (d *document) addFunctions(node *yaml.Node) *explorer.PackFunctions {
+	items := explorer.YamlItems(node)
+	if len(items) == 0 {
+		return nil
+	}
+
+	pack := &explorer.QueryPack{}
+	for _, item := range items {
+		pack.Functions = append(pack.Functions, item.Value)
+	}
+	res, err := explorer.NewPackFunctions(pack)
+	if err != nil {
+		d.diagnostics = append(d.diagnostics, Diagnostic{
+			Range:    d.lineRange(node.Line - 1),
+			Severity: severityError,
+			Source:   "cnquery",
+			Message:  err.Error(),
+		})
+		return nil
+	}
+	return res
+}
+
+// addProps adds all properties of a node and returns them together with
+// the parent's properties, which they override
+func (d *document) addProps(node *yaml.Node, parent map[string]*prop) map[string]*prop {
+	items := explorer.YamlItems(node)
+	if len(items) == 0 {
+		return parent
+	}
+
+	res := make(map[string]*prop, len(parent)+len(items))
+	for k, v := range parent {
+		res[k] = v
+	}
+
+	for _, item := range items {
+		uid := explorer.YamlValue(item, "uid")
+		if uid == nil || uid.Value == "" {
+			continue
+		}
+		p := &prop{
+			name:     uid.Value,
+			location: d.nodeRange(uid),
+		}
+		if mql := explorer.YamlValue(item, "mql"); mql != nil {
+			p.snippet = d.addSnippet(mql, nil)
+		}
+		res[p.name] = p
+	}
+	return res
+}
+
+func (d *document) addFilters(node *yaml.Node) {
+	if node == nil {
+		return
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		d.addSnippet(node, nil)
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if item.Kind == yaml.ScalarNode {
+				d.addSnippet(item, nil)
+			} else if mql := explorer.YamlValue(item, "mql"); mql != nil {
+				d.addSnippet(mql, nil)
+			}
+		}
+	}
+}
+
+func (d *document) addSnippet(node *yaml.Node, props map[string]*prop) *snippet {
+	if node.Kind != yaml.ScalarNode {
+		return nil
+	}
+
+	res := &snippet{props: props, functions: d.functions}
+
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+		res.mql = node.Value
+		res.line = node.Line - 1
+		res.col = node.Column - 1
+		if node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0 {
+			res.col++
+		}
+		res.indent = res.col
+		d.snippets = append(d.snippets, res)
+		return res
+	}
+
+	// The content of block scalars starts in the line after the indicator.
+	// We take it from the document, so that all positions map 1:1.
+	res.line = node.Line
+	res.indent = -1
+	minIndent := keyIndent(d.lines[node.Line-1]) + 1
+	var lines []string
+	for i := res.line; i < len(d.lines); i++ {
+		line := d.lines[i]
+		if strings.TrimSpace(line) == "" {
+			lines = append(lines, "")
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		if res.indent == -1 {
+			if indent < minIndent {
+				break
+			}
+			res.indent = indent
+		}
+		if indent < res.indent {
+			break
+		}
+		lines = append(lines, line[res.indent:])
+	}
+	if res.indent == -1 {
+		res.indent = minIndent
+	}
+	res.col = res.indent
+
+	for len(lines) != 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	res.mql = strings.Join(lines, "\n")
+	d.snippets = append(d.snippets, res)
+	return res
+}
+
+// keyIndent is the column of the key in a line of YAML, e.g. 4 for `  - mql: |`
+func keyIndent(line string) int {
+	res := 0
+	for res < len(line) && (line[res] == ' ' || line[res] == '-') {
+		res++
+	}
+	return res
+}
+
+// snippetAt finds the snippet at a position and returns the offset of the
+// position within the snippet's MQL
+func (d *document) snippetAt(pos Position) (*snippet, int, bool) {
+	for _, s := range d.snippets {
+		if offset, ok := s.offset(pos); ok {
+			return s, offset, true
+		}
+	}
+	return nil, 0, false
+}
+
+func (d *document) nodeRange(node *yaml.Node) Range {
+	start := Position{Line: node.Line - 1, Character: node.Column - 1}
+	return Range{
+		Start: start,
+		End:   Position{Line: start.Line, Character: start.Character + len(node.Value)},
+	}
+}
+
+func (d *document) lineRange(line int) Range {
+	res := Range{Start: Position{Line: line}, End: Position{Line: line}}
+	if line < len(d.lines) {
+		res.End.Character = len(d.lines[line])
+	}
+	return res
+}
+
+// Clients count characters in UTF-16 code units, while documents are
+// indexed by bytes. Positions are converted when they are sent or received.
+
+// bytePosition converts a position from the client to a byte position
+func (d *document) bytePosition(pos Position) Position {
+	if pos.Line < 0 || pos.Line >= len(d.lines) {
+		return pos
+	}
+
+	line := d.lines[pos.Line]
+	units := 0
+	for i, r := range line {
+		if units >= pos.Character {
+			return Position{Line: pos.Line, Character: i}
+		}
+		units += utf16Len(r)
+	}
+	return Position{Line: pos.Line, Character: len(line)}
+}
+
+// clientPosition converts a byte position to a position for the client
+func (d *document) clientPosition(pos Position) Position {
+	if pos.Line < 0 || pos.Line >= len(d.lines) {
+		return pos
+	}
+
+	line := d.lines[pos.Line]
+	if pos.Character > len(line) {
+		pos.Character = len(line)
+	}
+	units := 0
+	for _, r := range line[:pos.Character] {
+		units += utf16Len(r)
+	}
+	return Position{Line: pos.Line, Character: units}
+}
+
+func (d *document) clientRange(r Range) Range {
+	return Range{Start: d.clientPosition(r.Start), End: d.clientPosition(r.End)}
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// offset converts a position in the document to an offset in the MQL
+func (s *snippet) offset(pos Position) (int, bool) {
+	lines := strings.Split(s.mql, "\n")
+	idx := pos.Line - s.line
+	if idx < 0 || idx >= len(lines) {
+		return 0, false
+	}
+
+	col := pos.Character - s.indent
+	if idx == 0 {
+		col = pos.Character - s.col
+	}
+	if col < 0 || col > len(lines[idx]) {
+		return 0, false
+	}
+
+	offset := col
+	for i := 0; i < idx; i++ {
+		offset += len(lines[i]) + 1
+	}
+	return offset, true
+}
+
+// link adds the pack functions that the MQL calls and returns the number
+// of lines that were added in front of it
+func (s *snippet) link(mql string) (string, int) {
+	if s.functions == nil {
+		return mql, 0
+	}
+	res := s.functions.Link(mql)
+	return res, strings.Count(res[:len(res)-len(mql)], "\n")
+}
+
+// position converts an offset in the MQL to a position in the document
+func (s *snippet) position(offset int) Position {
+	if offset > len(s.mql) {
+		offset = len(s.mql)
+	}
+	before := s.mql[:offset]
+	line := strings.Count(before, "\n")
+	if line == 0 {
+		return Position{Line: s.line, Character: s.col + offset}
+	}
+	return Position{
+		Line:      s.line + line,
+		Character: s.indent + len(before) - strings.LastIndexByte(before, '\n') - 1,
+	}
+}
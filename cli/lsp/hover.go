@@ -0,0 +1,153 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package lsp
+
+import (
+	"strings"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
+	"go.mondoo.com/cnquery/types"
+)
+
+// functions on lists whose arguments are evaluated on every element
+var listFunctions = map[string]struct{}{
+	"where": {}, "all": {}, "any": {}, "one": {}, "none": {}, "contains": {},
+	"map": {}, "sort": {}, "groupBy": {}, "min": {}, "max": {}, "sum": {}, "avg": {},
+}
+
+func isIdentChar(b byte) bool {
+	return b == '_' || b == '.' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+// chainAt returns the chain of identifiers at the offset, e.g. `sshd.config`
+// when the offset is on `config` in `sshd.config.params`. It also returns
+// the offsets where the chain starts and where the identifier ends.
+func chainAt(mql string, offset int) (string, int, int) {
+	start := offset
+	for start > 0 && isIdentChar(mql[start-1]) {
+		start--
+	}
+	end := offset
+	for end < len(mql) && isIdentChar(mql[end]) && mql[end] != '.' {
+		end++
+	}
+	return strings.Trim(mql[start:end], "."), start, end
+}
+
+// contextType finds the type that a chain at this offset is evaluated on,
+// e.g. in `users { name }` the chain `name` is evaluated on each user.
+// Returns Nil at the top level.
+func contextType(schema llx.Schema, mql string, offset int) types.Type {
+	depth := 0
+	for i := offset - 1; i >= 0; i-- {
+		switch mql[i] {
+		case '}', ')', ']':
+			depth++
+		case '[':
+			depth--
+		case '{', '(':
+			if depth > 0 {
+				depth--
+				continue
+			}
+
+			isCall := mql[i] == '('
+			end := i
+			for end > 0 && mql[end-1] == ' ' {
+				end--
+			}
+			chain, start, _ := chainAt(mql, end)
+			if chain == "" {
+				return types.Nil
+			}
+
+			if isCall {
+				idx := strings.LastIndexByte(chain, '.')
+				if idx == -1 {
+					return types.Nil
+				}
+				if _, ok := listFunctions[chain[idx+1:]]; !ok {
+					return types.Nil
+				}
+				chain = chain[:idx]
+			}
+
+			typ, _, _ := resolveChain(schema, contextType(schema, mql, start), chain)
+			if typ.IsArray() {
+				return typ.Child()
+			}
+			// list resources, like packages, are evaluated on their elements
+			if typ.IsResource() {
+				if info := schema.Lookup(typ.ResourceName()); info != nil && info.ListType != "" {
+					return types.Type(info.ListType)
+				}
+			}
+			return typ
+		}
+	}
+	return types.Nil
+}
+
+// resolveChain looks up the type of a chain of identifiers and the docs of
+// the resource or field it ends in
+func resolveChain(schema llx.Schema, ctx types.Type, chain string) (types.Type, *resources.ResourceInfo, *resources.Field) {
+	segments := strings.Split(chain, ".")
+	typ := ctx
+
+	// at the top level chains start with a resource, e.g. sshd.config
+	var info *resources.ResourceInfo
+	if typ == types.Nil {
+		for i := len(segments); i > 0; i-- {
+			if info = schema.Lookup(strings.Join(segments[:i], ".")); info != nil {
+				typ = types.Resource(info.Name)
+				segments = segments[i:]
+				break
+			}
+		}
+		if info == nil {
+			return types.Nil, nil, nil
+		}
+	}
+
+	var field *resources.Field
+	for _, segment := range segments {
+		if !typ.IsResource() {
+			return types.Nil, nil, nil
+		}
+		info, field = schema.LookupField(typ.ResourceName(), segment)
+		if field == nil {
+			return types.Nil, nil, nil
+		}
+		typ = types.Type(field.Type)
+	}
+
+	if field == nil && typ.IsResource() {
+		info = schema.Lookup(typ.ResourceName())
+	}
+	return typ, info, field
+}
+
+// hoverDocs renders the docs of a resource or a field
+func hoverDocs(chain string, typ types.Type, info *resources.ResourceInfo, field *resources.Field) string {
+	var res strings.Builder
+	res.WriteString("**" + chain + "**")
+
+	title, desc := "", ""
+	if field != nil {
+		res.WriteString(" `" + typ.Label() + "`")
+		title, desc = field.Title, field.Desc
+	} else if info != nil {
+		res.WriteString(" resource")
+		title, desc = info.Title, info.Desc
+	}
+
+	if title != "" {
+		res.WriteString("\n\n" + title)
+	}
+	if desc != "" {
+		res.WriteString("\n\n" + desc)
+	}
+	return res.String()
+}
@@ -0,0 +1,399 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package lsp implements a language server for MQL. It supports plain MQL
+// files and query pack bundles, which embed MQL in YAML.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/textproto"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/types"
+)
+
+// Server is a language server that communicates via JSON-RPC
+type Server struct {
+	schema   llx.Schema
+	features cnquery.Features
+	docs     map[string]*document
+	out      io.Writer
+}
+
+// NewServer creates a language server, which compiles MQL with the schema
+func NewServer(schema llx.Schema, features cnquery.Features) *Server {
+	return &Server{
+		schema:   schema,
+		features: features,
+		docs:     map[string]*document{},
+	}
+}
+
+// Serve handles all requests from the reader until the client exits
+func (s *Server) Serve(in io.Reader, out io.Writer) error {
+	s.out = out
+	reader := bufio.NewReader(in)
+
+	for {
+		data, err := readMessage(reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var msg message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			s.respond(nil, nil, &responseError{Code: codeParseError, Message: err.Error()})
+			continue
+		}
+
+		if msg.Method == "exit" {
+			return nil
+		}
+
+		result, rerr := s.handle(&msg)
+		// notifications don't receive a response
+		if msg.ID != nil {
+			s.respond(msg.ID, result, rerr)
+		}
+	}
+}
+
+// readMessage reads a message, which has headers like HTTP and the
+// JSON-RPC payload as its body
+func readMessage(reader *bufio.Reader) ([]byte, error) {
+	headers, err := textproto.NewReader(reader).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := strconv.Atoi(headers.Get("Content-Length"))
+	if err != nil {
+		return nil, errors.New("invalid Content-Length header")
+	}
+
+	data := make([]byte, length)
+	_, err = io.ReadFull(reader, data)
+	return data, err
+}
+
+func (s *Server) write(msg *message) {
+	msg.JSONRPC = "2.0"
+	data, err := json.Marshal(msg)
+	if err != nil {
+		log.Error().Err(err).Msg("lsp> failed to encode message")
+		return
+	}
+
+	if _, err := io.WriteString(s.out, "Content-Length: "+strconv.Itoa(len(data))+"\r\n\r\n"); err != nil {
+		log.Error().Err(err).Msg("lsp> failed to write message")
+		return
+	}
+	if _, err := s.out.Write(data); err != nil {
+		log.Error().Err(err).Msg("lsp> failed to write message")
+	}
+}
+
+func (s *Server) respond(id *json.RawMessage, result interface{}, rerr *responseError) {
+	msg := &message{ID: id, Error: rerr}
+	if msg.ID == nil {
+		null := json.RawMessage("null")
+		msg.ID = &null
+	}
+	if rerr == nil {
+		data, err := json.Marshal(result)
+		if err != nil {
+			msg.Error = &responseError{Code: codeInvalidParams, Message: err.Error()}
+		} else {
+			msg.Result = data
+		}
+	}
+	s.write(msg)
+}
+
+func (s *Server) notify(method string, params interface{}) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		log.Error().Err(err).Msg("lsp> failed to encode notification")
+		return
+	}
+	s.write(&message{Method: method, Params: data})
+}
+
+func (s *Server) handle(msg *message) (interface{}, *responseError) {
+	switch msg.Method {
+	case "initialize":
+		return initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync:   1,
+				CompletionProvider: completionOptions{TriggerCharacters: []string{"."}},
+				HoverProvider:      true,
+				DefinitionProvider: true,
+			},
+			ServerInfo: serverInfo{Name: "cnquery", Version: cnquery.GetVersion()},
+		}, nil
+
+	case "shutdown":
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		s.update(params.TextDocument.URI, params.TextDocument.Text)
+		return nil, nil
+
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		// we only support full syncs, so the last change has the entire text
+		if n := len(params.ContentChanges); n != 0 {
+			s.update(params.TextDocument.URI, params.ContentChanges[n-1].Text)
+		}
+		return nil, nil
+
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		delete(s.docs, params.TextDocument.URI)
+		s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		})
+		return nil, nil
+
+	case "textDocument/completion", "textDocument/hover", "textDocument/definition":
+		var params positionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		doc, ok := s.docs[params.TextDocument.URI]
+		if !ok {
+			return nil, nil
+		}
+
+		pos := doc.bytePosition(params.Position)
+		switch msg.Method {
+		case "textDocument/completion":
+			return s.completion(doc, pos), nil
+		case "textDocument/hover":
+			hover := s.hover(doc, pos)
+			if hover != nil && hover.Range != nil {
+				r := doc.clientRange(*hover.Range)
+				hover.Range = &r
+			}
+			return hover, nil
+		default:
+			locations := s.definition(doc, pos)
+			for i := range locations {
+				locations[i].Range = doc.clientRange(locations[i].Range)
+			}
+			return locations, nil
+		}
+
+	default:
+		if msg.ID == nil {
+			// unknown notifications are ignored
+			return nil, nil
+		}
+		return nil, &responseError{Code: codeMethodNotFound, Message: "method not supported: " + msg.Method}
+	}
+}
+
+func (s *Server) update(uri string, text string) {
+	doc := parseDocument(uri, text)
+	s.docs[uri] = doc
+	diagnostics := s.diagnostics(doc)
+	for i := range diagnostics {
+		diagnostics[i].Range = doc.clientRange(diagnostics[i].Range)
+	}
+	s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diagnostics,
+	})
+}
+
+func (s *Server) compile(mql string, props map[string]*prop) (*llx.CodeBundle, error) {
+	var compilerProps map[string]*llx.Primitive
+	if len(props) != 0 {
+		compilerProps = make(map[string]*llx.Primitive, len(props))
+		for name, p := range props {
+			compilerProps[name] = &llx.Primitive{Type: string(s.propType(p))}
+		}
+	}
+	return mqlc.Compile(mql, compilerProps, mqlc.NewConfig(s.schema, s.features))
+}
+
+// propType compiles a property to find its type
+func (s *Server) propType(p *prop) types.Type {
+	if p.typ != "" {
+		return p.typ
+	}
+
+	p.typ = types.Any
+	if p.snippet == nil {
+		return p.typ
+	}
+
+	mql, _ := p.snippet.link(p.snippet.mql)
+	bundle, err := s.compile(mql, nil)
+	if err != nil {
+		return p.typ
+	}
+	if entrypoints := bundle.CodeV2.Entrypoints(); len(entrypoints) == 1 {
+		p.typ = bundle.CodeV2.Chunk(entrypoints[0]).DereferencedTypeV2(bundle.CodeV2)
+	}
+	return p.typ
+}
+
+var errorPositionRegex = regexp.MustCompile(`at <source>:(\d+):(\d+)`)
+
+func (s *Server) diagnostics(doc *document) []Diagnostic {
+	res := append([]Diagnostic{}, doc.diagnostics...)
+
+	for _, snippet := range doc.snippets {
+		if strings.TrimSpace(snippet.mql) == "" {
+			continue
+		}
+
+		mql, added := snippet.link(snippet.mql)
+		_, err := s.compile(mql, snippet.props)
+		if err == nil {
+			continue
+		}
+
+		// parser errors know where they happened, other errors and errors
+		// in linked functions are reported on the entire first line
+		offset, end := 0, strings.IndexByte(snippet.mql, '\n')
+		if end == -1 {
+			end = len(snippet.mql)
+		}
+		if m := errorPositionRegex.FindStringSubmatch(err.Error()); m != nil {
+			line, _ := strconv.Atoi(m[1])
+			col, _ := strconv.Atoi(m[2])
+			if line -= added; line > 0 {
+				offset = lineOffset(snippet.mql, line-1) + col - 1
+				if offset > len(snippet.mql) {
+					offset = len(snippet.mql)
+				}
+				end = strings.IndexByte(snippet.mql[offset:], '\n')
+				if end == -1 {
+					end = len(snippet.mql)
+				} else {
+					end += offset
+				}
+			}
+		}
+
+		res = append(res, Diagnostic{
+			Range:    Range{Start: snippet.position(offset), End: snippet.position(end)},
+			Severity: severityError,
+			Source:   "cnquery",
+			Message:  err.Error(),
+		})
+	}
+
+	return res
+}
+
+// lineOffset is the offset of the line in the text
+func lineOffset(text string, line int) int {
+	offset := 0
+	for i := 0; i < line; i++ {
+		idx := strings.IndexByte(text[offset:], '\n')
+		if idx == -1 {
+			return len(text)
+		}
+		offset += idx + 1
+	}
+	return offset
+}
+
+func (s *Server) completion(doc *document, pos Position) []CompletionItem {
+	snippet, offset, ok := doc.snippetAt(pos)
+	if !ok {
+		return []CompletionItem{}
+	}
+
+	mql, _ := snippet.link(snippet.mql[:offset])
+	bundle, _ := s.compile(mql, snippet.props)
+	if bundle == nil {
+		return []CompletionItem{}
+	}
+
+	res := make([]CompletionItem, len(bundle.Suggestions))
+	for i := range bundle.Suggestions {
+		cur := bundle.Suggestions[i]
+		kind := completionKindField
+		if s.schema.Lookup(cur.Field) != nil {
+			kind = completionKindClass
+		}
+		res[i] = CompletionItem{
+			Label:  cur.Field,
+			Kind:   kind,
+			Detail: cur.Title,
+		}
+		if cur.Desc != "" {
+			res[i].Documentation = &MarkupContent{Kind: "markdown", Value: cur.Desc}
+		}
+	}
+	return res
+}
+
+func (s *Server) hover(doc *document, pos Position) *Hover {
+	snippet, offset, ok := doc.snippetAt(pos)
+	if !ok {
+		return nil
+	}
+
+	chain, start, end := chainAt(snippet.mql, offset)
+	if chain == "" {
+		return nil
+	}
+
+	typ, info, field := resolveChain(s.schema, contextType(s.schema, snippet.mql, start), chain)
+	if info == nil && field == nil {
+		return nil
+	}
+
+	return &Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: hoverDocs(chain, typ, info, field)},
+		Range:    &Range{Start: snippet.position(start), End: snippet.position(end)},
+	}
+}
+
+func (s *Server) definition(doc *document, pos Position) []Location {
+	snippet, offset, ok := doc.snippetAt(pos)
+	if !ok {
+		return []Location{}
+	}
+
+	chain, _, _ := chainAt(snippet.mql, offset)
+	name := strings.TrimPrefix(chain, "props.")
+	if name == chain || name == "" {
+		return []Location{}
+	}
+
+	p, ok := snippet.props[strings.SplitN(name, ".", 2)[0]]
+	if !ok {
+		return []Location{}
+	}
+	return []Location{{URI: doc.uri, Range: p.location}}
+}
@@ -0,0 +1,230 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/providers"
+)
+
+const testBundle = `packs:
+  - uid: example
+    name: Example
+    props:
+      - uid: minNameLength
+        mql: 3
+    queries:
+      - uid: name
+        title: Asset name
+        mql: |
+          asset.name.length > props.minNameLength
+          asset { platform }
+      - uid: broken
+        title: Broken
+        mql: asset.nope
+`
+
+func newTestServer() *Server {
+	return NewServer(providers.DefaultRuntime().Schema(), cnquery.DefaultFeatures)
+}
+
+func TestDocument_Snippets(t *testing.T) {
+	doc := parseDocument("file:///example.mql.yaml", testBundle)
+	require.Len(t, doc.snippets, 3)
+
+	prop := doc.snippets[0]
+	assert.Equal(t, "3", prop.mql)
+	assert.Equal(t, Position{Line: 5, Character: 13}, prop.position(0))
+
+	query := doc.snippets[1]
+	assert.Equal(t, "asset.name.length > props.minNameLength\nasset { platform }", query.mql)
+	assert.Equal(t, Position{Line: 10, Character: 10}, query.position(0))
+	assert.Equal(t, Position{Line: 11, Character: 18}, query.position(48))
+	offset, ok := query.offset(Position{Line: 11, Character: 18})
+	require.True(t, ok)
+	assert.Equal(t, 48, offset)
+	_, ok = query.offset(Position{Line: 11, Character: 2})
+	assert.False(t, ok)
+
+	require.Contains(t, query.props, "minNameLength")
+	assert.Equal(t, Range{
+		Start: Position{Line: 4, Character: 13},
+		End:   Position{Line: 4, Character: 26},
+	}, query.props["minNameLength"].location)
+
+	t.Run("plain MQL", func(t *testing.T) {
+		doc := parseDocument("file:///example.mql", "asset.name")
+		require.Len(t, doc.snippets, 1)
+		assert.Equal(t, "asset.name", doc.snippets[0].mql)
+	})
+
+	t.Run("invalid YAML", func(t *testing.T) {
+		doc := parseDocument("file:///broken.mql.yaml", "packs:\n  name: a: b\n")
+		require.Len(t, doc.diagnostics, 1)
+		assert.Equal(t, 1, doc.diagnostics[0].Range.Start.Line)
+	})
+}
+
+func TestServer_Features(t *testing.T) {
+	s := newTestServer()
+	s.out = &bytes.Buffer{}
+	uri := "file:///example.mql.yaml"
+	s.update(uri, testBundle)
+	doc := s.docs[uri]
+
+	t.Run("diagnostics", func(t *testing.T) {
+		diagnostics := s.diagnostics(doc)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, "cannot find field 'nope' in asset", diagnostics[0].Message)
+		assert.Equal(t, Range{
+			Start: Position{Line: 14, Character: 13},
+			End:   Position{Line: 14, Character: 23},
+		}, diagnostics[0].Range)
+	})
+
+	t.Run("completion", func(t *testing.T) {
+		items := s.completion(doc, Position{Line: 10, Character: 16})
+		labels := make([]string, len(items))
+		for i := range items {
+			labels[i] = items[i].Label
+		}
+		assert.Contains(t, labels, "name")
+		assert.Contains(t, labels, "platform")
+
+		assert.Empty(t, s.completion(doc, Position{Line: 0, Character: 2}))
+	})
+
+	t.Run("hover", func(t *testing.T) {
+		hover := s.hover(doc, Position{Line: 10, Character: 17})
+		require.NotNil(t, hover)
+		assert.Contains(t, hover.Contents.Value, "**asset.name** `string`")
+		assert.Equal(t, &Range{
+			Start: Position{Line: 10, Character: 10},
+			End:   Position{Line: 10, Character: 20},
+		}, hover.Range)
+
+		// fields in blocks are looked up on the block's resource
+		hover = s.hover(doc, Position{Line: 11, Character: 20})
+		require.NotNil(t, hover)
+		assert.Contains(t, hover.Contents.Value, "**platform** `string`")
+
+		hover = s.hover(doc, Position{Line: 10, Character: 11})
+		require.NotNil(t, hover)
+		assert.Contains(t, hover.Contents.Value, "**asset** resource")
+
+		assert.Nil(t, s.hover(doc, Position{Line: 10, Character: 28}))
+	})
+
+	t.Run("definition", func(t *testing.T) {
+		locations := s.definition(doc, Position{Line: 10, Character: 40})
+		require.Len(t, locations, 1)
+		assert.Equal(t, Location{URI: uri, Range: Range{
+			Start: Position{Line: 4, Character: 13},
+			End:   Position{Line: 4, Character: 26},
+		}}, locations[0])
+
+		assert.Empty(t, s.definition(doc, Position{Line: 10, Character: 13}))
+	})
+}
+
+func TestServer_Functions(t *testing.T) {
+	s := newTestServer()
+	doc := parseDocument("file:///functions.mql.yaml", `packs:
+  - uid: functions
+    name: Functions
+    functions:
+      - |
+        def isRoot(name: string) { name == "root" }
+    filters: isRoot(asset.name)
+    queries:
+      - uid: root
+        title: Root
+        mql: |
+          isRoot(asset.name)
+          asset.nope
+`)
+	require.Empty(t, doc.diagnostics)
+
+	diagnostics := s.diagnostics(doc)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "cannot find field 'nope' in asset", diagnostics[0].Message)
+	assert.Equal(t, 11, diagnostics[0].Range.Start.Line)
+
+	t.Run("invalid functions", func(t *testing.T) {
+		doc := parseDocument("file:///functions.mql.yaml", `packs:
+  - uid: functions
+    functions:
+      - asset.name
+`)
+		require.Len(t, doc.diagnostics, 1)
+		assert.Equal(t, 3, doc.diagnostics[0].Range.Start.Line)
+	})
+}
+
+func TestDocument_Positions(t *testing.T) {
+	doc := parseDocument("file:///a.mql", "\"ü😀\" + asset.name")
+
+	// ü is 2 bytes and 1 UTF-16 unit, 😀 is 4 bytes and 2 UTF-16 units
+	assert.Equal(t, Position{Character: 10}, doc.bytePosition(Position{Character: 7}))
+	assert.Equal(t, Position{Character: 7}, doc.clientPosition(Position{Character: 10}))
+	assert.Equal(t, Position{Character: 21}, doc.bytePosition(Position{Character: 99}))
+	assert.Equal(t, Position{Line: 3, Character: 1}, doc.bytePosition(Position{Line: 3, Character: 1}))
+}
+
+func writeTestMessage(buf *bytes.Buffer, msg string) {
+	buf.WriteString("Content-Length: " + strconv.Itoa(len(msg)) + "\r\n\r\n" + msg)
+}
+
+func TestServer_Serve(t *testing.T) {
+	in := &bytes.Buffer{}
+	writeTestMessage(in, `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`)
+	writeTestMessage(in, `{"jsonrpc":"2.0","method":"initialized","params":{}}`)
+	writeTestMessage(in, `{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///a.mql","languageId":"mql","version":1,"text":"asset.nope"}}}`)
+	writeTestMessage(in, `{"jsonrpc":"2.0","id":2,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///a.mql"},"position":{"line":0,"character":2}}}`)
+	writeTestMessage(in, `{"jsonrpc":"2.0","id":3,"method":"unknown/method","params":{}}`)
+	writeTestMessage(in, `{"jsonrpc":"2.0","id":4,"method":"shutdown"}`)
+	writeTestMessage(in, `{"jsonrpc":"2.0","method":"exit"}`)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, newTestServer().Serve(in, out))
+
+	var messages []message
+	reader := bufio.NewReader(out)
+	for {
+		data, err := readMessage(reader)
+		if err != nil {
+			break
+		}
+		var msg message
+		require.NoError(t, json.Unmarshal(data, &msg))
+		messages = append(messages, msg)
+	}
+	require.Len(t, messages, 5)
+
+	var init initializeResult
+	require.NoError(t, json.Unmarshal(messages[0].Result, &init))
+	assert.True(t, init.Capabilities.HoverProvider)
+
+	assert.Equal(t, "textDocument/publishDiagnostics", messages[1].Method)
+	var diagnostics publishDiagnosticsParams
+	require.NoError(t, json.Unmarshal(messages[1].Params, &diagnostics))
+	require.Len(t, diagnostics.Diagnostics, 1)
+
+	var hover Hover
+	require.NoError(t, json.Unmarshal(messages[2].Result, &hover))
+	assert.Contains(t, hover.Contents.Value, "**asset** resource")
+
+	require.NotNil(t, messages[3].Error)
+	assert.Equal(t, codeMethodNotFound, messages[3].Error.Code)
+
+	assert.Equal(t, json.RawMessage("null"), messages[4].Result)
+}
@@ -0,0 +1,220 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package printer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+)
+
+// ProviderLookup returns the name of the provider that handles a resource,
+// or the resource's field if it is set. Builtin functions have no provider.
+type ProviderLookup func(resource string, field string) string
+
+// chunkProvider finds the provider for a chunk, i.e. the resource it creates
+// or the resource field it calls. Returns the provider and what it handles.
+func chunkProvider(code *llx.CodeV2, chunk *llx.Chunk, lookup ProviderLookup) (string, string) {
+	if lookup == nil || chunk.Call != llx.Chunk_FUNCTION {
+		return "", ""
+	}
+
+	if chunk.Function == nil || chunk.Function.Binding == 0 {
+		if provider := lookup(chunk.Id, ""); provider != "" {
+			return provider, chunk.Id
+		}
+		return "", ""
+	}
+
+	bound := code.Chunk(chunk.Function.Binding)
+	if bound == nil {
+		return "", ""
+	}
+	typ := bound.DereferencedTypeV2(code)
+	if typ == types.Any {
+		// global resources have no function and are only typed by their ID
+		typ = bound.Type()
+	}
+	if !typ.IsResource() {
+		return "", ""
+	}
+	resource := typ.ResourceName()
+	if provider := lookup(resource, chunk.Id); provider != "" {
+		return provider, resource + "." + chunk.Id
+	}
+	return "", ""
+}
+
+func formatDuration(d time.Duration) string {
+	switch {
+	case d < time.Microsecond:
+		return d.String()
+	case d < time.Millisecond:
+		return d.Round(time.Microsecond).String()
+	default:
+		return d.Round(10 * time.Microsecond).String()
+	}
+}
+
+func chunkStatsLabel(stats llx.ChunkStats) string {
+	res := "calls: " + strconv.Itoa(stats.Calls)
+	if stats.CacheHits != 0 {
+		res += ", cache hits: " + strconv.Itoa(stats.CacheHits)
+	}
+	return res + ", time: " + formatDuration(stats.Duration)
+}
+
+func refLabel(ref uint64) string {
+	return "<" + strconv.Itoa(int(ref>>32)) + "," + strconv.Itoa(int(ref&0xFFFFFFFF)) + ">"
+}
+
+func refsLabel(refs []uint64) string {
+	labels := make([]string, len(refs))
+	for i := range refs {
+		labels[i] = refLabel(refs[i])
+	}
+	return "[" + strings.Join(labels, " ") + "]"
+}
+
+// Explain renders the compiled code with all its blocks and chunks. Every
+// chunk that calls a provider is annotated with it. If stats are provided,
+// chunks also show how often they were called, their cache hits, and
+// how long they took.
+func (print *Printer) Explain(bundle *llx.CodeBundle, lookup ProviderLookup, stats *llx.ExecutionStats) string {
+	var res strings.Builder
+	code := bundle.CodeV2
+
+	for i := range code.Blocks {
+		block := code.Blocks[i]
+		blockRef := uint64(i+1) << 32
+
+		res.WriteString(print.Secondary(fmt.Sprintf("-> block %d\n", i+1)))
+		res.WriteString("   entrypoints: " + refsLabel(block.Entrypoints) + "\n")
+		if len(block.Datapoints) != 0 {
+			res.WriteString("   datapoints: " + refsLabel(block.Datapoints) + "\n")
+		}
+
+		for j := range block.Chunks {
+			chunk := block.Chunks[j]
+			ref := blockRef | uint64(j+1)
+
+			var line strings.Builder
+			print.chunkV2(j, chunk, block, bundle, "   ", &line)
+			res.WriteString("   " + strings.TrimRight(line.String(), " \n"))
+
+			if provider, target := chunkProvider(code, chunk, lookup); provider != "" {
+				res.WriteString(print.Yellow("  provider: " + provider + " (" + target + ")"))
+			}
+
+			// parameters are filled in by the caller and never executed
+			if stats != nil && j >= int(block.Parameters) {
+				if cur, ok := stats.Chunk(ref); ok {
+					res.WriteString(print.Secondary("  " + chunkStatsLabel(cur)))
+				} else {
+					res.WriteString(print.Disabled("  not executed"))
+				}
+			}
+			res.WriteString("\n")
+		}
+	}
+
+	return res.String()
+}
+
+// dotID is the ID of a chunk in a DOT graph
+func dotID(ref uint64) string {
+	return "\"" + strconv.Itoa(int(ref>>32)) + "." + strconv.Itoa(int(ref&0xFFFFFFFF)) + "\""
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n").Replace(s)
+}
+
+// ExplainDot renders the compiled code as Graphviz DOT graph. Every block is
+// a cluster of its chunks. Solid edges connect chunks with the chunks they are
+// bound to, dashed edges connect arguments, and dotted edges lead to blocks
+// that a chunk calls. Entrypoints are drawn with a double border.
+func ExplainDot(bundle *llx.CodeBundle, lookup ProviderLookup, stats *llx.ExecutionStats) string {
+	var res strings.Builder
+	code := bundle.CodeV2
+
+	res.WriteString("digraph mql {\n")
+	res.WriteString("  compound=true;\n")
+	res.WriteString("  node [shape=box, fontname=\"monospace\"];\n")
+
+	var edges []string
+	for i := range code.Blocks {
+		block := code.Blocks[i]
+		blockRef := uint64(i+1) << 32
+
+		entrypoints := map[uint64]struct{}{}
+		for _, ref := range block.Entrypoints {
+			entrypoints[ref] = struct{}{}
+		}
+
+		res.WriteString("  subgraph cluster_" + strconv.Itoa(i+1) + " {\n")
+		res.WriteString("    label=\"block " + strconv.Itoa(i+1) + "\";\n")
+
+		for j := range block.Chunks {
+			chunk := block.Chunks[j]
+			ref := blockRef | uint64(j+1)
+
+			label := strconv.Itoa(j+1) + ": "
+			if chunk.Id != "" {
+				label += chunk.Id
+			} else if j < int(block.Parameters) {
+				label += "context (" + types.Type(chunk.Primitive.Type).Label() + ")"
+			} else if chunk.Primitive != nil {
+				label += PlainNoColorPrinter.Primitive(chunk.Primitive, code.Id, bundle, "")
+			}
+			if chunk.Function != nil {
+				label += "\ntype: " + types.Type(chunk.Function.Type).Label()
+			}
+			if provider, target := chunkProvider(code, chunk, lookup); provider != "" {
+				label += "\nprovider: " + provider + " (" + target + ")"
+			}
+			if cur, ok := stats.Chunk(ref); ok {
+				label += "\n" + chunkStatsLabel(cur)
+			}
+
+			attrs := "label=\"" + dotEscape(label) + "\""
+			if _, ok := entrypoints[ref]; ok {
+				attrs += ", peripheries=2"
+			}
+			res.WriteString("    " + dotID(ref) + " [" + attrs + "];\n")
+
+			if chunk.Function == nil {
+				continue
+			}
+			if chunk.Function.Binding != 0 {
+				edges = append(edges, dotID(chunk.Function.Binding)+" -> "+dotID(ref)+";")
+			}
+			for _, arg := range chunk.Function.Args {
+				argRef, ok := arg.RefV2()
+				if !ok {
+					continue
+				}
+				if types.Type(arg.Type).Underlying() == types.FunctionLike {
+					// functions point to their block, we connect its first chunk
+					edges = append(edges, dotID(ref)+" -> "+dotID(argRef|1)+
+						" [style=dotted, lhead=cluster_"+strconv.Itoa(int(argRef>>32))+"];")
+				} else {
+					edges = append(edges, dotID(argRef)+" -> "+dotID(ref)+" [style=dashed];")
+				}
+			}
+		}
+
+		res.WriteString("  }\n")
+	}
+
+	for i := range edges {
+		res.WriteString("  " + edges[i] + "\n")
+	}
+	res.WriteString("}\n")
+	return res.String()
+}
@@ -562,6 +562,16 @@ func (print *Printer) Data(typ types.Type, data interface{}, codeID string, bund
 			return print.Secondary("null")
 		}
 		return print.Secondary(fmt.Sprintf("/%s/", data))
+	case types.Version:
+		if data == nil {
+			return print.Secondary("null")
+		}
+		return print.Secondary(data.(llx.Version).Value)
+	case types.IP:
+		if data == nil {
+			return print.Secondary("null")
+		}
+		return print.Secondary(data.(llx.IP).String())
 	case types.Time:
 		if data == nil {
 			return print.Secondary("null")
@@ -58,8 +58,10 @@ var PlainNoColorPrinter = Printer{
 	Secondary: fmt.Sprint,
 	Error:     fmt.Sprint,
 	Warn:      fmt.Sprint,
+	Yellow:    fmt.Sprint,
 	Disabled:  fmt.Sprint,
 	Failed:    fmt.Sprint,
+	Success:   fmt.Sprint,
 }
 
 // H1 prints a headline
@@ -448,32 +448,26 @@ func (m *modelMultiProgress) updateOverallProgress() {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	sumPercent := 0.0
-	validAssets := 0
-	erroredAssets := 0
-	notApplicableAssets := 0
+	assets := 0
 	for k := range m.Progress {
 		if k == overallProgressIndexName {
 			continue
 		}
+		assets++
 
+		// Errored and not applicable assets won't make any more progress.
+		// They count as done, so that the overall progress doesn't jump
+		// backwards when assets are scanned in parallel.
 		switch m.Progress[k].ProgressState {
-		case ProgressStateErrored:
-			erroredAssets++
-			continue
-		case ProgressStateNotApplicable:
-			notApplicableAssets++
+		case ProgressStateErrored, ProgressStateNotApplicable:
+			sumPercent += 1.0
 			continue
 		}
 
 		sumPercent += m.Progress[k].percent
-		validAssets++
-	}
-	if validAssets > 0 {
-		overallPercent = math.Floor((sumPercent/float64(validAssets))*100) / 100
 	}
-	_, ok := m.Progress[overallProgressIndexName]
-	if ok && erroredAssets+notApplicableAssets == len(m.Progress)-1 {
-		overallPercent = 1.0
+	if assets > 0 {
+		overallPercent = math.Floor((sumPercent/float64(assets))*100) / 100
 	}
 	m.Progress[overallProgressIndexName].percent = overallPercent
 
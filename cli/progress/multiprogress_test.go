@@ -123,6 +123,28 @@ func TestMultiProgressBarErrored(t *testing.T) {
 	assert.Contains(t, buf.String(), "2/3 scanned 1/3 errored         ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━ 100%")
 }
 
+func TestMultiProgressBarErroredWhileOthersRun(t *testing.T) {
+	var in bytes.Buffer
+	var buf bytes.Buffer
+
+	progressBarElements := map[string]string{"1": "test1", "2": "test2", "3": "test3"}
+	multiprogress, err := newMultiProgressBarsMock(progressBarElements, []string{"1", "2", "3"}, &in, &buf)
+	require.NoError(t, err)
+
+	go func() {
+		// we need to wait for tea to start the Program, otherwise these would be no-ops
+		time.Sleep(1 * time.Millisecond)
+		multiprogress.OnProgress("1", 0.5)
+		multiprogress.OnProgress("3", 0.5)
+		multiprogress.Errored("2")
+		multiprogress.Close()
+	}()
+	err = multiprogress.Open()
+	require.NoError(t, err)
+	// the errored asset counts as done, so the overall progress doesn't drop
+	assert.Contains(t, buf.String(), "0/3 scanned 1/3 errored         ━━━━━━━━━━━━━━━━━━━━━━━────────────  66%")
+}
+
 func TestMultiProgressBarLastErrored(t *testing.T) {
 	var in bytes.Buffer
 	var buf bytes.Buffer
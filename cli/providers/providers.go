@@ -174,6 +174,11 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 			Type: plugin.FlagType_String,
 			Desc: "Record all resource calls and use resources in the recording",
 		},
+		{
+			Long: "record-redact",
+			Type: plugin.FlagType_String,
+			Desc: "Apply the redaction rules in this file to the recording",
+		},
 		{
 			Long: "use-recording",
 			Type: plugin.FlagType_String,
@@ -244,12 +249,17 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 		if err != nil {
 			log.Warn().Msg("failed to get flag --pretty")
 		}
+		recordRedact, err := cc.Flags().GetString("record-redact")
+		if err != nil {
+			log.Warn().Msg("failed to get flag --record-redact")
+		}
 
 		// the following flags are not processed by the provider; we handle them
 		// here instead
 		skipFlags := map[string]struct{}{
 			"ask-pass":      {},
 			"record":        {},
+			"record-redact": {},
 			"use-recording": {},
 		}
 
@@ -308,9 +318,21 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 			recordingPath = useRecording
 		}
 
+		var redaction *providers.RedactionRules
+		if recordRedact != "" {
+			if record == "" {
+				log.Fatal().Msg("please use --record-redact together with --record")
+			}
+			redaction, err = providers.LoadRedactionRules(recordRedact)
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to load redaction rules")
+			}
+		}
+
 		runtime.Recording, err = providers.NewRecording(recordingPath, providers.RecordingOptions{
 			DoRecord:        record != "",
 			PrettyPrintJSON: pretty,
+			Redaction:       redaction,
 		})
 		if err != nil {
 			log.Fatal().Msg(err.Error())
@@ -53,6 +53,18 @@ func (r *cliReporter) printSummary() {
 	for mrn, asset := range r.data.Assets {
 		r.printAssetSummary(mrn, asset)
 	}
+
+	if failed := r.data.FailedAssets(); len(failed) > 0 {
+		r.out.Write([]byte(r.Printer.Error(fmt.Sprintf("%d of %d assets could not be scanned:", len(failed), len(r.data.Assets))) + "\n"))
+		for _, mrn := range failed {
+			name := mrn
+			if asset, ok := r.data.Assets[mrn]; ok && asset.Name != "" {
+				name = asset.Name
+			}
+			r.out.Write([]byte("  ✕ " + name + ": " + r.data.Errors[mrn].Message + "\n"))
+		}
+		r.out.Write([]byte("\n"))
+	}
 }
 
 func (r *cliReporter) printAssetSummary(assetMrn string, asset *explorer.Asset) {
@@ -0,0 +1,486 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+	"sigs.k8s.io/yaml"
+)
+
+// JSONReport is a report collection in the format that is written
+// by ReportCollectionToJSON
+type JSONReport struct {
+	Assets map[string]*explorer.Asset `json:"assets"`
+	// Data maps asset MRNs to their query results. Queries are either
+	// identified by their MRN or by their checksum.
+	Data   map[string]map[string]map[string]interface{} `json:"data"`
+	Errors map[string]string                            `json:"errors"`
+}
+
+// ParseJSONReport reads a report that was written with the JSON output format
+func ParseJSONReport(data []byte) (*JSONReport, error) {
+	var res JSONReport
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	if res.Assets == nil {
+		return nil, errors.New("no assets found, is this a JSON report?")
+	}
+	return &res, nil
+}
+
+// ReportDiff is the difference between two reports
+type ReportDiff struct {
+	AddedAssets   []*explorer.Asset `json:"addedAssets,omitempty"`
+	RemovedAssets []*explorer.Asset `json:"removedAssets,omitempty"`
+	ChangedAssets []*AssetDiff      `json:"changedAssets,omitempty"`
+}
+
+// IsEmpty returns true if both reports contain the same data
+func (d *ReportDiff) IsEmpty() bool {
+	return len(d.AddedAssets) == 0 && len(d.RemovedAssets) == 0 && len(d.ChangedAssets) == 0
+}
+
+// AssetDiff is the difference of one asset between two reports
+type AssetDiff struct {
+	Mrn  string `json:"mrn"`
+	Name string `json:"name,omitempty"`
+	// Error is set if the asset could not be scanned anymore
+	Error          string       `json:"error,omitempty"`
+	FailingQueries []*QueryDiff `json:"failingQueries,omitempty"`
+	ChangedQueries []*QueryDiff `json:"changedQueries,omitempty"`
+}
+
+// QueryDiff is the difference of one query's data on an asset
+type QueryDiff struct {
+	// Query is the query MRN or checksum
+	Query   string         `json:"query"`
+	Error   string         `json:"error,omitempty"`
+	Changes []*ValueChange `json:"changes,omitempty"`
+}
+
+// ValueChange is a value that changed at the given path. Values that were
+// added have no old value, values that were removed have no new value.
+type ValueChange struct {
+	Path string      `json:"path,omitempty"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+// DiffReports compares two reports. Assets are matched by their MRN first.
+// Local scans assign new MRNs every time, so assets without a match are
+// matched by their platform IDs, and finally by their name, as long as it
+// is unique in both reports. Queries are matched by their MRN or checksum.
+func DiffReports(old *JSONReport, cur *JSONReport) *ReportDiff {
+	res := &ReportDiff{}
+
+	matches := map[string]string{}
+	var removed []string
+	for mrn := range old.Assets {
+		if _, ok := cur.Assets[mrn]; ok {
+			matches[mrn] = mrn
+		} else {
+			removed = append(removed, mrn)
+		}
+	}
+	var added []string
+	for mrn := range cur.Assets {
+		if _, ok := old.Assets[mrn]; !ok {
+			added = append(added, mrn)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(added)
+
+	matchedOld := map[string]struct{}{}
+	match := func(curMrn string, oldMrn string) {
+		matches[curMrn] = oldMrn
+		matchedOld[oldMrn] = struct{}{}
+	}
+
+	platformIDs := map[string]string{}
+	for _, mrn := range removed {
+		for _, id := range old.Assets[mrn].GetPlatformIds() {
+			platformIDs[id] = mrn
+		}
+	}
+	for _, mrn := range added {
+		for _, id := range cur.Assets[mrn].GetPlatformIds() {
+			oldMrn, ok := platformIDs[id]
+			if !ok {
+				continue
+			}
+			if _, ok := matchedOld[oldMrn]; ok {
+				continue
+			}
+			match(mrn, oldMrn)
+			break
+		}
+	}
+
+	oldNames := uniqueAssetNames(old.Assets, unmatched(removed, matchedOld))
+	curNames := uniqueAssetNames(cur.Assets, unmatched(added, matches))
+	for name, oldMrn := range oldNames {
+		if curMrn, ok := curNames[name]; ok {
+			match(curMrn, oldMrn)
+		}
+	}
+
+	for _, mrn := range removed {
+		if _, ok := matchedOld[mrn]; ok {
+			continue
+		}
+		res.RemovedAssets = append(res.RemovedAssets, old.Assets[mrn])
+	}
+	for _, mrn := range added {
+		if _, ok := matches[mrn]; ok {
+			continue
+		}
+		res.AddedAssets = append(res.AddedAssets, cur.Assets[mrn])
+	}
+	sortAssets(res.RemovedAssets)
+	sortAssets(res.AddedAssets)
+
+	for curMrn, oldMrn := range matches {
+		if diff := diffAsset(old, oldMrn, cur, curMrn); diff != nil {
+			res.ChangedAssets = append(res.ChangedAssets, diff)
+		}
+	}
+	sort.Slice(res.ChangedAssets, func(i, j int) bool {
+		return res.ChangedAssets[i].Mrn < res.ChangedAssets[j].Mrn
+	})
+
+	return res
+}
+
+func unmatched[T any](mrns []string, matched map[string]T) []string {
+	var res []string
+	for _, mrn := range mrns {
+		if _, ok := matched[mrn]; !ok {
+			res = append(res, mrn)
+		}
+	}
+	return res
+}
+
+func uniqueAssetNames(assets map[string]*explorer.Asset, mrns []string) map[string]string {
+	res := map[string]string{}
+	duplicates := map[string]struct{}{}
+	for _, mrn := range mrns {
+		name := assets[mrn].GetName()
+		if name == "" {
+			continue
+		}
+		if _, ok := res[name]; ok {
+			duplicates[name] = struct{}{}
+		}
+		res[name] = mrn
+	}
+	for name := range duplicates {
+		delete(res, name)
+	}
+	return res
+}
+
+func sortAssets(assets []*explorer.Asset) {
+	sort.Slice(assets, func(i, j int) bool {
+		return assets[i].Mrn < assets[j].Mrn
+	})
+}
+
+func diffAsset(old *JSONReport, oldMrn string, cur *JSONReport, curMrn string) *AssetDiff {
+	res := &AssetDiff{
+		Mrn:  curMrn,
+		Name: cur.Assets[curMrn].GetName(),
+	}
+
+	if msg, ok := cur.Errors[curMrn]; ok {
+		if _, ok := old.Errors[oldMrn]; !ok {
+			res.Error = msg
+			return res
+		}
+	}
+
+	oldData := old.Data[oldMrn]
+	curData := cur.Data[curMrn]
+
+	queries := make([]string, 0, len(curData))
+	for id := range curData {
+		queries = append(queries, id)
+	}
+	for id := range oldData {
+		if _, ok := curData[id]; !ok {
+			queries = append(queries, id)
+		}
+	}
+	sort.Strings(queries)
+
+	for _, id := range queries {
+		oldValue, oldOk := oldData[id]
+		curValue, curOk := curData[id]
+
+		if curOk {
+			if msg := jsonQueryError(curValue); msg != "" {
+				if !oldOk || jsonQueryError(oldValue) == "" {
+					res.FailingQueries = append(res.FailingQueries, &QueryDiff{Query: id, Error: msg})
+				}
+				continue
+			}
+		}
+
+		var changes []*ValueChange
+		switch {
+		case !oldOk:
+			changes = []*ValueChange{{New: curValue}}
+		case !curOk:
+			changes = []*ValueChange{{Old: oldValue}}
+		default:
+			changes = diffRawData("", llx.MapData(oldValue, types.Dict), llx.MapData(curValue, types.Dict))
+		}
+		if len(changes) != 0 {
+			res.ChangedQueries = append(res.ChangedQueries, &QueryDiff{Query: id, Changes: changes})
+		}
+	}
+
+	if len(res.FailingQueries) == 0 && len(res.ChangedQueries) == 0 {
+		return nil
+	}
+	return res
+}
+
+// jsonQueryError returns the error of a query result, if any of its
+// entrypoints failed
+func jsonQueryError(data map[string]interface{}) string {
+	labels := make([]string, 0, len(data))
+	for label := range data {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	for _, label := range labels {
+		m, ok := data[label].(map[string]interface{})
+		if !ok || len(m) != 1 {
+			continue
+		}
+		if msg, ok := m["error"].(string); ok {
+			return msg
+		}
+	}
+	return ""
+}
+
+// jsonRawData turns decoded JSON into raw data
+func jsonRawData(v interface{}) *llx.RawData {
+	switch x := v.(type) {
+	case []interface{}:
+		return llx.ArrayData(x, types.Dict)
+	case map[string]interface{}:
+		return llx.MapData(x, types.Dict)
+	default:
+		return llx.DictData(x)
+	}
+}
+
+// diffRawData compares two values. Maps are compared key by key and arrays
+// element by element, regardless of the position of their elements.
+func diffRawData(path string, old *llx.RawData, cur *llx.RawData) []*ValueChange {
+	if old.Type.IsMap() && cur.Type.IsMap() {
+		return diffMaps(path, old.Value.(map[string]interface{}), cur.Value.(map[string]interface{}))
+	}
+	if old.Type.IsArray() && cur.Type.IsArray() {
+		return diffArrays(path, old.Value.([]interface{}), cur.Value.([]interface{}))
+	}
+	if reflect.DeepEqual(old.Value, cur.Value) {
+		return nil
+	}
+	return []*ValueChange{{Path: path, Old: old.Value, New: cur.Value}}
+}
+
+func diffMaps(path string, old map[string]interface{}, cur map[string]interface{}) []*ValueChange {
+	keys := make([]string, 0, len(cur))
+	for k := range cur {
+		keys = append(keys, k)
+	}
+	for k := range old {
+		if _, ok := cur[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var res []*ValueChange
+	for _, k := range keys {
+		keyPath := k
+		if path != "" {
+			keyPath = path + "." + k
+		}
+
+		oldValue, oldOk := old[k]
+		curValue, curOk := cur[k]
+		switch {
+		case !oldOk:
+			res = append(res, &ValueChange{Path: keyPath, New: curValue})
+		case !curOk:
+			res = append(res, &ValueChange{Path: keyPath, Old: oldValue})
+		default:
+			res = append(res, diffRawData(keyPath, jsonRawData(oldValue), jsonRawData(curValue))...)
+		}
+	}
+	return res
+}
+
+func diffArrays(path string, old []interface{}, cur []interface{}) []*ValueChange {
+	// count all elements by their JSON representation, which is stable
+	// since map keys are sorted when marshalling
+	counts := map[string]int{}
+	for i := range old {
+		counts[diffKey(old[i])]++
+	}
+	var added []interface{}
+	for i := range cur {
+		key := diffKey(cur[i])
+		if counts[key] > 0 {
+			counts[key]--
+		} else {
+			added = append(added, cur[i])
+		}
+	}
+
+	var res []*ValueChange
+	for i := range old {
+		key := diffKey(old[i])
+		if counts[key] > 0 {
+			counts[key]--
+			res = append(res, &ValueChange{Path: path, Old: old[i]})
+		}
+	}
+	for i := range added {
+		res = append(res, &ValueChange{Path: path, New: added[i]})
+	}
+	return res
+}
+
+func diffKey(v interface{}) string {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%#v", v)
+	}
+	return string(raw)
+}
+
+// PrintDiff prints the difference between two reports
+func (r *Reporter) PrintDiff(diff *ReportDiff, out io.Writer) error {
+	switch r.Format {
+	case Compact, Summary, Full:
+		r.printDiff(diff, out)
+		return nil
+	case JSON:
+		raw, err := json.Marshal(diff)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(raw)
+		return err
+	case YAML:
+		raw, err := yaml.Marshal(diff)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(raw)
+		return err
+	default:
+		return errors.New("this output format is not supported for diffs, use one of: compact, summary, full, json, yaml")
+	}
+}
+
+// maxDiffValueLen is the length at which values are cut off in compact output
+const maxDiffValueLen = 80
+
+func (r *Reporter) printDiff(diff *ReportDiff, out io.Writer) {
+	out.Write([]byte(r.Printer.H1(fmt.Sprintf("Diff (%d changed, %d added, %d removed assets)",
+		len(diff.ChangedAssets), len(diff.AddedAssets), len(diff.RemovedAssets)))))
+
+	if diff.IsEmpty() {
+		out.Write([]byte("No differences found.\n"))
+		return
+	}
+
+	for _, asset := range diff.AddedAssets {
+		out.Write([]byte(r.Printer.Success("+ "+assetTitle(asset.Mrn, asset.Name)) + "\n"))
+	}
+	for _, asset := range diff.RemovedAssets {
+		out.Write([]byte(r.Printer.Failed("- "+assetTitle(asset.Mrn, asset.Name)) + "\n"))
+	}
+	if len(diff.AddedAssets) != 0 || len(diff.RemovedAssets) != 0 {
+		out.Write([]byte("\n"))
+	}
+
+	for _, asset := range diff.ChangedAssets {
+		out.Write([]byte(r.Printer.Primary("Target:     "+assetTitle(asset.Mrn, asset.Name)) + "\n"))
+
+		if asset.Error != "" {
+			out.Write([]byte(r.Printer.Error(asset.Error) + "\n\n"))
+			continue
+		}
+
+		if r.Format == Summary {
+			out.Write([]byte(fmt.Sprintf("Failing:    %d\nChanged:    %d\n\n", len(asset.FailingQueries), len(asset.ChangedQueries))))
+			continue
+		}
+
+		for _, query := range asset.FailingQueries {
+			out.Write([]byte(r.Printer.Failed("✕ "+query.Query+": "+query.Error) + "\n"))
+		}
+		for _, query := range asset.ChangedQueries {
+			out.Write([]byte("~ " + query.Query + "\n"))
+			for _, change := range query.Changes {
+				if change.Path != "" {
+					out.Write([]byte("    " + r.Printer.Secondary(change.Path) + "\n"))
+				}
+				if change.Old != nil {
+					out.Write([]byte("      " + r.Printer.Failed("- "+r.diffValue(change.Old)) + "\n"))
+				}
+				if change.New != nil {
+					out.Write([]byte("      " + r.Printer.Success("+ "+r.diffValue(change.New)) + "\n"))
+				}
+			}
+		}
+		out.Write([]byte("\n"))
+	}
+}
+
+func (r *Reporter) diffValue(v interface{}) string {
+	if r.Format == Full {
+		var buf bytes.Buffer
+		raw, _ := json.Marshal(v)
+		if err := json.Indent(&buf, raw, "        ", "  "); err != nil {
+			return string(raw)
+		}
+		return buf.String()
+	}
+
+	res := []rune(diffKey(v))
+	if len(res) > maxDiffValueLen {
+		return string(res[:maxDiffValueLen]) + "... (" + strconv.Itoa(len(res)) + " chars)"
+	}
+	return string(res)
+}
+
+func assetTitle(mrn string, name string) string {
+	if name == "" {
+		return mrn
+	}
+	return name
+}
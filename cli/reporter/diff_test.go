@@ -0,0 +1,190 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/cli/printer"
+)
+
+const diffOldReport = `{
+	"assets": {
+		"//asset/1": {"mrn": "//asset/1", "name": "web"},
+		"//asset/2": {"mrn": "//asset/2", "name": "db"},
+		"//asset/old": {"mrn": "//asset/old", "name": "renamed"},
+		"//asset/gone": {"mrn": "//asset/gone", "name": "gone"}
+	},
+	"data": {
+		"//asset/1": {
+			"//query/users": {"users.list": [{"name": "root"}, {"name": "bob"}]},
+			"//query/sshd": {"sshd.config.params": {"Port": "22", "PermitRootLogin": "no"}},
+			"//query/file": {"file.content": "hi"}
+		},
+		"//asset/2": {
+			"//query/users": {"users.list": [{"name": "root"}]}
+		},
+		"//asset/old": {
+			"//query/users": {"users.list": [{"name": "root"}]}
+		},
+		"//asset/gone": {}
+	},
+	"errors": {}
+}`
+
+const diffNewReport = `{
+	"assets": {
+		"//asset/1": {"mrn": "//asset/1", "name": "web"},
+		"//asset/2": {"mrn": "//asset/2", "name": "db"},
+		"//asset/new": {"mrn": "//asset/new", "name": "renamed"},
+		"//asset/added": {"mrn": "//asset/added", "name": "added"}
+	},
+	"data": {
+		"//asset/1": {
+			"//query/users": {"users.list": [{"name": "root"}, {"name": "alice"}]},
+			"//query/sshd": {"sshd.config.params": {"Port": "2222", "PermitRootLogin": "no", "UsePAM": "yes"}},
+			"//query/file": {"file.content": {"error": "file not found"}}
+		},
+		"//asset/new": {
+			"//query/users": {"users.list": [{"name": "root"}]}
+		},
+		"//asset/added": {}
+	},
+	"errors": {
+		"//asset/2": "connection refused"
+	}
+}`
+
+func testDiff(t *testing.T) *ReportDiff {
+	old, err := ParseJSONReport([]byte(diffOldReport))
+	require.NoError(t, err)
+	cur, err := ParseJSONReport([]byte(diffNewReport))
+	require.NoError(t, err)
+	return DiffReports(old, cur)
+}
+
+func TestDiffReports(t *testing.T) {
+	diff := testDiff(t)
+
+	require.Len(t, diff.AddedAssets, 1)
+	assert.Equal(t, "//asset/added", diff.AddedAssets[0].Mrn)
+	require.Len(t, diff.RemovedAssets, 1)
+	assert.Equal(t, "//asset/gone", diff.RemovedAssets[0].Mrn)
+
+	// the renamed asset is matched by its name and has no changes
+	require.Len(t, diff.ChangedAssets, 2)
+
+	web := diff.ChangedAssets[0]
+	assert.Equal(t, "//asset/1", web.Mrn)
+	assert.Equal(t, []*QueryDiff{{Query: "//query/file", Error: "file not found"}}, web.FailingQueries)
+	assert.Equal(t, []*QueryDiff{
+		{Query: "//query/sshd", Changes: []*ValueChange{
+			{Path: "sshd.config.params.Port", Old: "22", New: "2222"},
+			{Path: "sshd.config.params.UsePAM", New: "yes"},
+		}},
+		{Query: "//query/users", Changes: []*ValueChange{
+			{Path: "users.list", Old: map[string]interface{}{"name": "bob"}},
+			{Path: "users.list", New: map[string]interface{}{"name": "alice"}},
+		}},
+	}, web.ChangedQueries)
+
+	db := diff.ChangedAssets[1]
+	assert.Equal(t, "//asset/2", db.Mrn)
+	assert.Equal(t, "connection refused", db.Error)
+}
+
+func TestDiffReportsEqual(t *testing.T) {
+	old, err := ParseJSONReport([]byte(diffOldReport))
+	require.NoError(t, err)
+	assert.True(t, DiffReports(old, old).IsEmpty())
+}
+
+func TestDiffReportsPlatformIds(t *testing.T) {
+	// local scans get new MRNs and both assets share a name
+	old, err := ParseJSONReport([]byte(`{
+		"assets": {
+			"//asset/a1": {"mrn": "//asset/a1", "name": "node", "platform_ids": ["//platformid/a"]},
+			"//asset/b1": {"mrn": "//asset/b1", "name": "node", "platform_ids": ["//platformid/b"]}
+		},
+		"data": {
+			"//asset/a1": {"//query/hostname": {"hostname": "a"}},
+			"//asset/b1": {"//query/hostname": {"hostname": "b"}}
+		}
+	}`))
+	require.NoError(t, err)
+	cur, err := ParseJSONReport([]byte(`{
+		"assets": {
+			"//asset/a2": {"mrn": "//asset/a2", "name": "node", "platform_ids": ["//platformid/a"]},
+			"//asset/b2": {"mrn": "//asset/b2", "name": "node", "platform_ids": ["//platformid/b"]}
+		},
+		"data": {
+			"//asset/a2": {"//query/hostname": {"hostname": "a"}},
+			"//asset/b2": {"//query/hostname": {"hostname": "b2"}}
+		}
+	}`))
+	require.NoError(t, err)
+
+	diff := DiffReports(old, cur)
+	assert.Empty(t, diff.AddedAssets)
+	assert.Empty(t, diff.RemovedAssets)
+	require.Len(t, diff.ChangedAssets, 1)
+	assert.Equal(t, "//asset/b2", diff.ChangedAssets[0].Mrn)
+	assert.Equal(t, []*ValueChange{{Path: "hostname", Old: "b", New: "b2"}}, diff.ChangedAssets[0].ChangedQueries[0].Changes)
+}
+
+func TestDiffValueTruncated(t *testing.T) {
+	r, err := New("compact")
+	require.NoError(t, err)
+
+	res := r.diffValue(strings.Repeat("ü", 100))
+	assert.True(t, utf8.ValidString(res))
+	assert.Equal(t, "\""+strings.Repeat("ü", 79)+"... (102 chars)", res)
+}
+
+func TestDiffArrayDuplicates(t *testing.T) {
+	changes := diffArrays("list", []interface{}{"a", "a", "b"}, []interface{}{"b", "a", "c"})
+	assert.Equal(t, []*ValueChange{
+		{Path: "list", Old: "a"},
+		{Path: "list", New: "c"},
+	}, changes)
+}
+
+func TestPrintDiff(t *testing.T) {
+	diff := testDiff(t)
+
+	t.Run("compact", func(t *testing.T) {
+		r, err := New("compact")
+		require.NoError(t, err)
+		r.Printer = &printer.PlainNoColorPrinter
+
+		var out strings.Builder
+		require.NoError(t, r.PrintDiff(diff, &out))
+		assert.Contains(t, out.String(), "Diff (2 changed, 1 added, 1 removed assets)")
+		assert.Contains(t, out.String(), "+ added\n- gone\n")
+		assert.Contains(t, out.String(), "✕ //query/file: file not found\n")
+		assert.Contains(t, out.String(), "    sshd.config.params.Port\n      - \"22\"\n      + \"2222\"\n")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		r, err := New("json")
+		require.NoError(t, err)
+
+		var out strings.Builder
+		require.NoError(t, r.PrintDiff(diff, &out))
+		var res ReportDiff
+		require.NoError(t, json.Unmarshal([]byte(out.String()), &res))
+		assert.Len(t, res.ChangedAssets, 2)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		r, err := New("junit")
+		require.NoError(t, err)
+		assert.Error(t, r.PrintDiff(diff, &strings.Builder{}))
+	})
+}
@@ -111,7 +111,18 @@ func ReportCollectionToJSON(data *explorer.ReportCollection, out shared.OutputHe
 		out.WriteString(pre + llx.PrettyPrintString(id) + ":" + llx.PrettyPrintString(errStatus.Message))
 		pre = ","
 	}
-	out.WriteString("}}")
+	out.WriteString("}")
+
+	// profiles are optional and only included for profiled scans
+	if len(data.Profiles) != 0 {
+		profiles, err := json.Marshal(data.Profiles)
+		if err != nil {
+			return err
+		}
+		out.WriteString(",\"profiles\":")
+		out.WriteString(string(profiles))
+	}
+	out.WriteString("}")
 
 	return nil
 }
@@ -0,0 +1,135 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/xml"
+
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/shared"
+)
+
+type junitTestsuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Name    string           `xml:"name,attr"`
+	Tests   int              `xml:"tests,attr"`
+	Errors  int              `xml:"errors,attr"`
+	Skipped int              `xml:"skipped,attr"`
+	Suites  []junitTestsuite `xml:"testsuite"`
+}
+
+type junitTestsuite struct {
+	Name       string          `xml:"name,attr"`
+	ID         int             `xml:"id,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Testcases  []junitTestcase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestcase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Content string `xml:",chardata"`
+}
+
+// ReportCollectionToJunit writes the report collection as JUnit XML. Every
+// asset is a testsuite and every query that ran on it is a testcase. Queries
+// that failed to collect data are reported as errors.
+func ReportCollectionToJunit(data *explorer.ReportCollection, out shared.OutputHelper) error {
+	suites := junitTestsuites{
+		Name: "cnquery",
+	}
+
+	if data != nil {
+		queries := queriesByCodeID(data.Bundle)
+
+		for i, assetMrn := range sortedAssetMrns(data) {
+			suite := assetToJunit(data, assetMrn, queries)
+			suite.ID = i
+			suites.Tests += suite.Tests
+			suites.Errors += suite.Errors
+			suites.Skipped += suite.Skipped
+			suites.Suites = append(suites.Suites, suite)
+		}
+	}
+
+	raw, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	out.WriteString(xml.Header)
+	out.Write(raw)
+	out.WriteString("\n")
+	return nil
+}
+
+func assetToJunit(data *explorer.ReportCollection, assetMrn string, queries map[string]*explorer.Mquery) junitTestsuite {
+	asset := data.Assets[assetMrn]
+	suite := junitTestsuite{
+		Name: asset.Name,
+		Properties: []junitProperty{
+			{Name: "mrn", Value: assetMrn},
+		},
+	}
+	if suite.Name == "" {
+		suite.Name = assetMrn
+	}
+
+	// assets that failed to scan have no results, but we still want to see them
+	if errStatus, ok := data.Errors[assetMrn]; ok {
+		tc := junitTestcase{
+			Name:      suite.Name,
+			Classname: assetMrn,
+		}
+		msg := &junitMessage{Message: errStatus.Message, Type: errStatus.ErrorCode().String()}
+		if errStatus.ErrorCode().Category() == explorer.ErrorCategoryError {
+			tc.Error = msg
+			suite.Errors++
+		} else {
+			tc.Skipped = msg
+			suite.Skipped++
+		}
+		suite.Tests++
+		suite.Testcases = append(suite.Testcases, tc)
+		return suite
+	}
+
+	results := assetQueryResults(data.Reports[assetMrn], data.Resolved[assetMrn], queries)
+	for _, res := range results {
+		tc := junitTestcase{
+			Name:      res.Title(),
+			Classname: res.Mrn(),
+			SystemOut: string(res.Data),
+		}
+		if tc.Classname == "" {
+			tc.Classname = res.CodeID
+		}
+
+		if res.Error != "" {
+			tc.Error = &junitMessage{Message: res.Error, Type: "error", Content: res.MQL}
+			suite.Errors++
+		}
+
+		suite.Tests++
+		suite.Testcases = append(suite.Testcases, tc)
+	}
+
+	return suite
+}
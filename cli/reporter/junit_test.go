@@ -0,0 +1,90 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/xml"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/shared"
+	"sigs.k8s.io/yaml"
+)
+
+func TestJunitExport(t *testing.T) {
+	data, err := os.ReadFile("testdata/kubernetes_report.yaml")
+	require.NoError(t, err)
+
+	var report *explorer.ReportCollection
+	err = yaml.Unmarshal(data, &report)
+	require.NoError(t, err)
+
+	var out strings.Builder
+	w := shared.IOWriter{Writer: &out}
+	err = ReportCollectionToJunit(report, &w)
+	require.NoError(t, err)
+
+	var suites junitTestsuites
+	err = xml.Unmarshal([]byte(out.String()), &suites)
+	require.NoError(t, err)
+
+	assert.Equal(t, len(report.Assets), len(suites.Suites))
+	// assets that don't match any query pack are skipped
+	assert.Equal(t, 3, suites.Skipped)
+	assert.Equal(t, 0, suites.Errors)
+
+	var pod *junitTestsuite
+	for i := range suites.Suites {
+		if suites.Suites[i].Name == "kube-system/kube-proxy-gdsjm" {
+			pod = &suites.Suites[i]
+		}
+	}
+	require.NotNil(t, pod)
+	require.NotEmpty(t, pod.Testcases)
+	for _, tc := range pod.Testcases {
+		assert.NotEmpty(t, tc.Name)
+		assert.Nil(t, tc.Error)
+		assert.True(t, strings.HasPrefix(tc.SystemOut, "{"), "data is written as JSON")
+	}
+}
+
+func TestJunitQueryError(t *testing.T) {
+	query := "file('/does/not/exist').content"
+	code, results := testQuery(t, query)
+
+	data := map[string]*llx.Result{}
+	for checksum, res := range results {
+		data[checksum] = res.Result()
+	}
+
+	report := &explorer.ReportCollection{
+		Assets: map[string]*explorer.Asset{
+			"//asset/1": {Mrn: "//asset/1", Name: "linux"},
+		},
+		Reports: map[string]*explorer.Report{
+			"//asset/1": {Data: data},
+		},
+		Resolved: map[string]*explorer.ResolvedPack{
+			"//asset/1": {ExecutionJob: &explorer.ExecutionJob{
+				Queries: map[string]*explorer.ExecutionQuery{
+					code.CodeV2.Id: {Query: query, Code: code},
+				},
+			}},
+		},
+	}
+
+	var out strings.Builder
+	w := shared.IOWriter{Writer: &out}
+	err := ReportCollectionToJunit(report, &w)
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), `<testsuites name="cnquery" tests="1" errors="1" skipped="0">`)
+	assert.Contains(t, out.String(), `<testsuite name="linux" id="0" tests="1" errors="1" skipped="0">`)
+	assert.Contains(t, out.String(), `<error message="file &#39;/does/not/exist&#39; not found" type="error">`)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/explorer/scan"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+)
+
+type ndjsonAsset struct {
+	Mrn  string `json:"mrn"`
+	Name string `json:"name,omitempty"`
+}
+
+type ndjsonQuery struct {
+	Mrn    string `json:"mrn,omitempty"`
+	Title  string `json:"title,omitempty"`
+	Mql    string `json:"mql"`
+	CodeID string `json:"codeId"`
+}
+
+// ndjsonLine is one line of NDJSON output. It either carries the result of
+// a query on an asset or the error of an asset that couldn't be scanned.
+type ndjsonLine struct {
+	Asset ndjsonAsset     `json:"asset"`
+	Query *ndjsonQuery    `json:"query,omitempty"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+func writeNdjsonLine(line ndjsonLine, out io.Writer) error {
+	raw, err := json.Marshal(line)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(append(raw, '\n'))
+	return err
+}
+
+func writeNdjsonAsset(asset ndjsonAsset, results []queryResult, out io.Writer) error {
+	for _, res := range results {
+		line := ndjsonLine{
+			Asset: asset,
+			Query: &ndjsonQuery{
+				Mrn:    res.Mrn(),
+				Title:  res.Title(),
+				Mql:    res.MQL,
+				CodeID: res.CodeID,
+			},
+			Error: res.Error,
+		}
+		if json.Valid(res.Data) {
+			line.Data = res.Data
+		}
+		if err := writeNdjsonLine(line, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReportCollectionToNdjson writes one JSON line per asset and query result
+func ReportCollectionToNdjson(data *explorer.ReportCollection, out io.Writer) error {
+	if data == nil {
+		return nil
+	}
+
+	queries := queriesByCodeID(data.Bundle)
+	for _, assetMrn := range sortedAssetMrns(data) {
+		asset := ndjsonAsset{Mrn: assetMrn, Name: data.Assets[assetMrn].Name}
+
+		if errStatus, ok := data.Errors[assetMrn]; ok {
+			if err := writeNdjsonLine(ndjsonLine{Asset: asset, Error: errStatus.Message}, out); err != nil {
+				return err
+			}
+			continue
+		}
+
+		results := assetQueryResults(data.Reports[assetMrn], data.Resolved[assetMrn], queries)
+		if err := writeNdjsonAsset(asset, results, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NdjsonStream is a scan reporter that writes the results of every asset as
+// NDJSON as soon as the asset is scanned. This lets consumers process the
+// results of large inventories while the scan is still running.
+type NdjsonStream struct {
+	out  io.Writer
+	lock sync.Mutex
+}
+
+var _ scan.Reporter = &NdjsonStream{}
+
+func NewNdjsonStream(out io.Writer) *NdjsonStream {
+	return &NdjsonStream{out: out}
+}
+
+func (s *NdjsonStream) AddReport(asset *inventory.Asset, results *scan.AssetReport) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	res := assetQueryResults(results.Report, results.Resolved, queriesByCodeID(results.Bundle))
+	if err := writeNdjsonAsset(ndjsonAsset{Mrn: asset.Mrn, Name: asset.Name}, res, s.out); err != nil {
+		log.Error().Err(err).Str("asset", asset.Name).Msg("failed to write results")
+	}
+}
+
+func (s *NdjsonStream) AddScanError(asset *inventory.Asset, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	line := ndjsonLine{
+		Asset: ndjsonAsset{Mrn: asset.Mrn, Name: asset.Name},
+		Error: explorer.NewErrorStatus(err).Message,
+	}
+	if err := writeNdjsonLine(line, s.out); err != nil {
+		log.Error().Err(err).Str("asset", asset.Name).Msg("failed to write scan error")
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/explorer/scan"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"sigs.k8s.io/yaml"
+)
+
+func readNdjson(t *testing.T, data string) []ndjsonLine {
+	var res []ndjsonLine
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var line ndjsonLine
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		res = append(res, line)
+	}
+	require.NoError(t, scanner.Err())
+	return res
+}
+
+func TestNdjsonExport(t *testing.T) {
+	data, err := os.ReadFile("testdata/kubernetes_report.yaml")
+	require.NoError(t, err)
+
+	var report *explorer.ReportCollection
+	err = yaml.Unmarshal(data, &report)
+	require.NoError(t, err)
+
+	var out strings.Builder
+	err = ReportCollectionToNdjson(report, &out)
+	require.NoError(t, err)
+
+	lines := readNdjson(t, out.String())
+	require.NotEmpty(t, lines)
+
+	failed := 0
+	for _, line := range lines {
+		assert.NotEmpty(t, line.Asset.Mrn)
+		if line.Query == nil {
+			assert.NotEmpty(t, line.Error)
+			failed++
+			continue
+		}
+		assert.NotEmpty(t, line.Query.CodeID)
+		assert.NotEmpty(t, line.Query.Mql)
+	}
+	assert.Equal(t, 3, failed)
+}
+
+func TestNdjsonStream(t *testing.T) {
+	query := "users.where(uid==0)"
+	code, results := testQuery(t, query)
+
+	data := map[string]*llx.Result{}
+	for checksum, res := range results {
+		data[checksum] = res.Result()
+	}
+
+	var out strings.Builder
+	stream := NewNdjsonStream(&out)
+
+	stream.AddReport(&inventory.Asset{Mrn: "//asset/1", Name: "linux"}, &scan.AssetReport{
+		Mrn:    "//asset/1",
+		Report: &explorer.Report{Data: data},
+		Resolved: &explorer.ResolvedPack{ExecutionJob: &explorer.ExecutionJob{
+			Queries: map[string]*explorer.ExecutionQuery{
+				code.CodeV2.Id: {Query: query, Code: code},
+			},
+		}},
+	})
+	stream.AddScanError(&inventory.Asset{Mrn: "//asset/2", Name: "unreachable"}, errors.New("connection refused"))
+
+	lines := readNdjson(t, out.String())
+	require.Len(t, lines, 2)
+
+	assert.Equal(t, "linux", lines[0].Asset.Name)
+	require.NotNil(t, lines[0].Query)
+	assert.Equal(t, query, lines[0].Query.Mql)
+	assert.JSONEq(t, `{"users.where.list":[{"gid":0,"name":"root","uid":0}]}`, string(lines[0].Data))
+
+	assert.Equal(t, "unreachable", lines[1].Asset.Name)
+	assert.Nil(t, lines[1].Query)
+	assert.Equal(t, "connection refused", lines[1].Error)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"go.mondoo.com/cnquery/explorer"
+)
+
+// DefaultProfileLimit is the number of queries and fields that are printed
+// for every profiled asset
+const DefaultProfileLimit = 10
+
+// PrintProfiles prints tables of the most expensive queries and fields for
+// all assets that were profiled. Limit restricts the rows of every table.
+func (r *Reporter) PrintProfiles(data *explorer.ReportCollection, out io.Writer, limit int) error {
+	if data == nil || len(data.Profiles) == 0 {
+		return nil
+	}
+
+	queries := queriesByCodeID(data.Bundle)
+
+	mrns := make([]string, 0, len(data.Profiles))
+	for mrn := range data.Profiles {
+		mrns = append(mrns, mrn)
+	}
+	sort.Strings(mrns)
+
+	for _, mrn := range mrns {
+		profile := data.Profiles[mrn]
+		name := mrn
+		if asset, ok := data.Assets[mrn]; ok && asset.Name != "" {
+			name = asset.Name
+		}
+
+		if _, err := io.WriteString(out, r.Printer.H1("Profile: "+name)); err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		io.WriteString(w, "DURATION\tCALLS\tCACHE HITS\tQUERY\n")
+		for i, query := range profile.Queries {
+			if i == limit {
+				break
+			}
+			title := ""
+			if q, ok := queries[query.CodeId]; ok {
+				title = q.Mrn
+			}
+			if title == "" {
+				title = oneLine(query.Mql)
+			}
+			io.WriteString(w, costRow(query.Duration, query.Calls, query.CacheHits)+title+"\n")
+		}
+		io.WriteString(w, "\t\t\t\n")
+
+		io.WriteString(w, "DURATION\tCALLS\tCACHE HITS\tFIELD\n")
+		for i, field := range profile.Fields {
+			if i == limit {
+				break
+			}
+			title := field.Resource + "." + field.Field
+			if field.Field == "" {
+				title = field.Resource + " (create)"
+			}
+			io.WriteString(w, costRow(field.Duration, field.Calls, field.CacheHits)+title+"\n")
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(out, "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func costRow(duration int64, calls uint32, cacheHits uint32) string {
+	return time.Duration(duration).Round(time.Microsecond).String() + "\t" +
+		strconv.FormatUint(uint64(calls), 10) + "\t" +
+		strconv.FormatUint(uint64(cacheHits), 10) + "\t"
+}
+
+// oneLine shortens queries to fit into a single table row
+func oneLine(mql string) string {
+	res := []rune(strings.Join(strings.Fields(mql), " "))
+	if len(res) > 60 {
+		return string(res[:57]) + "..."
+	}
+	return string(res)
+}
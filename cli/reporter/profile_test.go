@@ -0,0 +1,80 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/shared"
+)
+
+func profiledReport() *explorer.ReportCollection {
+	return &explorer.ReportCollection{
+		Assets: map[string]*explorer.Asset{"//asset": {Mrn: "//asset", Name: "arch"}},
+		Bundle: &explorer.Bundle{Packs: []*explorer.QueryPack{{
+			Queries: []*explorer.Mquery{{Mrn: "//query/packages", CodeId: "packages"}},
+		}}},
+		Profiles: map[string]*explorer.AssetProfile{"//asset": {
+			Queries: []*explorer.QueryCost{
+				{CodeId: "packages", Mql: "packages", Duration: (2 * time.Second).Nanoseconds(), Calls: 3},
+				{CodeId: "users", Mql: "users {\n  name\n}", Duration: time.Millisecond.Nanoseconds(), CacheHits: 2},
+			},
+			Fields: []*explorer.FieldCost{
+				{Resource: "packages", Field: "list", Duration: (2 * time.Second).Nanoseconds(), Calls: 1},
+				{Resource: "users", Duration: time.Millisecond.Nanoseconds(), Calls: 1},
+			},
+		}},
+	}
+}
+
+func TestPrintProfiles(t *testing.T) {
+	r, err := New("compact")
+	require.NoError(t, err)
+
+	var out strings.Builder
+	require.NoError(t, r.PrintProfiles(profiledReport(), &out, 1))
+	res := out.String()
+	assert.Contains(t, res, "Profile: arch")
+	assert.Contains(t, res, "2s        3      0           //query/packages")
+	assert.Contains(t, res, "2s        1      0           packages.list")
+	assert.NotContains(t, res, "users", "only the most expensive rows are printed")
+
+	out.Reset()
+	require.NoError(t, r.PrintProfiles(profiledReport(), &out, DefaultProfileLimit))
+	assert.Contains(t, out.String(), "1ms       0      2           users { name }")
+	assert.Contains(t, out.String(), "users (create)")
+}
+
+func TestPrintProfilesQueryMrns(t *testing.T) {
+	r, err := New("compact")
+	require.NoError(t, err)
+
+	report := profiledReport()
+	report.Bundle.Queries = []*explorer.Mquery{{Mrn: "//query/users", CodeId: "users"}}
+
+	var out strings.Builder
+	require.NoError(t, r.PrintProfiles(report, &out, DefaultProfileLimit))
+	assert.Contains(t, out.String(), "1ms       0      2           //query/users")
+}
+
+func TestOneLine(t *testing.T) {
+	assert.Equal(t, "users { name }", oneLine("users {\n  name\n}"))
+	assert.Equal(t, strings.Repeat("ü", 57)+"...", oneLine(strings.Repeat("ü", 61)))
+}
+
+func TestJsonProfiles(t *testing.T) {
+	var out strings.Builder
+	w := shared.IOWriter{Writer: &out}
+	require.NoError(t, ReportCollectionToJSON(&explorer.ReportCollection{}, &w))
+	assert.NotContains(t, out.String(), "profiles")
+
+	out.Reset()
+	require.NoError(t, ReportCollectionToJSON(profiledReport(), &w))
+	assert.Contains(t, out.String(), `"profiles":{"//asset":{"queries":[{"code_id":"packages","mql":"packages","duration":2000000000,"calls":3}`)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"bytes"
+	"sort"
+
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/shared"
+)
+
+// queryResult is the result of one query on one asset
+type queryResult struct {
+	CodeID string
+	// Query is nil if the query isn't part of the bundle
+	Query *explorer.Mquery
+	MQL   string
+	// Data is the JSON encoded result data
+	Data  []byte
+	Error string
+}
+
+func (q queryResult) Title() string {
+	if q.Query != nil && q.Query.Title != "" {
+		return q.Query.Title
+	}
+	return q.MQL
+}
+
+func (q queryResult) Mrn() string {
+	if q.Query != nil {
+		return q.Query.Mrn
+	}
+	return ""
+}
+
+// assetQueryResults collects the results of all queries that were run for
+// an asset, sorted by their code ID
+func assetQueryResults(report *explorer.Report, resolved *explorer.ResolvedPack, queries map[string]*explorer.Mquery) []queryResult {
+	if report == nil || resolved == nil || resolved.ExecutionJob == nil {
+		return nil
+	}
+
+	results := report.RawResults()
+
+	codeIDs := make([]string, 0, len(resolved.ExecutionJob.Queries))
+	for codeID := range resolved.ExecutionJob.Queries {
+		codeIDs = append(codeIDs, codeID)
+	}
+	sort.Strings(codeIDs)
+
+	res := make([]queryResult, len(codeIDs))
+	for i, codeID := range codeIDs {
+		equery := resolved.ExecutionJob.Queries[codeID]
+
+		buf := bytes.Buffer{}
+		if err := BundleResultsToJSON(equery.Code, results, &shared.IOWriter{Writer: &buf}); err != nil {
+			buf.Reset()
+		}
+
+		res[i] = queryResult{
+			CodeID: codeID,
+			Query:  queries[codeID],
+			MQL:    equery.Query,
+			Data:   buf.Bytes(),
+			Error:  queryError(equery.Code, results),
+		}
+	}
+	return res
+}
+
+// queryError returns the first error of all results of a query
+func queryError(code *llx.CodeBundle, results map[string]*llx.RawResult) string {
+	checksums := append(code.EntrypointChecksums(), code.DatapointChecksums()...)
+	for _, checksum := range checksums {
+		res, ok := results[checksum]
+		if !ok || res == nil {
+			return "cannot find result for this query"
+		}
+		if res.Data != nil && res.Data.Error != nil {
+			return res.Data.Error.Error()
+		}
+	}
+	return ""
+}
+
+// queriesByCodeID indexes all queries in the bundle by their code ID
+func queriesByCodeID(bundle *explorer.Bundle) map[string]*explorer.Mquery {
+	res := map[string]*explorer.Mquery{}
+	if bundle == nil {
+		return res
+	}
+
+	for i := range bundle.Queries {
+		query := bundle.Queries[i]
+		res[query.CodeId] = query
+	}
+	for i := range bundle.Packs {
+		pack := bundle.Packs[i]
+		for j := range pack.Queries {
+			query := pack.Queries[j]
+			res[query.CodeId] = query
+		}
+		for j := range pack.Groups {
+			group := pack.Groups[j]
+			for k := range group.Queries {
+				query := group.Queries[k]
+				res[query.CodeId] = query
+			}
+		}
+	}
+	return res
+}
+
+// sortedAssetMrns returns the MRNs of all assets in the collection in a stable order
+func sortedAssetMrns(data *explorer.ReportCollection) []string {
+	res := make([]string, 0, len(data.Assets))
+	for mrn := range data.Assets {
+		res = append(res, mrn)
+	}
+	sort.Strings(res)
+	return res
+}
@@ -29,6 +29,8 @@ const (
 	JSON
 	JUnit
 	CSV
+	NDJSON
+	SARIF
 )
 
 // Formats that are supported by the reporter
@@ -40,7 +42,10 @@ var Formats = map[string]Format{
 	"yaml":    YAML,
 	"yml":     YAML,
 	"json":    JSON,
+	"junit":   JUnit,
 	"csv":     CSV,
+	"ndjson":  NDJSON,
+	"sarif":   SARIF,
 }
 
 func AllFormats() string {
@@ -109,9 +114,17 @@ func (r *Reporter) Print(data *explorer.ReportCollection, out io.Writer) error {
 	case JSON:
 		w := shared.IOWriter{Writer: out}
 		return ReportCollectionToJSON(data, &w)
+	case JUnit:
+		w := shared.IOWriter{Writer: out}
+		return ReportCollectionToJunit(data, &w)
 	case CSV:
 		w := shared.IOWriter{Writer: out}
 		return ReportCollectionToCSV(data, &w)
+	case NDJSON:
+		return ReportCollectionToNdjson(data, out)
+	case SARIF:
+		w := shared.IOWriter{Writer: out}
+		return ReportCollectionToSarif(data, &w)
 	case YAML:
 		raw := bytes.Buffer{}
 		writer := shared.IOWriter{Writer: &raw}
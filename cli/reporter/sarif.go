@@ -0,0 +1,175 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/json"
+	"sort"
+
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/shared"
+	"go.mondoo.com/cnquery/utils/sarif"
+)
+
+// ReportCollectionToSarif writes the report collection as SARIF 2.1.0. Every
+// query becomes a rule and every query result on an asset becomes a result.
+// Results that contain file positions (e.g. from terraform) point to the
+// file and line, all results are linked to their asset via a logical location.
+func ReportCollectionToSarif(data *explorer.ReportCollection, out shared.OutputHelper) error {
+	run := sarif.Run{
+		Tool: sarif.Tool{Driver: sarif.Driver{
+			Name:           "cnquery",
+			Version:        cnquery.GetVersion(),
+			InformationURI: "https://github.com/mondoohq/cnquery",
+			Rules:          []sarif.Rule{},
+		}},
+		Invocations: []sarif.Invocation{{ExecutionSuccessful: true}},
+		Results:     []sarif.Result{},
+	}
+
+	if data != nil {
+		queries := queriesByCodeID(data.Bundle)
+		rules := map[string]int{}
+
+		for _, assetMrn := range sortedAssetMrns(data) {
+			asset := data.Assets[assetMrn]
+			assetLocation := sarif.LogicalLocation{Name: asset.Name, FullyQualifiedName: assetMrn, Kind: "asset"}
+
+			if errStatus, ok := data.Errors[assetMrn]; ok {
+				level := "warning"
+				if errStatus.ErrorCode().Category() == explorer.ErrorCategoryError {
+					level = "error"
+					run.Invocations[0].ExecutionSuccessful = false
+				}
+				run.Invocations[0].ToolExecutionNotifications = append(run.Invocations[0].ToolExecutionNotifications, sarif.Notification{
+					Level:     level,
+					Message:   sarif.Message{Text: errStatus.Message},
+					Locations: []sarif.Location{{LogicalLocations: []sarif.LogicalLocation{assetLocation}}},
+				})
+				continue
+			}
+
+			results := assetQueryResults(data.Reports[assetMrn], data.Resolved[assetMrn], queries)
+			for _, res := range results {
+				ruleID := res.Mrn()
+				if ruleID == "" {
+					ruleID = res.CodeID
+				}
+
+				idx, ok := rules[ruleID]
+				if !ok {
+					idx = len(run.Tool.Driver.Rules)
+					rules[ruleID] = idx
+					run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarif.Rule{
+						ID:               ruleID,
+						Name:             res.Title(),
+						ShortDescription: &sarif.Message{Text: res.Title()},
+						FullDescription:  &sarif.Message{Text: res.MQL},
+					})
+				}
+
+				result := sarif.Result{
+					RuleID:    ruleID,
+					RuleIndex: idx,
+					Level:     "note",
+					Message:   sarif.Message{Text: string(res.Data)},
+				}
+				if res.Error != "" {
+					result.Level = "error"
+					result.Message.Text = res.Error
+				}
+
+				positions := sarifPhysicalLocations(res.Data)
+				if len(positions) == 0 {
+					result.Locations = []sarif.Location{{LogicalLocations: []sarif.LogicalLocation{assetLocation}}}
+				}
+				for i := range positions {
+					result.Locations = append(result.Locations, sarif.Location{
+						PhysicalLocation: positions[i],
+						LogicalLocations: []sarif.LogicalLocation{assetLocation},
+					})
+				}
+
+				run.Results = append(run.Results, result)
+			}
+		}
+	}
+
+	raw, err := json.MarshalIndent(sarif.Log{
+		Schema:  sarif.Schema,
+		Version: sarif.Version,
+		Runs:    []sarif.Run{run},
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	out.Write(raw)
+	out.WriteString("\n")
+	return nil
+}
+
+// sarifPhysicalLocations finds all file positions in the JSON result data.
+// A file position is any object that has a path and a line.
+func sarifPhysicalLocations(data []byte) []*sarif.PhysicalLocation {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil
+	}
+
+	seen := map[sarifPosition]struct{}{}
+	collectSarifPositions(v, seen)
+	if len(seen) == 0 {
+		return nil
+	}
+
+	positions := make([]sarifPosition, 0, len(seen))
+	for pos := range seen {
+		positions = append(positions, pos)
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].path != positions[j].path {
+			return positions[i].path < positions[j].path
+		}
+		if positions[i].line != positions[j].line {
+			return positions[i].line < positions[j].line
+		}
+		return positions[i].column < positions[j].column
+	})
+
+	res := make([]*sarif.PhysicalLocation, len(positions))
+	for i, pos := range positions {
+		res[i] = &sarif.PhysicalLocation{
+			ArtifactLocation: sarif.ArtifactLocation{URI: pos.path},
+			Region:           &sarif.Region{StartLine: pos.line, StartColumn: pos.column},
+		}
+	}
+	return res
+}
+
+type sarifPosition struct {
+	path   string
+	line   int
+	column int
+}
+
+func collectSarifPositions(v interface{}, res map[sarifPosition]struct{}) {
+	switch x := v.(type) {
+	case []interface{}:
+		for i := range x {
+			collectSarifPositions(x[i], res)
+		}
+	case map[string]interface{}:
+		path, _ := x["path"].(string)
+		line, _ := x["line"].(float64)
+		if path != "" && line > 0 {
+			column, _ := x["column"].(float64)
+			res[sarifPosition{path: path, line: int(line), column: int(column)}] = struct{}{}
+		}
+		for _, child := range x {
+			collectSarifPositions(child, res)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/shared"
+	"go.mondoo.com/cnquery/utils/sarif"
+	"sigs.k8s.io/yaml"
+)
+
+func TestSarifExport(t *testing.T) {
+	data, err := os.ReadFile("testdata/kubernetes_report.yaml")
+	require.NoError(t, err)
+
+	var report *explorer.ReportCollection
+	err = yaml.Unmarshal(data, &report)
+	require.NoError(t, err)
+
+	var out strings.Builder
+	w := shared.IOWriter{Writer: &out}
+	err = ReportCollectionToSarif(report, &w)
+	require.NoError(t, err)
+
+	var log sarif.Log
+	err = json.Unmarshal([]byte(out.String()), &log)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	run := log.Runs[0]
+	assert.Equal(t, "cnquery", run.Tool.Driver.Name)
+	require.NotEmpty(t, run.Tool.Driver.Rules)
+	require.NotEmpty(t, run.Results)
+
+	for _, res := range run.Results {
+		require.Less(t, res.RuleIndex, len(run.Tool.Driver.Rules))
+		assert.Equal(t, run.Tool.Driver.Rules[res.RuleIndex].ID, res.RuleID)
+		require.NotEmpty(t, res.Locations)
+		assert.Equal(t, "asset", res.Locations[0].LogicalLocations[0].Kind)
+	}
+
+	// assets that don't match any query pack are only informational
+	require.Len(t, run.Invocations, 1)
+	assert.True(t, run.Invocations[0].ExecutionSuccessful)
+	assert.Len(t, run.Invocations[0].ToolExecutionNotifications, 3)
+}
+
+func TestSarifPhysicalLocations(t *testing.T) {
+	data := `{"terraform.resources":[` +
+		`{"start":{"path":"main.tf","line":12,"column":1}},` +
+		`{"start":{"path":"main.tf","line":3,"column":1}},` +
+		`{"name":"no position"}]}`
+
+	locations := sarifPhysicalLocations([]byte(data))
+	require.Len(t, locations, 2)
+	assert.Equal(t, "main.tf", locations[0].ArtifactLocation.URI)
+	assert.Equal(t, 3, locations[0].Region.StartLine)
+	assert.Equal(t, 12, locations[1].Region.StartLine)
+	assert.Equal(t, 1, locations[1].Region.StartColumn)
+
+	assert.Empty(t, sarifPhysicalLocations([]byte(`{"users.list":[{"name":"root"}]}`)))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/mitchellh/go-homedir"
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/cli/printer"
 	"go.mondoo.com/cnquery/cli/theme"
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/mql"
@@ -206,7 +207,10 @@ func (s *Shell) RunInteractive(cmd string) {
 	s.handleExit()
 }
 
-var helpResource = regexp.MustCompile(`help\s(.*)`)
+var (
+	helpResource = regexp.MustCompile(`help\s(.*)`)
+	explainQuery = regexp.MustCompile(`^\.explain(\s+--dot)?\s+(.*)`)
+)
 
 func (s *Shell) ExecCmd(cmd string) {
 	switch {
@@ -233,6 +237,14 @@ func (s *Shell) ExecCmd(cmd string) {
 	case helpResource.MatchString(cmd):
 		s.listFilteredResources(cmd)
 		return
+	case explainQuery.MatchString(cmd):
+		m := explainQuery.FindStringSubmatch(cmd)
+		format := "text"
+		if m[1] != "" {
+			format = "dot"
+		}
+		s.Explain(m[2], format)
+		return
 	default:
 		s.execQuery(cmd)
 	}
@@ -333,6 +345,46 @@ func (s *Shell) RunOnce(cmd string) (*llx.CodeBundle, map[string]*llx.RawResult,
 	return code, results, err
 }
 
+// Explain executes the query and prints how it was run: all chunks with
+// the providers they call and how long they took. The format is either
+// text, which also prints the results, or dot for a Graphviz graph.
+func (s *Shell) Explain(cmd string, format string) error {
+	s.resetPrintCache()
+
+	code, err := mqlc.Compile(cmd, nil, mqlc.NewConfig(s.Runtime.Schema(), s.features))
+	if err != nil {
+		fmt.Fprintln(s.out, s.Theme.Error("failed to compile: "+err.Error()))
+
+		if code != nil && code.Suggestions != nil {
+			fmt.Fprintln(s.out, formatSuggestions(code.Suggestions, s.Theme))
+		}
+		return err
+	}
+
+	stats := llx.NewExecutionStats()
+	results, err := mql.ExecuteCodeWithStats(s.Runtime, code, nil, s.features, stats)
+	if err != nil {
+		return err
+	}
+
+	var lookup printer.ProviderLookup
+	if x, ok := s.Runtime.(*providers.Runtime); ok {
+		lookup = func(resource string, field string) string {
+			name, _ := x.LookupProvider(resource, field)
+			return name
+		}
+	}
+
+	if format == "dot" {
+		fmt.Fprint(s.out, printer.ExplainDot(code, lookup, stats))
+		return nil
+	}
+
+	s.PrintResults(code, results)
+	fmt.Fprint(s.out, s.Theme.PolicyPrinter.Explain(code, lookup, stats))
+	return nil
+}
+
 func (s *Shell) PrintResults(code *llx.CodeBundle, results map[string]*llx.RawResult) {
 	printedResult := s.Theme.PolicyPrinter.Results(code, results)
 
@@ -4,10 +4,12 @@
 package shell_test
 
 import (
+	"bytes"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mondoo.com/cnquery/cli/shell"
 	"go.mondoo.com/cnquery/providers-sdk/v1/testutils"
 	"go.mondoo.com/cnquery/providers/mock"
@@ -70,3 +72,27 @@ func TestShell_Centos8(t *testing.T) {
 		shell.RunOnce("platform { title name release arch }")
 	}, "should not panic on partial queries")
 }
+
+func TestShell_Explain(t *testing.T) {
+	var out bytes.Buffer
+	runtime := testutils.LinuxMock()
+	shell, err := shell.New(runtime, shell.WithOutput(&out))
+	require.NoError(t, err)
+
+	require.NoError(t, shell.Explain("mondoo { version }", "text"))
+	res := out.String()
+	assert.Contains(t, res, "-> block 2")
+	assert.Contains(t, res, "provider: core (mondoo.version)")
+	assert.Contains(t, res, "calls: 1")
+
+	out.Reset()
+	require.NoError(t, shell.Explain("mondoo { version }", "dot"))
+	res = out.String()
+	assert.Contains(t, res, "digraph mql {")
+	assert.Contains(t, res, `"1.2" -> "2.1" [style=dotted, lhead=cluster_2];`)
+	assert.Contains(t, res, `"2.1" [label="1: context (mondoo)"];`)
+
+	assert.NotPanics(t, func() {
+		shell.ExecCmd(".explain --dot mondoo.version")
+	}, "should not panic on explain command")
+}
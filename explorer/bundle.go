@@ -230,9 +230,27 @@ func (p *Bundle) Compile(ctx context.Context, schema llx.Schema) (*BundleMap, er
 		uid2mrn:     map[string]string{},
 		lookupProp:  map[string]PropertyRef{},
 		lookupQuery: map[string]*Mquery{},
+		functions:   map[*QueryPack]*PackFunctions{},
 		schema:      schema,
 	}
 
+	// functions are parsed first, so that we can tell when MQL outside
+	// of query packs calls them
+	for i := range p.Packs {
+		pack := p.Packs[i]
+		functions, err := NewPackFunctions(pack)
+		if err != nil {
+			return nil, multierr.Wrap(err, "failed to compile functions in query pack "+packID(pack))
+		}
+		cache.functions[pack] = functions
+	}
+
+	for i := range p.Props {
+		if err := cache.checkPackFunctions(p.Props[i].Mql); err != nil {
+			return nil, multierr.Wrap(err, "failed to compile property "+p.Props[i].Uid)
+		}
+	}
+
 	if err := cache.compileQueries(p.Queries, nil); err != nil {
 		return nil, err
 	}
@@ -252,6 +270,8 @@ func (p *Bundle) Compile(ctx context.Context, schema llx.Schema) (*BundleMap, er
 			return nil, multierr.Wrap(err, "failed to refresh query pack "+pack.Mrn)
 		}
 
+		functions := cache.functions[pack]
+		functions.linkFilters(pack.Filters)
 		if err = pack.Filters.Compile(ownerMrn, schema); err != nil {
 			return nil, multierr.Wrap(err, "failed to compile querypack filters")
 		}
@@ -265,6 +285,7 @@ func (p *Bundle) Compile(ctx context.Context, schema llx.Schema) (*BundleMap, er
 			group := pack.Groups[i]
 
 			// When filters are initially added they haven't been compiled
+			functions.linkFilters(group.Filters)
 			if err = group.Filters.Compile(ownerMrn, schema); err != nil {
 				return nil, multierr.Wrap(err, "failed to compile querypack filters")
 			}
@@ -284,9 +305,11 @@ type bundleCache struct {
 	lookupQuery map[string]*Mquery
 	lookupProp  map[string]PropertyRef
 	uid2mrn     map[string]string
-	bundle      *Bundle
-	errors      []error
-	schema      llx.Schema
+	// functions defined in each query pack
+	functions map[*QueryPack]*PackFunctions
+	bundle    *Bundle
+	errors    []error
+	schema    llx.Schema
 }
 
 type PropertyRef struct {
@@ -311,6 +334,45 @@ func (c *bundleCache) error() error {
 	return errors.New(msg.String())
 }
 
+// checkPackFunctions makes sure that MQL outside of query packs doesn't
+// call their functions, which it can't be linked to
+func (c *bundleCache) checkPackFunctions(mqls ...string) error {
+	for _, pack := range c.bundle.Packs {
+		functions, ok := c.functions[pack]
+		if !ok {
+			continue
+		}
+		for _, mql := range mqls {
+			if name, ok := functions.firstCall(mql); ok {
+				return errors.New("function '" + name + "' can only be called in query pack " + packID(pack) + ", which defines it")
+			}
+		}
+	}
+	return nil
+}
+
+// queryMql returns the MQL of the query, its properties, and its filters
+func queryMql(query *Mquery) []string {
+	res := []string{query.Mql}
+	for i := range query.Props {
+		res = append(res, query.Props[i].Mql)
+	}
+	if query.Filters != nil {
+		for _, filter := range query.Filters.Items {
+			res = append(res, filter.Mql)
+		}
+	}
+	return res
+}
+
+// packID is the MRN of the query pack or its UID, if it has no MRN yet
+func packID(pack *QueryPack) string {
+	if pack.Mrn != "" {
+		return pack.Mrn
+	}
+	return pack.Uid
+}
+
 func (c *bundleCache) compileQueries(queries []*Mquery, pack *QueryPack) error {
 	for i := range queries {
 		c.precompileQuery(queries[i], pack)
@@ -367,6 +429,19 @@ func (c *bundleCache) precompileQuery(query *Mquery, pack *QueryPack) {
 		c.lookupQuery[query.Mrn] = query
 	}
 
+	// queries in a pack can call the functions it defines, which includes
+	// their properties and filters
+	if functions, ok := c.functions[pack]; ok {
+		query.Mql = functions.Link(query.Mql)
+		for i := range query.Props {
+			query.Props[i].Mql = functions.Link(query.Props[i].Mql)
+		}
+		functions.linkFilters(query.Filters)
+	} else if err := c.checkPackFunctions(queryMql(query)...); err != nil {
+		c.errors = append(c.errors, multierr.Wrap(err, "failed to compile query '"+query.Mrn+"'"))
+		return
+	}
+
 	// ensure MRNs for properties
 	for i := range query.Props {
 		if err := c.compileProp(query.Props[i]); err != nil {
@@ -375,6 +450,11 @@ func (c *bundleCache) precompileQuery(query *Mquery, pack *QueryPack) {
 		}
 	}
 
+	if _, err := query.ExecutionTimeout(); err != nil {
+		c.errors = append(c.errors, multierr.Wrap(err, "failed to validate query '"+query.Mrn+"'"))
+		return
+	}
+
 	// filters have no dependencies, so we can compile them early
 	if err := query.Filters.Compile(c.ownerMrn, c.schema); err != nil {
 		c.errors = append(c.errors, errors.New("failed to compile filters for query "+query.Mrn))
@@ -0,0 +1,186 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package explorer
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mondoo.com/cnquery/mqlc/parser"
+	"gopkg.in/yaml.v3"
+)
+
+// The canonical order of fields in bundles. Fields that aren't listed
+// follow in their original order.
+var (
+	bundleFieldOrder = []string{"owner_mrn", "packs", "queries", "props"}
+	packFieldOrder   = []string{
+		"uid", "mrn", "name", "version", "license", "authors", "tags", "summary", "docs",
+		"filters", "props", "functions", "queries", "groups",
+	}
+	groupFieldOrder = []string{"title", "filters", "queries"}
+	queryFieldOrder = []string{
+		"uid", "mrn", "title", "desc", "impact", "timeout", "action", "tags", "docs", "refs",
+		"variants", "filters", "props", "mql", "query",
+	}
+	propFieldOrder = []string{"uid", "mrn", "title", "desc", "type", "for", "mql"}
+)
+
+// FormatBundle rewrites a query pack bundle in its canonical style. Fields
+// are sorted in a fixed order and all MQL in queries, props, filters, and
+// functions is formatted. Comments are kept.
+func FormatBundle(data []byte) ([]byte, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, errors.New("failed to parse bundle: " + err.Error())
+	}
+	if len(root.Content) == 0 {
+		return data, nil
+	}
+
+	doc := root.Content[0]
+	if doc.Kind != yaml.MappingNode {
+		return nil, errors.New("failed to parse bundle: expected a map of packs and queries")
+	}
+
+	f := &bundleFormatter{}
+	sortFields(doc, bundleFieldOrder)
+	f.formatProps(YamlValue(doc, "props"))
+	for _, query := range YamlItems(YamlValue(doc, "queries")) {
+		f.formatQuery(query)
+	}
+	for _, pack := range YamlItems(YamlValue(doc, "packs")) {
+		f.formatPack(pack)
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	var res bytes.Buffer
+	enc := yaml.NewEncoder(&res)
+	enc.SetIndent(2)
+	if err := enc.Encode(&root); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return res.Bytes(), nil
+}
+
+// sortFields sorts the keys of a mapping node by the order of fields
+func sortFields(node *yaml.Node, order []string) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+
+	rank := func(key string) int {
+		for i := range order {
+			if order[i] == key {
+				return i
+			}
+		}
+		return len(order)
+	}
+
+	pairs := make([][2]*yaml.Node, len(node.Content)/2)
+	for i := range pairs {
+		pairs[i] = [2]*yaml.Node{node.Content[2*i], node.Content[2*i+1]}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return rank(pairs[i][0].Value) < rank(pairs[j][0].Value)
+	})
+	for i := range pairs {
+		node.Content[2*i] = pairs[i][0]
+		node.Content[2*i+1] = pairs[i][1]
+	}
+}
+
+type bundleFormatter struct {
+	// the first error that occurred, so we can report it with its line
+	err error
+}
+
+func (f *bundleFormatter) formatPack(pack *yaml.Node) {
+	sortFields(pack, packFieldOrder)
+	f.formatFilters(YamlValue(pack, "filters"))
+	f.formatProps(YamlValue(pack, "props"))
+	for _, function := range YamlItems(YamlValue(pack, "functions")) {
+		f.formatMql(function)
+	}
+	for _, query := range YamlItems(YamlValue(pack, "queries")) {
+		f.formatQuery(query)
+	}
+	for _, group := range YamlItems(YamlValue(pack, "groups")) {
+		sortFields(group, groupFieldOrder)
+		f.formatFilters(YamlValue(group, "filters"))
+		for _, query := range YamlItems(YamlValue(group, "queries")) {
+			f.formatQuery(query)
+		}
+	}
+}
+
+func (f *bundleFormatter) formatQuery(query *yaml.Node) {
+	sortFields(query, queryFieldOrder)
+	f.formatFilters(YamlValue(query, "filters"))
+	f.formatProps(YamlValue(query, "props"))
+	f.formatMql(YamlValue(query, "mql"))
+	f.formatMql(YamlValue(query, "query"))
+	for _, variant := range YamlItems(YamlValue(query, "variants")) {
+		sortFields(variant, queryFieldOrder)
+	}
+}
+
+func (f *bundleFormatter) formatProps(node *yaml.Node) {
+	for _, prop := range YamlItems(node) {
+		sortFields(prop, propFieldOrder)
+		f.formatMql(YamlValue(prop, "mql"))
+	}
+}
+
+// formatFilters handles filters, which are either MQL or a list of
+// MQL strings and queries
+func (f *bundleFormatter) formatFilters(node *yaml.Node) {
+	if node == nil {
+		return
+	}
+	if node.Kind == yaml.ScalarNode {
+		f.formatMql(node)
+		return
+	}
+	for _, item := range YamlItems(node) {
+		if item.Kind == yaml.ScalarNode {
+			f.formatMql(item)
+		} else {
+			f.formatQuery(item)
+		}
+	}
+}
+
+func (f *bundleFormatter) formatMql(node *yaml.Node) {
+	if node == nil || node.Kind != yaml.ScalarNode || f.err != nil {
+		return
+	}
+
+	mql, err := parser.Format(node.Value)
+	if err != nil {
+		f.err = errors.New("failed to format MQL in line " + strconv.Itoa(node.Line) + ": " + err.Error())
+		return
+	}
+	if mql == strings.TrimSpace(node.Value) {
+		return
+	}
+
+	node.Tag = "!!str"
+	if strings.Contains(mql, "\n") {
+		node.Style = yaml.LiteralStyle
+	}
+	if node.Style&yaml.LiteralStyle != 0 {
+		mql += "\n"
+	}
+	node.Value = mql
+}
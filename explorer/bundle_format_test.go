@@ -0,0 +1,55 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package explorer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatBundle(t *testing.T) {
+	data := `# my pack
+packs:
+- queries:
+  - mql: |
+      packages.where(name==/ssh/)
+    # the title
+    title: SSH packages
+    uid: ssh
+  filters: asset.family.contains('unix')
+  name: Example
+  uid: example
+  props:
+  - mql: 3
+    uid: minLength
+`
+	res, err := FormatBundle([]byte(data))
+	require.NoError(t, err)
+	assert.Equal(t, `# my pack
+packs:
+  - uid: example
+    name: Example
+    filters: asset.family.contains("unix")
+    props:
+      - uid: minLength
+        mql: 3
+    queries:
+      - uid: ssh
+        # the title
+        title: SSH packages
+        mql: |
+          packages.where(name == /ssh/)
+`, string(res))
+
+	again, err := FormatBundle(res)
+	require.NoError(t, err)
+	assert.Equal(t, string(res), string(again))
+
+	t.Run("invalid MQL", func(t *testing.T) {
+		_, err := FormatBundle([]byte("queries:\n  - uid: a\n    mql: users.where(\n"))
+		assert.EqualError(t, err, "failed to format MQL in line 3: incomplete query, missing closing ')' at <source>:1:13")
+	})
+}
@@ -4,11 +4,13 @@
 package explorer
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers"
 )
 
 func TestBundleLoad(t *testing.T) {
@@ -34,3 +36,107 @@ func TestBundleLoad(t *testing.T) {
 		assert.True(t, len(bundle.Packs[0].Queries[0].Uid) > 0)
 	})
 }
+
+func TestBundleCompile_Timeout(t *testing.T) {
+	bundle, err := BundleFromYAML([]byte(`
+packs:
+  - uid: timeouts
+    queries:
+      - uid: slow
+        mql: asset.name
+        timeout: soon
+`))
+	require.NoError(t, err)
+
+	_, err = bundle.Compile(context.Background(), providers.DefaultRuntime().Schema())
+	assert.ErrorContains(t, err, "invalid timeout 'soon'")
+}
+
+func TestBundleCompile_Functions(t *testing.T) {
+	bundle, err := BundleFromYAML([]byte(`
+packs:
+  - uid: functions
+    functions:
+      - |
+        def isLong(s: string) { s.length > 3 }
+      - |
+        def named(s: string) { isLong(s) }
+      - def unused() { 1 }
+    queries:
+      - uid: name
+        mql: named(asset.name)
+      - uid: plain
+        mql: asset.name
+`))
+	require.NoError(t, err)
+
+	_, err = bundle.Compile(context.Background(), providers.DefaultRuntime().Schema())
+	require.NoError(t, err)
+
+	queries := bundle.Packs[0].Queries
+	assert.Equal(t, "def isLong(s: string) { s.length > 3 }\ndef named(s: string) { isLong(s) }\nnamed(asset.name)", queries[0].Mql)
+	assert.Equal(t, "asset.name", queries[1].Mql)
+
+	t.Run("invalid functions", func(t *testing.T) {
+		bundle, err := BundleFromYAML([]byte(`
+packs:
+  - uid: functions
+    functions:
+      - asset.name
+`))
+		require.NoError(t, err)
+
+		_, err = bundle.Compile(context.Background(), providers.DefaultRuntime().Schema())
+		assert.ErrorContains(t, err, "may only contain function definitions")
+	})
+
+	t.Run("filters and properties", func(t *testing.T) {
+		bundle, err := BundleFromYAML([]byte(`
+packs:
+  - uid: functions
+    functions:
+      - def isLinux() { asset.family.contains("linux") }
+    filters:
+      - isLinux()
+    queries:
+      - uid: name
+        mql: asset.name == props.name
+        filters: isLinux()
+        props:
+          - uid: name
+            mql: isLinux() && "linux"
+`))
+		require.NoError(t, err)
+
+		_, err = bundle.Compile(context.Background(), providers.DefaultRuntime().Schema())
+		require.NoError(t, err)
+
+		pack := bundle.Packs[0]
+		require.Len(t, pack.Filters.Items, 1)
+		for _, filter := range pack.Filters.Items {
+			assert.Equal(t, "def isLinux() { asset.family.contains(\"linux\") }\nisLinux()", filter.Mql)
+		}
+		query := pack.Queries[0]
+		require.Len(t, query.Filters.Items, 1)
+		assert.Equal(t, "def isLinux() { asset.family.contains(\"linux\") }\nisLinux() && \"linux\"", query.Props[0].Mql)
+		for _, filter := range query.Filters.Items {
+			assert.Equal(t, "def isLinux() { asset.family.contains(\"linux\") }\nisLinux()", filter.Mql)
+		}
+	})
+
+	t.Run("outside of query packs", func(t *testing.T) {
+		bundle, err := BundleFromYAML([]byte(`
+queries:
+  - uid: shared
+    mql: isLinux()
+packs:
+  - uid: functions
+    functions:
+      - def isLinux() { asset.family.contains("linux") }
+`))
+		require.NoError(t, err)
+
+		_, err = bundle.Compile(context.Background(), providers.DefaultRuntime().Schema())
+		assert.ErrorContains(t, err, "function 'isLinux' can only be called in query pack functions, which defines it")
+	})
+}
@@ -4,10 +4,14 @@
 package explorer
 
 import (
+	"context"
+	"errors"
+
 	"go.mondoo.com/ranger-rpc/codes"
 	"go.mondoo.com/ranger-rpc/status"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	spb "google.golang.org/genproto/googleapis/rpc/status"
+	grpcstatus "google.golang.org/grpc/status"
 )
 
 // cnquery codes start at 100 to avoid conflicts with GRPC and Ranger RPC codes
@@ -79,9 +83,9 @@ func (e ErrorStatusCode) Category() ErrorCategory {
 func NewErrorStatus(err error) *ErrorStatus {
 	s, ok := status.FromError(err)
 	if !ok {
-		// not status error - just add it as a generic error
+		// not status error - add it with the code of the error it wraps
 		return &ErrorStatus{
-			Code:    int32(codes.Unknown),
+			Code:    int32(wrappedErrorCode(err)),
 			Message: err.Error(),
 		}
 	}
@@ -101,6 +105,33 @@ func NewErrorStatus(err error) *ErrorStatus {
 	}
 }
 
+// wrappedErrorCode walks the chain of wrapped errors, e.g. a provider
+// connect error wrapped with some context, and returns the first code it
+// finds in there
+func wrappedErrorCode(err error) codes.Code {
+	for err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return codes.DeadlineExceeded
+		}
+		if errors.Is(err, context.Canceled) {
+			return codes.Canceled
+		}
+		if s, ok := grpcstatus.FromError(err); ok {
+			return codes.Code(s.Code())
+		}
+
+		switch x := err.(type) {
+		case interface{ Cause() error }:
+			err = x.Cause()
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		default:
+			return codes.Unknown
+		}
+	}
+	return codes.Unknown
+}
+
 func (e *ErrorStatus) StatusProto() *spb.Status {
 	return &spb.Status{
 		Code:    e.Code,
@@ -6,6 +6,7 @@ package executor
 import (
 	"context"
 	"errors"
+	"sort"
 	"sync"
 	"time"
 
@@ -13,12 +14,17 @@ import (
 	"go.mondoo.com/cnquery/cli/progress"
 	"go.mondoo.com/cnquery/explorer"
 	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers"
 	"go.mondoo.com/cnquery/utils/multierr"
 )
 
+// RunExecutionJob runs all queries of the job. Queries that don't finish
+// within the query timeout are canceled, unless they set their own timeout.
+// A query timeout of 0 means queries can run as long as they need.
 func RunExecutionJob(
 	runtime llx.Runtime, collectorSvc explorer.QueryConductor, assetMrn string,
 	job *explorer.ExecutionJob, features cnquery.Features, progressReporter progress.Progress,
+	queryTimeout time.Duration,
 ) (*instance, error) {
 	// We are setting a sensible default timeout for jobs here. This will need
 	// user-configuration.
@@ -28,6 +34,7 @@ func RunExecutionJob(
 	res.assetMrn = assetMrn
 	res.collector = collectorSvc
 	res.datapoints = job.Datapoints
+	res.queryTimeout = queryTimeout
 
 	return res, res.runCode(job.Queries, timeout)
 }
@@ -54,7 +61,10 @@ func RunFilterQueries(runtime llx.Runtime, queries []*explorer.Mquery, timeout t
 		return nil, errs
 	}
 
+	// every filter query gets the full timeout, so that a single query
+	// that hangs can't hold up all others
 	instance := newInstance(runtime, nil)
+	instance.queryTimeout = timeout
 	err := instance.runCode(equeries, timeout)
 	if err != nil {
 		return nil, []error{err}
@@ -137,7 +147,7 @@ func (e *instance) runCode(queries map[string]*explorer.ExecutionQuery, timeout
 			continue
 		}
 
-		if err := e.runQuery(query.Code, nil); err != nil {
+		if err := e.runQuery(query, nil); err != nil {
 			errs.Add(err)
 		}
 	}
@@ -171,6 +181,26 @@ type instance struct {
 	progressReporter progress.Progress
 	collector        explorer.QueryConductor
 	assetMrn         string
+	// queryTimeout is used for all queries that don't set their own timeout
+	queryTimeout time.Duration
+	// costs of all queries that ran, mapped via CodeID
+	costs map[string]*explorer.QueryCost
+	// running queries, with the ones they triggered on top
+	running []*runningQuery
+}
+
+// runningQuery tracks the cost of a query while it runs. Queries may
+// trigger other queries, which are only charged to themselves.
+type runningQuery struct {
+	start     time.Time
+	calls     uint32
+	cacheHits uint32
+	nested    explorer.QueryCost
+}
+
+// profiler is implemented by runtimes that account for their calls to providers
+type profiler interface {
+	Profile() *providers.Profile
 }
 
 func newInstance(runtime llx.Runtime, progressReporter progress.Progress) *instance {
@@ -189,11 +219,33 @@ func newInstance(runtime llx.Runtime, progressReporter progress.Progress) *insta
 		done:             make(chan struct{}),
 		progressReporter: progressReporter,
 		assetMrn:         runtime.AssetMRN(),
+		costs:            map[string]*explorer.QueryCost{},
 	}
 }
 
-func (e *instance) runQuery(bundle *llx.CodeBundle, props map[string]*llx.Primitive) error {
-	exec, err := llx.NewExecutorV2(bundle.CodeV2, e.runtime, props, e.collect)
+func (e *instance) runQuery(query *explorer.ExecutionQuery, props map[string]*llx.Primitive) error {
+	bundle := query.Code
+	running := e.startQuery()
+	defer e.stopQuery(query, running)
+
+	runtime := e.runtime
+	timeout := e.queryTimeout
+	if query.Timeout > 0 {
+		timeout = time.Duration(query.Timeout) * time.Millisecond
+	}
+	if x, ok := runtime.(llx.ContextRuntime); ok && timeout > 0 {
+		ctx, cancel := context.WithCancelCause(context.Background())
+		timer := time.AfterFunc(timeout, func() {
+			cancel(&llx.QueryTimeoutError{Timeout: timeout})
+		})
+		defer func() {
+			timer.Stop()
+			cancel(nil)
+		}()
+		runtime = x.WithContext(ctx)
+	}
+
+	exec, err := llx.NewExecutorV2(bundle.CodeV2, runtime, props, e.collect)
 	if err != nil {
 		return err
 	}
@@ -207,6 +259,74 @@ func (e *instance) runQuery(bundle *llx.CodeBundle, props map[string]*llx.Primit
 	return nil
 }
 
+func (e *instance) providerCalls() (uint32, uint32) {
+	if x, ok := e.runtime.(profiler); ok {
+		return x.Profile().Totals()
+	}
+	return 0, 0
+}
+
+func (e *instance) startQuery() *runningQuery {
+	calls, cacheHits := e.providerCalls()
+	res := &runningQuery{start: time.Now(), calls: calls, cacheHits: cacheHits}
+
+	e.mutex.Lock()
+	e.running = append(e.running, res)
+	e.mutex.Unlock()
+	return res
+}
+
+func (e *instance) stopQuery(query *explorer.ExecutionQuery, running *runningQuery) {
+	duration := time.Since(running.start).Nanoseconds()
+	calls, cacheHits := e.providerCalls()
+	calls -= running.calls
+	cacheHits -= running.cacheHits
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.running = e.running[:len(e.running)-1]
+	if n := len(e.running); n != 0 {
+		parent := &e.running[n-1].nested
+		parent.Duration += duration
+		parent.Calls += calls
+		parent.CacheHits += cacheHits
+	}
+
+	codeID := query.Code.CodeV2.Id
+	cost, ok := e.costs[codeID]
+	if !ok {
+		mql := query.Query
+		if mql == "" {
+			mql = query.Code.Source
+		}
+		cost = &explorer.QueryCost{CodeId: codeID, Mql: mql}
+		e.costs[codeID] = cost
+	}
+	cost.Duration += duration - running.nested.Duration
+	cost.Calls += calls - running.nested.Calls
+	cost.CacheHits += cacheHits - running.nested.CacheHits
+}
+
+// QueryCosts returns the cost of all queries that ran, the most expensive
+// ones first. Calls to providers are only counted if the runtime is profiled.
+func (e *instance) QueryCosts() []*explorer.QueryCost {
+	e.mutex.Lock()
+	res := make([]*explorer.QueryCost, 0, len(e.costs))
+	for _, cost := range e.costs {
+		res = append(res, cost)
+	}
+	e.mutex.Unlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Duration != res[j].Duration {
+			return res[i].Duration > res[j].Duration
+		}
+		return res[i].CodeId < res[j].CodeId
+	})
+	return res
+}
+
 func (e *instance) WaitUntilDone(timeout time.Duration) error {
 	select {
 	case <-e.done:
@@ -343,7 +463,7 @@ func (e *instance) collect(res *llx.RawResult) {
 				break
 			}
 
-			err = e.runQuery(query.Code, props)
+			err = e.runQuery(query, props)
 			if err != nil {
 				fatalErr = err
 				break
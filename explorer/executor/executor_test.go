@@ -0,0 +1,99 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers"
+)
+
+// slowRuntime serves all asset fields, except for the platform and kind,
+// which never return unless the query is canceled
+type slowRuntime struct {
+	llx.Runtime
+	schema llx.Schema
+	ctx    context.Context
+}
+
+func (r *slowRuntime) AssetMRN() string                   { return "//asset" }
+func (r *slowRuntime) Schema() llx.Schema                 { return r.schema }
+func (r *slowRuntime) Unregister(watcherUID string) error { return nil }
+
+func (r *slowRuntime) CreateResource(name string, args map[string]*llx.Primitive) (llx.Resource, error) {
+	return &llx.MockResource{Name: name}, nil
+}
+
+func (r *slowRuntime) WatchAndUpdate(resource llx.Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
+	if field != "platform" && field != "kind" {
+		callback("value", nil)
+		return nil
+	}
+
+	<-r.ctx.Done()
+	return context.Cause(r.ctx)
+}
+
+func (r *slowRuntime) WithContext(ctx context.Context) llx.Runtime {
+	return &slowRuntime{schema: r.schema, ctx: ctx}
+}
+
+func TestQueryTimeout(t *testing.T) {
+	runtime := &slowRuntime{schema: providers.DefaultRuntime().Schema(), ctx: context.Background()}
+
+	slow := MustCompile("asset.platform")
+	fast := MustCompile("asset.name")
+	custom := MustCompile("asset.kind == 'container'")
+	queries := map[string]*explorer.ExecutionQuery{
+		slow.CodeV2.Id:   {Code: slow},
+		fast.CodeV2.Id:   {Code: fast},
+		custom.CodeV2.Id: {Code: custom, Timeout: 10},
+	}
+
+	e := newInstance(runtime, nil)
+	e.queryTimeout = 50 * time.Millisecond
+	require.NoError(t, e.runCode(queries, time.Second))
+	require.NoError(t, e.WaitUntilDone(time.Second))
+
+	res := e.results[MustGetOneDatapoint(slow)]
+	require.NotNil(t, res)
+	assert.EqualError(t, res.Data.Error, "query timed out after 50ms")
+
+	res = e.results[MustGetOneDatapoint(fast)]
+	require.NotNil(t, res)
+	assert.NoError(t, res.Data.Error)
+	assert.Equal(t, "value", res.Data.Value)
+
+	res = e.results[MustGetOneDatapoint(custom)]
+	require.NotNil(t, res)
+	assert.EqualError(t, res.Data.Error, "query timed out after 10ms")
+}
+
+func TestQueryCosts(t *testing.T) {
+	runtime := &slowRuntime{schema: providers.DefaultRuntime().Schema(), ctx: context.Background()}
+
+	slow := MustCompile("asset.platform")
+	fast := MustCompile("asset.name")
+	queries := map[string]*explorer.ExecutionQuery{
+		slow.CodeV2.Id: {Query: "asset.platform", Code: slow, Timeout: 20},
+		fast.CodeV2.Id: {Code: fast},
+	}
+
+	e := newInstance(runtime, nil)
+	require.NoError(t, e.runCode(queries, time.Second))
+
+	costs := e.QueryCosts()
+	require.Len(t, costs, 2)
+	assert.Equal(t, slow.CodeV2.Id, costs[0].CodeId)
+	assert.Equal(t, "asset.platform", costs[0].Mql)
+	assert.GreaterOrEqual(t, costs[0].Duration, (20 * time.Millisecond).Nanoseconds())
+	assert.Equal(t, "asset.name", costs[1].Mql)
+	assert.Empty(t, e.running)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package explorer
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/mqlc/parser"
+)
+
+// PackFunctions are the MQL functions that a query pack defines.
+// Queries are linked to the functions they call, by adding their
+// definitions to the query's MQL. This way queries remain self-contained
+// and compile to the same code wherever they are used.
+type PackFunctions struct {
+	// all function definitions in the order of the query pack
+	defs []packFunction
+	// function name => index of its definition
+	names map[string]int
+}
+
+type packFunction struct {
+	mql string
+	// identifiers that are used in this function definition, which
+	// may include calls to other functions
+	idents map[string]struct{}
+}
+
+// NewPackFunctions parses and checks the functions of a query pack
+func NewPackFunctions(pack *QueryPack) (*PackFunctions, error) {
+	res := &PackFunctions{
+		names: map[string]int{},
+	}
+
+	for i := range pack.Functions {
+		mql := strings.TrimSpace(mqlc.Dedent(pack.Functions[i]))
+		ast, err := parser.Parse(mql)
+		if err != nil {
+			return nil, errors.New("failed to parse function #" + strconv.Itoa(i+1) + ": " + err.Error())
+		}
+
+		defined := definedFunctions(ast.Expressions)
+		if len(defined) == 0 || len(defined) != len(ast.Expressions) {
+			return nil, errors.New("function #" + strconv.Itoa(i+1) + " may only contain function definitions, e.g. def name(arg: type) { ... }")
+		}
+
+		for _, name := range defined {
+			if _, ok := res.names[name]; ok {
+				return nil, errors.New("function '" + name + "' is defined more than once")
+			}
+			res.names[name] = len(res.defs)
+		}
+
+		idents := map[string]struct{}{}
+		collectIdents(ast.Expressions, idents)
+		res.defs = append(res.defs, packFunction{mql: mql, idents: idents})
+	}
+
+	return res, nil
+}
+
+// Link adds all functions to the MQL that it calls directly or indirectly.
+// Functions that are defined in the MQL itself take precedence. The
+// definitions are put in front of the unchanged MQL, so positions in it
+// only move by the lines that were added.
+func (p *PackFunctions) Link(mql string) string {
+	idents, own := p.idents(mql)
+	needed := map[int]struct{}{}
+	var add func(idents map[string]struct{})
+	add = func(idents map[string]struct{}) {
+		for ident := range idents {
+			if _, ok := own[ident]; ok {
+				continue
+			}
+			idx, ok := p.names[ident]
+			if !ok {
+				continue
+			}
+			if _, ok := needed[idx]; ok {
+				continue
+			}
+			needed[idx] = struct{}{}
+			add(p.defs[idx].idents)
+		}
+	}
+	add(idents)
+
+	if len(needed) == 0 {
+		return mql
+	}
+
+	var res strings.Builder
+	for i := range p.defs {
+		if _, ok := needed[i]; ok {
+			res.WriteString(p.defs[i].mql)
+			res.WriteString("\n")
+		}
+	}
+	res.WriteString(mql)
+	return res.String()
+}
+
+// linkFilters links all filters to the functions they call
+func (p *PackFunctions) linkFilters(filters *Filters) {
+	if filters == nil {
+		return
+	}
+	for _, filter := range filters.Items {
+		filter.Mql = p.Link(filter.Mql)
+	}
+}
+
+// idents returns all identifiers in the MQL and the functions it defines
+func (p *PackFunctions) idents(mql string) (map[string]struct{}, map[string]struct{}) {
+	if len(p.defs) == 0 {
+		return nil, nil
+	}
+
+	ast, err := parser.Parse(mqlc.Dedent(mql))
+	if err != nil {
+		// the compiler will report this error
+		return nil, nil
+	}
+
+	own := map[string]struct{}{}
+	for _, name := range definedFunctions(ast.Expressions) {
+		own[name] = struct{}{}
+	}
+
+	idents := map[string]struct{}{}
+	collectIdents(ast.Expressions, idents)
+	return idents, own
+}
+
+// firstCall returns the first function of the pack that the MQL calls
+// directly, if any
+func (p *PackFunctions) firstCall(mql string) (string, bool) {
+	idents, own := p.idents(mql)
+	var res []string
+	for ident := range idents {
+		if _, ok := own[ident]; ok {
+			continue
+		}
+		if _, ok := p.names[ident]; ok {
+			res = append(res, ident)
+		}
+	}
+	if len(res) == 0 {
+		return "", false
+	}
+	sort.Strings(res)
+	return res[0], true
+}
+
+func definedFunctions(expressions []*parser.Expression) []string {
+	var res []string
+	for i := range expressions {
+		operand := expressions[i].Operand
+		if operand == nil || operand.Value == nil || operand.Value.Ident == nil || *operand.Value.Ident != "def" {
+			continue
+		}
+		if len(operand.Calls) == 0 || operand.Calls[0].Ident == nil {
+			continue
+		}
+		res = append(res, *operand.Calls[0].Ident)
+	}
+	return res
+}
+
+// collectIdents adds all identifiers in these expressions to res
+func collectIdents(expressions []*parser.Expression, res map[string]struct{}) {
+	for i := range expressions {
+		expression := expressions[i]
+		if expression == nil {
+			continue
+		}
+		collectOperandIdents(expression.Operand, res)
+		for j := range expression.Operations {
+			collectOperandIdents(expression.Operations[j].Operand, res)
+		}
+	}
+}
+
+func collectOperandIdents(operand *parser.Operand, res map[string]struct{}) {
+	if operand == nil {
+		return
+	}
+
+	if v := operand.Value; v != nil {
+		if v.Ident != nil {
+			res[*v.Ident] = struct{}{}
+		}
+		collectIdents(v.Array, res)
+		for _, exp := range v.Map {
+			collectIdents([]*parser.Expression{exp}, res)
+		}
+	}
+
+	for i := range operand.Calls {
+		call := operand.Calls[i]
+		if call.Ident != nil {
+			res[*call.Ident] = struct{}{}
+		}
+		for j := range call.Function {
+			collectIdents([]*parser.Expression{call.Function[j].Value}, res)
+		}
+		if call.Accessor != nil {
+			collectIdents([]*parser.Expression{call.Accessor}, res)
+		}
+	}
+
+	collectIdents(operand.Block, res)
+}
@@ -0,0 +1,582 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package explorer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"strings"
+
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/mqlc/parser"
+	"go.mondoo.com/cnquery/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+)
+
+// LintRule describes one of the checks that are run when linting bundles
+type LintRule struct {
+	ID          string
+	Name        string
+	Description string
+}
+
+const (
+	LintRuleBundleInvalid       = "bundle-invalid"
+	LintRulePackUid             = "pack-uid"
+	LintRulePackName            = "pack-name"
+	LintRuleQueryUid            = "query-uid"
+	LintRuleQueryTitle          = "query-title"
+	LintRuleCompileError        = "compile-error"
+	LintRuleUnknownField        = "unknown-field"
+	LintRuleDeprecated          = "deprecated"
+	LintRuleStaticComparison    = "static-comparison"
+	LintRuleFilterPlatform      = "filter-platform"
+	LintRuleDefaultsExpansion   = "defaults-expansion"
+	LintRuleFunctionsDefinition = "functions-definition"
+)
+
+// LintRules are all the rules that bundle linting can report
+var LintRules = []LintRule{
+	{ID: LintRuleBundleInvalid, Name: "Invalid bundle", Description: "The bundle cannot be loaded or compiled."},
+	{ID: LintRulePackUid, Name: "Query pack UID", Description: "Every query pack needs a UID."},
+	{ID: LintRulePackName, Name: "Query pack name", Description: "Every query pack needs a name."},
+	{ID: LintRuleQueryUid, Name: "Query UID", Description: "Every query in a query pack needs a UID."},
+	{ID: LintRuleQueryTitle, Name: "Query title", Description: "Every query needs a title."},
+	{ID: LintRuleCompileError, Name: "Compile error", Description: "The MQL cannot be compiled."},
+	{ID: LintRuleUnknownField, Name: "Unknown field", Description: "The MQL uses a resource or field that none of the providers support."},
+	{ID: LintRuleDeprecated, Name: "Deprecated", Description: "The MQL uses a resource or field that is deprecated."},
+	{ID: LintRuleStaticComparison, Name: "Static comparison", Description: "The MQL compares values of types that can never be equal."},
+	{ID: LintRuleFilterPlatform, Name: "Filter platform", Description: "The query filters target platforms that its query pack never runs on."},
+	{ID: LintRuleDefaultsExpansion, Name: "Defaults expansion", Description: "The query expands the default fields of every resource in a list, which can produce huge outputs."},
+	{ID: LintRuleFunctionsDefinition, Name: "Functions definition", Description: "The query pack's functions cannot be parsed."},
+}
+
+// LintEntry is a problem that was found in a bundle
+type LintEntry struct {
+	RuleID  string
+	Level   string
+	Message string
+	File    string
+	Line    int
+	Column  int
+}
+
+// LintResults are all the problems that were found in a bundle
+type LintResults struct {
+	Entries []*LintEntry
+}
+
+// HasErrors is true if any of the entries is an error, as opposed to a warning
+func (r *LintResults) HasErrors() bool {
+	for i := range r.Entries {
+		if r.Entries[i].Level == LintError {
+			return true
+		}
+	}
+	return false
+}
+
+// Lint checks all bundle files in the given paths. All MQL is compiled
+// against the schema, which should include all providers.
+func Lint(schema llx.Schema, paths ...string) (*LintResults, error) {
+	filenames, err := walkBundleFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &LintResults{}
+	for i := range filenames {
+		data, err := os.ReadFile(filenames[i])
+		if err != nil {
+			return nil, err
+		}
+		lintBundleData(schema, filenames[i], data, res)
+	}
+
+	// Bundles can be split across files, so their references can only be
+	// checked once all of them are combined. We only do this if everything
+	// else is valid, to not report the same errors twice.
+	if !res.HasErrors() && len(filenames) != 0 {
+		bundle, err := aggregateFilesToBundle(filenames)
+		if err == nil {
+			_, err = bundle.Compile(context.Background(), schema)
+		}
+		if err != nil {
+			res.Entries = append(res.Entries, &LintEntry{
+				RuleID:  LintRuleBundleInvalid,
+				Level:   LintError,
+				Message: strings.TrimSpace(err.Error()),
+				File:    filenames[0],
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func lintBundleData(schema llx.Schema, filename string, data []byte, res *LintResults) {
+	l := &linter{
+		schema:   schema,
+		filename: filename,
+		results:  res,
+		props:    map[string]types.Type{},
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		l.add(LintRuleBundleInvalid, LintError, nil, "failed to parse bundle: "+err.Error())
+		return
+	}
+	if _, err := BundleFromYAML(data); err != nil {
+		l.add(LintRuleBundleInvalid, LintError, nil, "failed to load bundle: "+err.Error())
+		return
+	}
+	if len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return
+	}
+	doc := root.Content[0]
+
+	l.lintProps(YamlValue(doc, "props"))
+	for _, query := range YamlItems(YamlValue(doc, "queries")) {
+		l.lintQuery(query, platformTargets{})
+	}
+
+	for _, pack := range YamlItems(YamlValue(doc, "packs")) {
+		l.lintPack(pack)
+	}
+}
+
+type linter struct {
+	schema   llx.Schema
+	filename string
+	results  *LintResults
+	// property UID => type
+	props map[string]types.Type
+	// functions of the query pack that is linted, if any
+	functions *PackFunctions
+}
+
+func (l *linter) add(rule string, level string, node *yaml.Node, msg string) {
+	entry := &LintEntry{
+		RuleID:  rule,
+		Level:   level,
+		Message: msg,
+		File:    l.filename,
+	}
+	if node != nil {
+		entry.Line = node.Line
+		entry.Column = node.Column
+		// the content of block scalars starts in the line after the indicator
+		if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+			entry.Line++
+			entry.Column = 0
+		}
+	}
+	l.results.Entries = append(l.results.Entries, entry)
+}
+
+func (l *linter) lintPack(pack *yaml.Node) {
+	uid := yamlString(pack, "uid")
+	name := yamlString(pack, "name")
+	if uid == "" {
+		l.add(LintRulePackUid, LintError, pack, "query pack "+name+" does not define a uid")
+	}
+	if name == "" {
+		l.add(LintRulePackName, LintError, pack, "query pack "+uid+" does not define a name")
+	}
+	packID := uid
+	if packID == "" {
+		packID = name
+	}
+
+	if node := YamlValue(pack, "functions"); node != nil {
+		qp := &QueryPack{}
+		for _, item := range YamlItems(node) {
+			qp.Functions = append(qp.Functions, item.Value)
+		}
+		functions, err := NewPackFunctions(qp)
+		if err != nil {
+			l.add(LintRuleFunctionsDefinition, LintError, node, err.Error())
+		} else {
+			l.functions = functions
+			defer func() { l.functions = nil }()
+		}
+	}
+
+	targets := l.lintFilters(YamlValue(pack, "filters"), platformTargets{})
+	l.lintProps(YamlValue(pack, "props"))
+	for _, query := range YamlItems(YamlValue(pack, "queries")) {
+		if yamlString(query, "uid") == "" {
+			l.add(LintRuleQueryUid, LintError, query, "query "+yamlString(query, "title")+" in query pack "+packID+" does not define a uid")
+		}
+		l.lintQuery(query, targets)
+	}
+
+	for _, group := range YamlItems(YamlValue(pack, "groups")) {
+		groupTargets := l.lintFilters(YamlValue(group, "filters"), targets)
+		groupTargets.inherit(targets)
+		for _, query := range YamlItems(YamlValue(group, "queries")) {
+			l.lintQuery(query, groupTargets)
+		}
+	}
+}
+
+// lintProps compiles all properties and remembers their types, so that
+// queries can be compiled with them
+func (l *linter) lintProps(node *yaml.Node) map[string]*llx.Primitive {
+	res := map[string]*llx.Primitive{}
+	for _, prop := range YamlItems(node) {
+		uid := yamlString(prop, "uid")
+		if uid == "" {
+			continue
+		}
+
+		typ, ok := l.props[uid]
+		if mqlNode := YamlValue(prop, "mql"); mqlNode != nil {
+			if code := l.compile(mqlNode, mqlNode.Value, nil); code != nil {
+				typ, ok = entrypointType(code), true
+				l.props[uid] = typ
+			}
+		}
+		if !ok {
+			typ = types.Any
+		}
+		res[uid] = &llx.Primitive{Type: string(typ)}
+	}
+	return res
+}
+
+// lintFilters compiles all filters and returns the platforms they target
+func (l *linter) lintFilters(node *yaml.Node, parent platformTargets) platformTargets {
+	res := platformTargets{}
+	if node == nil {
+		return res
+	}
+
+	var filters []*yaml.Node
+	switch node.Kind {
+	case yaml.ScalarNode:
+		filters = append(filters, node)
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if item.Kind == yaml.ScalarNode {
+				filters = append(filters, item)
+			} else if mql := YamlValue(item, "mql"); mql != nil {
+				filters = append(filters, mql)
+			}
+		}
+	}
+
+	for _, filter := range filters {
+		if code := l.compile(filter, filter.Value, nil); code == nil {
+			continue
+		}
+		res.addMql(filter.Value)
+	}
+
+	if missing, ok := res.excludes(parent); ok {
+		l.add(LintRuleFilterPlatform, LintWarning, node,
+			"filters only target "+missing+", which its query pack doesn't run on")
+	}
+	return res
+}
+
+func (l *linter) lintQuery(query *yaml.Node, targets platformTargets) {
+	mqlNode := YamlValue(query, "mql")
+	if mqlNode != nil && yamlString(query, "title") == "" {
+		l.add(LintRuleQueryTitle, LintError, query, "query "+yamlString(query, "uid")+" does not define a title")
+	}
+
+	l.lintFilters(YamlValue(query, "filters"), targets)
+	props := l.lintProps(YamlValue(query, "props"))
+
+	if mqlNode == nil {
+		return
+	}
+
+	code := l.compile(mqlNode, mqlNode.Value, props)
+	if code == nil {
+		return
+	}
+	l.lintCode(mqlNode, code)
+}
+
+// compile the MQL and report an error if it fails. MQL in query packs is
+// linked to the pack's functions first.
+func (l *linter) compile(node *yaml.Node, mql string, props map[string]*llx.Primitive) *llx.CodeBundle {
+	if l.functions != nil {
+		mql = l.functions.Link(mql)
+	}
+	code, err := mqlc.Compile(mql, props, mqlc.NewConfig(l.schema, cnquery.DefaultFeatures))
+	if err == nil {
+		return code
+	}
+
+	rule := LintRuleCompileError
+	var unknown *mqlc.UnknownIdentifierError
+	if errors.As(err, &unknown) {
+		rule = LintRuleUnknownField
+	}
+	l.add(rule, LintError, node, strings.TrimSpace(err.Error()))
+	return nil
+}
+
+// lintCode looks for problems in compiled code
+func (l *linter) lintCode(node *yaml.Node, bundle *llx.CodeBundle) {
+	code := bundle.CodeV2
+	reported := map[string]struct{}{}
+	warn := func(rule string, msg string) {
+		if _, ok := reported[msg]; ok {
+			return
+		}
+		reported[msg] = struct{}{}
+		l.add(rule, LintWarning, node, msg)
+	}
+
+	for i := range code.Blocks {
+		block := code.Blocks[i]
+		for j := range block.Chunks {
+			chunk := block.Chunks[j]
+			if chunk.Call != llx.Chunk_FUNCTION {
+				continue
+			}
+
+			// resources that are created
+			if chunk.Function == nil || chunk.Function.Binding == 0 {
+				if info := l.schema.Lookup(chunk.Id); info != nil && isDeprecated(info.Title, info.Desc) {
+					warn(LintRuleDeprecated, "resource '"+chunk.Id+"' is deprecated: "+deprecation(info.Title, info.Desc))
+				}
+				continue
+			}
+
+			bindingType := chunkType(code, code.Chunk(chunk.Function.Binding))
+
+			if bindingType.IsResource() {
+				resource := bindingType.ResourceName()
+				if _, field := l.schema.LookupField(resource, chunk.Id); field != nil && isDeprecated(field.Title, field.Desc) {
+					warn(LintRuleDeprecated, "field '"+resource+"."+chunk.Id+"' is deprecated: "+deprecation(field.Title, field.Desc))
+				}
+			}
+
+			if label, ok := llx.ComparableLabel(chunk.Id); ok && (label == "==" || label == "!=") {
+				if result, ok := llx.StaticComparison(bindingType, chunk.Id); ok && len(chunk.Function.Args) != 0 {
+					argType := (&llx.Chunk{Primitive: chunk.Function.Args[0]}).DereferencedTypeV2(code)
+					res := "false"
+					if result {
+						res = "true"
+					}
+					warn(LintRuleStaticComparison, "comparing "+bindingType.Label()+" "+label+" "+argType.Label()+" is always "+res)
+				}
+			}
+		}
+	}
+
+	for _, ref := range code.Entrypoints() {
+		chunk := code.Chunk(ref)
+		if chunk.Id != "{}" || chunk.Function == nil || types.Type(chunk.Function.Type) != types.Array(types.Block) {
+			continue
+		}
+		if _, ok := bundle.AutoExpand[code.Checksums[ref]]; !ok {
+			continue
+		}
+
+		list := chunkType(code, code.Chunk(chunk.Function.Binding))
+		name := list.Label()
+		if list.IsArray() && list.Child().IsResource() {
+			name = list.Child().ResourceName()
+		}
+		warn(LintRuleDefaultsExpansion, "query returns the default fields of every "+name+
+			", which can produce huge outputs; select fields with a block or narrow it down with where")
+	}
+}
+
+func chunkType(code *llx.CodeV2, chunk *llx.Chunk) types.Type {
+	typ := chunk.DereferencedTypeV2(code)
+	if typ == types.Any {
+		return chunk.Type()
+	}
+	return typ
+}
+
+func entrypointType(bundle *llx.CodeBundle) types.Type {
+	entrypoints := bundle.CodeV2.Entrypoints()
+	if len(entrypoints) != 1 {
+		return types.Any
+	}
+	return chunkType(bundle.CodeV2, bundle.CodeV2.Chunk(entrypoints[0]))
+}
+
+func isDeprecated(title string, desc string) bool {
+	return strings.Contains(strings.ToLower(title), "deprecated") ||
+		strings.Contains(strings.ToLower(desc), "deprecated")
+}
+
+// deprecation returns the part of the docs that describes the deprecation
+func deprecation(title string, desc string) string {
+	if strings.Contains(strings.ToLower(title), "deprecated") {
+		return strings.TrimSpace(title)
+	}
+	return strings.TrimSpace(desc)
+}
+
+// platformTargets are the platforms and platform families that filters
+// are looking for, e.g. `asset.platform == "ubuntu"`
+type platformTargets struct {
+	platforms map[string]struct{}
+	families  map[string]struct{}
+}
+
+// inherit the parent's targets, unless these targets are more specific
+func (p *platformTargets) inherit(parent platformTargets) {
+	if len(p.platforms) == 0 {
+		p.platforms = parent.platforms
+	}
+	if len(p.families) == 0 {
+		p.families = parent.families
+	}
+}
+
+func (p *platformTargets) addPlatform(name string) {
+	if p.platforms == nil {
+		p.platforms = map[string]struct{}{}
+	}
+	p.platforms[name] = struct{}{}
+}
+
+func (p *platformTargets) addFamily(name string) {
+	if p.families == nil {
+		p.families = map[string]struct{}{}
+	}
+	p.families[name] = struct{}{}
+}
+
+// excludes checks if these targets and the parent's targets never overlap.
+// It returns the targets that are missing in the parent in that case.
+func (p platformTargets) excludes(parent platformTargets) (string, bool) {
+	if missing := disjoint(p.platforms, parent.platforms); missing != "" {
+		return "platforms " + missing, true
+	}
+	if missing := disjoint(p.families, parent.families); missing != "" {
+		return "platform families " + missing, true
+	}
+	return "", false
+}
+
+// disjoint returns a list of all keys in a if none of them are in b
+func disjoint(a map[string]struct{}, b map[string]struct{}) string {
+	if len(a) == 0 || len(b) == 0 {
+		return ""
+	}
+	res := make([]string, 0, len(a))
+	for k := range a {
+		if _, ok := b[k]; ok {
+			return ""
+		}
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return strings.Join(res, ", ")
+}
+
+func (p *platformTargets) addMql(mql string) {
+	ast, err := parser.Parse(mqlc.Dedent(mql))
+	if err != nil {
+		return
+	}
+
+	for _, expression := range ast.Expressions {
+		ops := append([]*parser.Operation{{Operand: expression.Operand}}, expression.Operations...)
+		for i := range ops {
+			operand := ops[i].Operand
+			if operand == nil || operand.Value == nil || operand.Value.Ident == nil || *operand.Value.Ident != "asset" ||
+				len(operand.Calls) == 0 || operand.Calls[0].Ident == nil {
+				continue
+			}
+
+			var addTarget func(string)
+			switch *operand.Calls[0].Ident {
+			case "platform":
+				addTarget = p.addPlatform
+			case "family":
+				addTarget = p.addFamily
+			default:
+				continue
+			}
+
+			// e.g. asset.platform == "ubuntu"
+			if len(operand.Calls) == 1 && i+1 < len(ops) && ops[i+1].Operator == parser.OpEqual {
+				if s := stringValue(ops[i+1].Operand); s != nil {
+					addTarget(*s)
+				}
+				continue
+			}
+
+			// e.g. asset.family.contains("unix") or asset.platform.in(["ubuntu", "debian"])
+			if len(operand.Calls) == 3 && operand.Calls[1].Ident != nil && len(operand.Calls[2].Function) == 1 &&
+				operand.Calls[2].Function[0].Value != nil {
+				arg := operand.Calls[2].Function[0].Value.Operand
+				switch *operand.Calls[1].Ident {
+				case "contains":
+					if s := stringValue(arg); s != nil {
+						addTarget(*s)
+					}
+				case "in":
+					if arg == nil || arg.Value == nil {
+						continue
+					}
+					for _, item := range arg.Value.Array {
+						if s := stringValue(item.Operand); s != nil {
+							addTarget(*s)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func stringValue(operand *parser.Operand) *string {
+	if operand == nil || operand.Value == nil || len(operand.Calls) != 0 {
+		return nil
+	}
+	return operand.Value.String
+}
+
+// YamlValue returns the value for the key in a YAML mapping node
+func YamlValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func yamlString(node *yaml.Node, key string) string {
+	value := YamlValue(node, key)
+	if value == nil || value.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return value.Value
+}
+
+// YamlItems returns the items of a YAML sequence node
+func YamlItems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return node.Content
+}
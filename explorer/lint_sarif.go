@@ -0,0 +1,62 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package explorer
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"go.mondoo.com/cnquery/utils/sarif"
+)
+
+// ToSarif converts the lint results into a SARIF report
+func (r *LintResults) ToSarif(toolVersion string) ([]byte, error) {
+	rules := make([]sarif.Rule, len(LintRules))
+	ruleIndex := make(map[string]int, len(LintRules))
+	for i := range LintRules {
+		rule := LintRules[i]
+		rules[i] = sarif.Rule{
+			ID:               rule.ID,
+			Name:             rule.Name,
+			ShortDescription: &sarif.Message{Text: rule.Description},
+		}
+		ruleIndex[rule.ID] = i
+	}
+
+	results := make([]sarif.Result, len(r.Entries))
+	for i := range r.Entries {
+		entry := r.Entries[i]
+		location := &sarif.PhysicalLocation{
+			ArtifactLocation: sarif.ArtifactLocation{URI: filepath.ToSlash(entry.File)},
+		}
+		if entry.Line > 0 {
+			location.Region = &sarif.Region{
+				StartLine:   entry.Line,
+				StartColumn: entry.Column,
+			}
+		}
+
+		results[i] = sarif.Result{
+			RuleID:    entry.RuleID,
+			RuleIndex: ruleIndex[entry.RuleID],
+			Level:     entry.Level,
+			Message:   sarif.Message{Text: entry.Message},
+			Locations: []sarif.Location{{PhysicalLocation: location}},
+		}
+	}
+
+	return json.MarshalIndent(sarif.Log{
+		Schema:  sarif.Schema,
+		Version: sarif.Version,
+		Runs: []sarif.Run{{
+			Tool: sarif.Tool{Driver: sarif.Driver{
+				Name:           "cnquery",
+				Version:        toolVersion,
+				InformationURI: "https://mondoo.com",
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}, "", "  ")
+}
@@ -0,0 +1,224 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package explorer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers"
+	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
+	"go.mondoo.com/cnquery/types"
+)
+
+func lintSchema() *resources.Schema {
+	res := &resources.Schema{Resources: map[string]*resources.ResourceInfo{}}
+	for k, v := range providers.DefaultRuntime().Schema().AllResources() {
+		res.Resources[k] = v
+	}
+
+	res.Resources["legacy"] = &resources.ResourceInfo{
+		Id:    "legacy",
+		Name:  "legacy",
+		Title: "Deprecated. Use `asset` instead.",
+		Fields: map[string]*resources.Field{
+			"name": {Name: "name", Type: string(types.String)},
+			"old":  {Name: "old", Type: string(types.String), Title: "Deprecated. Use `name` instead."},
+		},
+	}
+	res.Resources["things"] = &resources.ResourceInfo{
+		Id:       "things",
+		Name:     "things",
+		ListType: string(types.Resource("thing")),
+		Fields: map[string]*resources.Field{
+			"list": {Name: "list", Type: string(types.Array(types.Resource("thing")))},
+		},
+	}
+	res.Resources["thing"] = &resources.ResourceInfo{
+		Id:       "thing",
+		Name:     "thing",
+		Defaults: "name",
+		Fields: map[string]*resources.Field{
+			"name": {Name: "name", Type: string(types.String)},
+		},
+	}
+	return res
+}
+
+func lintYAML(t *testing.T, data string) []*LintEntry {
+	res := &LintResults{}
+	lintBundleData(lintSchema(), "test.mql.yaml", []byte(data), res)
+	return res.Entries
+}
+
+func TestLint(t *testing.T) {
+	t.Run("valid bundle", func(t *testing.T) {
+		entries := lintYAML(t, `
+packs:
+  - uid: valid
+    name: Valid
+    queries:
+      - uid: name
+        title: Asset name
+        mql: asset.name
+`)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("structure", func(t *testing.T) {
+		entries := lintYAML(t, `
+packs:
+  - uid: structure
+    queries:
+      - uid: untitled
+        mql: asset.name
+`)
+		require.Len(t, entries, 2)
+		assert.Equal(t, &LintEntry{RuleID: LintRulePackName, Level: LintError, Message: "query pack structure does not define a name", File: "test.mql.yaml", Line: 3, Column: 5}, entries[0])
+		assert.Equal(t, &LintEntry{RuleID: LintRuleQueryTitle, Level: LintError, Message: "query untitled does not define a title", File: "test.mql.yaml", Line: 5, Column: 9}, entries[1])
+	})
+
+	t.Run("uids", func(t *testing.T) {
+		entries := lintYAML(t, `
+packs:
+  - name: No UID
+    queries:
+      - title: No UID
+        mql: asset.name
+`)
+		require.Len(t, entries, 2)
+		assert.Equal(t, &LintEntry{RuleID: LintRulePackUid, Level: LintError, Message: "query pack No UID does not define a uid", File: "test.mql.yaml", Line: 3, Column: 5}, entries[0])
+		assert.Equal(t, &LintEntry{RuleID: LintRuleQueryUid, Level: LintError, Message: "query No UID in query pack No UID does not define a uid", File: "test.mql.yaml", Line: 5, Column: 9}, entries[1])
+	})
+
+	t.Run("unknown fields", func(t *testing.T) {
+		entries := lintYAML(t, `
+queries:
+  - uid: unknown
+    title: Unknown
+    mql: |
+      asset.unknown
+`)
+		require.Len(t, entries, 1)
+		assert.Equal(t, LintRuleUnknownField, entries[0].RuleID)
+		assert.Equal(t, LintError, entries[0].Level)
+		assert.Equal(t, 6, entries[0].Line)
+	})
+
+	t.Run("deprecated", func(t *testing.T) {
+		entries := lintYAML(t, `
+queries:
+  - uid: deprecated
+    title: Deprecated
+    mql: legacy.old
+`)
+		require.Len(t, entries, 2)
+		assert.Equal(t, LintRuleDeprecated, entries[0].RuleID)
+		assert.Equal(t, "resource 'legacy' is deprecated: Deprecated. Use `asset` instead.", entries[0].Message)
+		assert.Equal(t, "field 'legacy.old' is deprecated: Deprecated. Use `name` instead.", entries[1].Message)
+		assert.Equal(t, 5, entries[1].Line)
+		assert.Equal(t, 10, entries[1].Column)
+	})
+
+	t.Run("static comparisons", func(t *testing.T) {
+		entries := lintYAML(t, `
+queries:
+  - uid: static
+    title: Static
+    mql: asset.name == /name/ && asset.ids.contains("a") == 1
+`)
+		require.Len(t, entries, 1)
+		assert.Equal(t, LintRuleStaticComparison, entries[0].RuleID)
+		assert.Equal(t, "comparing bool == int is always false", entries[0].Message)
+	})
+
+	t.Run("filters for other platforms", func(t *testing.T) {
+		entries := lintYAML(t, `
+packs:
+  - uid: filters
+    name: Filters
+    filters:
+      - asset.platform == "ubuntu" || asset.platform == "debian"
+    queries:
+      - uid: windows
+        title: Windows
+        filters: asset.platform.in(["windows", "macos"])
+        mql: asset.name
+      - uid: debian
+        title: Debian
+        filters: asset.platform == "debian"
+        mql: asset.name
+`)
+		require.Len(t, entries, 1)
+		assert.Equal(t, LintRuleFilterPlatform, entries[0].RuleID)
+		assert.Equal(t, "filters only target platforms macos, windows, which its query pack doesn't run on", entries[0].Message)
+		assert.Equal(t, 10, entries[0].Line)
+	})
+
+	t.Run("functions", func(t *testing.T) {
+		entries := lintYAML(t, `
+packs:
+  - uid: functions
+    name: Functions
+    functions:
+      - def isLinux() { asset.family.contains("linux") }
+    filters: isLinux()
+    queries:
+      - uid: name
+        title: Name
+        filters: isLinux()
+        mql: isLinux() && asset.name != ""
+`)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("defaults expansion", func(t *testing.T) {
+		entries := lintYAML(t, `
+queries:
+  - uid: all
+    title: All things
+    mql: things
+  - uid: names
+    title: All thing names
+    mql: things { name }
+`)
+		require.Len(t, entries, 1)
+		assert.Equal(t, LintRuleDefaultsExpansion, entries[0].RuleID)
+		assert.Equal(t, LintWarning, entries[0].Level)
+		assert.Equal(t, 5, entries[0].Line)
+	})
+}
+
+func TestLint_Sarif(t *testing.T) {
+	results := &LintResults{Entries: []*LintEntry{
+		{RuleID: LintRuleUnknownField, Level: LintError, Message: "cannot find field", File: "pack.mql.yaml", Line: 3, Column: 5},
+		{RuleID: LintRuleBundleInvalid, Level: LintError, Message: "invalid", File: "pack.mql.yaml"},
+	}}
+	assert.True(t, results.HasErrors())
+
+	data, err := results.ToSarif("9.0.0")
+	require.NoError(t, err)
+
+	var sarif map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &sarif))
+	assert.Equal(t, "2.1.0", sarif["version"])
+
+	runs := sarif["runs"].([]interface{})
+	require.Len(t, runs, 1)
+	run := runs[0].(map[string]interface{})
+	res := run["results"].([]interface{})
+	require.Len(t, res, 2)
+
+	first := res[0].(map[string]interface{})
+	assert.Equal(t, LintRuleUnknownField, first["ruleId"])
+	assert.Equal(t, LintRules[int(first["ruleIndex"].(float64))].ID, LintRuleUnknownField)
+	location := first["locations"].([]interface{})[0].(map[string]interface{})["physicalLocation"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"startLine": 3.0, "startColumn": 5.0}, location["region"])
+
+	second := res[1].(map[string]interface{})
+	location = second["locations"].([]interface{})[0].(map[string]interface{})["physicalLocation"].(map[string]interface{})
+	assert.Nil(t, location["region"])
+}
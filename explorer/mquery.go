@@ -9,6 +9,7 @@ import (
 	"errors"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery"
@@ -388,6 +389,23 @@ func (m *Mquery) AddBase(base *Mquery) {
 	if m.Variants == nil {
 		m.Variants = base.Variants
 	}
+	if m.Timeout == "" {
+		m.Timeout = base.Timeout
+	}
+}
+
+// ExecutionTimeout returns the time this query may run, or 0 if the query
+// doesn't have its own timeout
+func (m *Mquery) ExecutionTimeout() (time.Duration, error) {
+	if m.Timeout == "" {
+		return 0, nil
+	}
+
+	res, err := time.ParseDuration(m.Timeout)
+	if err != nil || res <= 0 {
+		return 0, errors.New("invalid timeout '" + m.Timeout + "', use a positive duration like 30s or 5m")
+	}
+	return res, nil
 }
 
 func (r *Remediation) UnmarshalJSON(data []byte) error {
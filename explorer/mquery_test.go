@@ -6,6 +6,7 @@ package explorer
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -121,3 +122,20 @@ func TestMquery_Remediation(t *testing.T) {
 		assert.Equal(t, initial, &back)
 	})
 }
+
+func TestMquery_ExecutionTimeout(t *testing.T) {
+	timeout, err := (&Mquery{}).ExecutionTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeout)
+
+	query := (&Mquery{Mql: "override"}).Merge(&Mquery{Mql: "base", Timeout: "1m30s"})
+	timeout, err = query.ExecutionTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	_, err = (&Mquery{Timeout: "soon"}).ExecutionTimeout()
+	assert.EqualError(t, err, "invalid timeout 'soon', use a positive duration like 30s or 5m")
+
+	_, err = (&Mquery{Timeout: "-5s"}).ExecutionTimeout()
+	assert.Error(t, err)
+}
@@ -248,11 +248,17 @@ func (s *LocalServices) addQueryToJob(ctx context.Context, query *Mquery, job *E
 		return err
 	}
 
+	timeout, err := query.ExecutionTimeout()
+	if err != nil {
+		return multierr.Wrap(err, "failed to resolve query "+query.Mrn)
+	}
+
 	equery := &ExecutionQuery{
 		Query:      query.Mql,
 		Checksum:   query.Checksum,
 		Code:       codeBundle,
 		Properties: propRefs,
+		Timeout:    timeout.Milliseconds(),
 	}
 
 	code := equery.Code.CodeV2
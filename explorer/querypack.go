@@ -65,6 +65,10 @@ func (p *QueryPack) UpdateChecksums() error {
 	contentChecksum = contentChecksum.AddUint(uint64(c))
 	executionChecksum = executionChecksum.AddUint(uint64(e))
 
+	for i := range p.Functions {
+		contentChecksum = contentChecksum.Add(p.Functions[i])
+	}
+
 	c, e = ChecksumQueries(p.Queries)
 	contentChecksum = contentChecksum.AddUint(uint64(c))
 	executionChecksum = executionChecksum.AddUint(uint64(e))
@@ -3,7 +3,11 @@
 
 package explorer
 
-import llx "go.mondoo.com/cnquery/llx"
+import (
+	"sort"
+
+	llx "go.mondoo.com/cnquery/llx"
+)
 
 func (r *Report) RawResults() map[string]*llx.RawResult {
 	results := map[string]*llx.RawResult{}
@@ -16,3 +20,17 @@ func (r *Report) RawResults() map[string]*llx.RawResult {
 
 	return results
 }
+
+// FailedAssets returns the sorted MRNs of all assets that have errored.
+// Informational errors and warnings, e.g. for assets that are not
+// applicable, are not counted as failures.
+func (r *ReportCollection) FailedAssets() []string {
+	var res []string
+	for mrn, errStatus := range r.Errors {
+		if errStatus.ErrorCode().Category() == ErrorCategoryError {
+			res = append(res, mrn)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
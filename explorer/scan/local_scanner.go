@@ -10,6 +10,7 @@ import (
 	"os"
 	"strings"
 	sync "sync"
+	"sync/atomic"
 	"time"
 
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory/manager"
@@ -43,11 +44,39 @@ type assetWithRuntime struct {
 	runtime *providers.Runtime
 }
 
+type assetWithError struct {
+	asset *inventory.Asset
+	err   error
+}
+
+// ensureMrn makes sure the failed asset can be referenced in a report
+func (a *assetWithError) ensureMrn() error {
+	if a.asset.Mrn != "" {
+		return nil
+	}
+
+	randID := "//" + explorer.SERVICE_NAME + "/" + explorer.MRN_RESOURCE_ASSET + "/" + ksuid.New().String()
+	x, err := mrn.NewMRN(randID)
+	if err != nil {
+		return multierr.Wrap(err, "failed to generate a random asset MRN")
+	}
+	a.asset.Mrn = x.String()
+	return nil
+}
+
 type LocalScanner struct {
 	ctx       context.Context
 	fetcher   *fetcher
 	upstream  *upstream.UpstreamConfig
 	recording providers.Recording
+	// parallel is the number of assets that are connected and scanned at the same time
+	parallel int
+	// stream receives asset reports as soon as they are done
+	stream Reporter
+	// queryTimeout is the default timeout for every query
+	queryTimeout time.Duration
+	// profile adds the cost of all queries and fields to the reports
+	profile bool
 }
 
 type ScannerOption func(*LocalScanner)
@@ -64,15 +93,53 @@ func WithRecording(r providers.Recording) func(s *LocalScanner) {
 	}
 }
 
+// WithParallel sets the number of assets that are scanned concurrently.
+// Values below 1 are treated as 1, i.e. assets are scanned one by one.
+func WithParallel(n int) func(s *LocalScanner) {
+	return func(s *LocalScanner) {
+		s.parallel = n
+	}
+}
+
+// WithStreamReporter adds a reporter that receives every asset report and
+// scan error as soon as it is available, in addition to the aggregated report
+// collection. Progress bars are disabled, since streams usually go to stdout.
+func WithStreamReporter(r Reporter) func(s *LocalScanner) {
+	return func(s *LocalScanner) {
+		s.stream = r
+	}
+}
+
+// WithQueryTimeout cancels queries that don't finish within the timeout,
+// unless they set their own timeout. Other queries still run to completion.
+func WithQueryTimeout(timeout time.Duration) func(s *LocalScanner) {
+	return func(s *LocalScanner) {
+		s.queryTimeout = timeout
+	}
+}
+
+// WithProfiling accounts for the time, provider calls, and cache hits of
+// all queries and resource fields. Reports carry them in their profiles.
+func WithProfiling() func(s *LocalScanner) {
+	return func(s *LocalScanner) {
+		s.profile = true
+	}
+}
+
 func NewLocalScanner(opts ...ScannerOption) *LocalScanner {
 	ls := &LocalScanner{
-		fetcher: newFetcher(),
+		fetcher:  newFetcher(),
+		parallel: 1,
 	}
 
 	for i := range opts {
 		opts[i](ls)
 	}
 
+	if ls.parallel < 1 {
+		ls.parallel = 1
+	}
+
 	return ls
 }
 
@@ -161,22 +228,37 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 	}
 	assetList := im.GetAssets()
 
-	var assets []*assetWithRuntime
-	var assetCandidates []*inventory.Asset
+	// assets that fail to resolve, detect or connect are reported individually,
+	// so that one bad host doesn't abort the scan of an entire inventory
+	var failed []*assetWithError
 
-	// we connect and perform discovery for each asset in the job inventory
+	// resolve all assets upfront, the inventory manager is not safe for concurrent use
+	var resolvedAssets []*inventory.Asset
 	for i := range assetList {
 		asset := assetList[i]
 		resolvedAsset, err := im.ResolveAsset(asset)
 		if err != nil {
-			return nil, false, err
+			log.Error().Err(err).Str("asset", asset.Name).Msg("unable to resolve asset")
+			failed = append(failed, &assetWithError{asset: asset, err: multierr.Wrap(err, "unable to resolve asset")})
+			continue
 		}
+		resolvedAssets = append(resolvedAssets, resolvedAsset)
+	}
+
+	// we connect and perform discovery for each asset in the job inventory
+	discovered := make([][]*inventory.Asset, len(resolvedAssets))
+	discoveryRuntimes := make([]*providers.Runtime, len(resolvedAssets))
+	discoveryErrs := make([]error, len(resolvedAssets))
+	s.parallelize(len(resolvedAssets), func(i int) {
+		resolvedAsset := resolvedAssets[i]
 		runtime := providers.Coordinator.NewRuntime()
+		discoveryRuntimes[i] = runtime
 
-		err = runtime.DetectProvider(resolvedAsset)
+		err := runtime.DetectProvider(resolvedAsset)
 		if err != nil {
-			log.Error().Err(err).Msg("unable to detect provider for asset")
-			continue
+			log.Error().Err(err).Str("asset", resolvedAsset.Name).Msg("unable to detect provider for asset")
+			discoveryErrs[i] = multierr.Wrap(err, "unable to detect provider for asset")
+			return
 		}
 
 		if err := runtime.Connect(&plugin.ConnectReq{
@@ -184,33 +266,45 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 			Asset:    resolvedAsset,
 			Upstream: upstream,
 		}); err != nil {
-			log.Error().Err(err).Msg("unable to connect to asset")
-			continue
+			log.Error().Err(err).Str("asset", resolvedAsset.Name).Msg("unable to connect to asset")
+			discoveryErrs[i] = multierr.Wrap(err, "unable to connect to asset")
+			return
 		}
 		inventorySpec := runtime.Provider.Connection
 		if inventorySpec.Inventory != nil &&
 			inventorySpec.Inventory.Spec != nil &&
 			inventorySpec.Inventory.Spec.Assets != nil {
 			log.Debug().Msgf("adding %d discovered asset(s)", len(runtime.Provider.Connection.Inventory.Spec.Assets))
-			assetCandidates = append(assetCandidates, inventorySpec.Inventory.Spec.Assets...)
+			discovered[i] = inventorySpec.Inventory.Spec.Assets
 		} else {
-			assetCandidates = append(assetCandidates, runtime.Provider.Connection.Asset)
+			discovered[i] = []*inventory.Asset{runtime.Provider.Connection.Asset}
 		}
-		// TODO: we want to keep better track of errors, since there may be
-		// multiple assets coming in. It's annoying to abort the scan if we get one
-		// error at this stage.
 
 		// we grab the asset from the connection, because it contains all the
 		// detected metadata (and IDs)
 		// assets = append(assets, runtime.Provider.Connection.Asset)
+	})
+
+	var assetCandidates []*inventory.Asset
+	for i := range discovered {
+		if discoveryErrs[i] != nil {
+			failed = append(failed, &assetWithError{asset: resolvedAssets[i], err: discoveryErrs[i]})
+			continue
+		}
+		assetCandidates = append(assetCandidates, discovered[i]...)
 	}
 
 	// for each asset candidate, we initialize a new runtime and connect to it.
-	for _, asset := range assetCandidates {
+	connected := make([]*assetWithRuntime, len(assetCandidates))
+	connectErrs := make([]error, len(assetCandidates))
+	s.parallelize(len(assetCandidates), func(i int) {
+		asset := assetCandidates[i]
 		runtime := providers.Coordinator.NewRuntime()
 		// Make sure the provider for the asset is present
 		if err := runtime.DetectProvider(asset); err != nil {
-			return nil, false, err
+			log.Error().Err(err).Str("asset", asset.Name).Msg("unable to detect provider for asset")
+			connectErrs[i] = multierr.Wrap(err, "unable to detect provider for asset")
+			return
 		}
 
 		// attach recording before connect, so it is tied to the asset
@@ -222,18 +316,67 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 			Upstream: upstream,
 		})
 		if err != nil {
-			log.Error().Err(err).Msg("unable to connect to asset")
-			continue
+			log.Error().Err(err).Str("asset", asset.Name).Msg("unable to connect to asset")
+			connectErrs[i] = multierr.Wrap(err, "unable to connect to asset")
+			runtime.Close()
+			return
 		}
 
-		assets = append(assets, &assetWithRuntime{
+		connected[i] = &assetWithRuntime{
 			asset:   asset,
 			runtime: runtime,
-		})
+		}
+	})
+
+	// discovery is done and all candidates have their own connection, so the
+	// discovery runtimes can release their providers
+	for i := range discoveryRuntimes {
+		if discoveryRuntimes[i] != nil && discoveryRuntimes[i].Provider != nil {
+			discoveryRuntimes[i].Close()
+		}
+	}
+
+	var assets []*assetWithRuntime
+	for i := range connected {
+		if connectErrs[i] != nil {
+			failed = append(failed, &assetWithError{asset: assetCandidates[i], err: connectErrs[i]})
+			continue
+		}
+		assets = append(assets, connected[i])
+	}
+
+	// the runtimes are closed once their asset is scanned, so we only close
+	// them here if we return before the scan starts
+	scanning := false
+	defer func() {
+		if scanning {
+			return
+		}
+		for i := range assets {
+			assets[i].runtime.Close()
+		}
+	}()
+
+	// failed assets have no platform IDs, so they can't be synchronized
+	// upstream. They get local MRNs, which tie them to their errors.
+	for i := range failed {
+		if err := failed[i].ensureMrn(); err != nil {
+			return nil, false, err
+		}
 	}
 
 	if len(assets) == 0 {
-		return nil, false, nil
+		if len(failed) == 0 {
+			return nil, false, nil
+		}
+		reporter := NewAggregateReporter(nil)
+		for i := range failed {
+			reporter.AddFailedAsset(failed[i].asset, failed[i].err)
+			if s.stream != nil {
+				s.stream.AddScanError(failed[i].asset, failed[i].err)
+			}
+		}
+		return reporter.Reports(), false, nil
 	}
 
 	justAssets := []*inventory.Asset{}
@@ -293,6 +436,16 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 
 	// plan scan jobs
 	reporter := NewAggregateReporter(justAssets)
+	for i := range failed {
+		reporter.AddFailedAsset(failed[i].asset, failed[i].err)
+		if s.stream != nil {
+			s.stream.AddScanError(failed[i].asset, failed[i].err)
+		}
+	}
+	var jobReporter Reporter = reporter
+	if s.stream != nil {
+		jobReporter = multiReporter{reporter, s.stream}
+	}
 	// if a bundle was provided check that it matches the filter, bundles can also be downloaded
 	// later therefore we do not want to stop execution here
 	if job.Bundle != nil && job.Bundle.FilterQueryPacks(job.QueryPackFilters) {
@@ -311,7 +464,7 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 		orderedKeys = append(orderedKeys, assets[i].asset.PlatformIds[0])
 	}
 	var multiprogress progress.MultiProgress
-	if isatty.IsTerminal(os.Stdout.Fd()) && !strings.EqualFold(logger.GetLevel(), "debug") && !strings.EqualFold(logger.GetLevel(), "trace") {
+	if s.stream == nil && isatty.IsTerminal(os.Stdout.Fd()) && !strings.EqualFold(logger.GetLevel(), "debug") && !strings.EqualFold(logger.GetLevel(), "trace") {
 		var err error
 		multiprogress, err = progress.NewMultiProgressBars(progressBarElements, orderedKeys)
 		if err != nil {
@@ -322,44 +475,49 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 		multiprogress = progress.NoopMultiProgressBars{}
 	}
 
+	scanning = true
 	scanGroup := sync.WaitGroup{}
 	scanGroup.Add(1)
-	finished := false
+	var canceled atomic.Bool
 	go func() {
 		defer scanGroup.Done()
-		for i := range assets {
+		queryPackFilters := preprocessQueryPackFilters(job.QueryPackFilters)
+		s.parallelize(len(assets), func(i int) {
 			asset := assets[i].asset
 			runtime := assets[i].runtime
+			// we don't need the runtime anymore once we are done, so close it
+			defer runtime.Close()
+
+			p := &progress.MultiProgressAdapter{Key: asset.PlatformIds[0], Multi: multiprogress}
 
-			// Make sure the context has not been canceled in the meantime. Note that this approach works only for single threaded execution. If we have more than 1 thread calling this function,
-			// we need to solve this at a different level.
+			// Make sure the context has not been canceled in the meantime. Assets
+			// that are skipped are marked as errored, which lets the progress bars
+			// finish once all assets are accounted for.
 			select {
 			case <-ctx.Done():
-				log.Warn().Msg("request context has been canceled")
-				// When we scan concurrently, we need to call Errored(asset.Mrn) status for this asset
-				multiprogress.Close()
+				if !canceled.Swap(true) {
+					log.Warn().Msg("request context has been canceled")
+				}
+				p.Errored()
 				return
 			default:
 			}
 
-			p := &progress.MultiProgressAdapter{Key: asset.PlatformIds[0], Multi: multiprogress}
 			s.RunAssetJob(&AssetJob{
 				DoRecord:         job.DoRecord,
 				UpstreamConfig:   upstream,
 				Asset:            asset,
 				Bundle:           job.Bundle,
 				Props:            job.Props,
-				QueryPackFilters: preprocessQueryPackFilters(job.QueryPackFilters),
+				QueryPackFilters: queryPackFilters,
 				Ctx:              ctx,
-				Reporter:         reporter,
+				Reporter:         jobReporter,
 				ProgressReporter: p,
+				QueryTimeout:     s.queryTimeout,
+				Profile:          s.profile,
 				runtime:          runtime,
 			})
-
-			// we don't need the runtime anymore, so close it
-			runtime.Close()
-		}
-		finished = true
+		})
 	}()
 
 	scanGroup.Add(1)
@@ -368,7 +526,26 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 		multiprogress.Open()
 	}()
 	scanGroup.Wait()
-	return reporter.Reports(), finished, nil
+	return reporter.Reports(), !canceled.Load(), nil
+}
+
+// parallelize calls fn for every index in [0, n) and waits for all calls to
+// finish. At most s.parallel calls run at the same time.
+func (s *LocalScanner) parallelize(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, s.parallel)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
 }
 
 func (s *LocalScanner) RunAssetJob(job *AssetJob) {
@@ -577,6 +754,10 @@ func (s *localAssetScanner) ensureBundle() error {
 }
 
 func (s *localAssetScanner) runQueryPack() (*AssetReport, error) {
+	if s.job.Profile {
+		s.job.runtime.EnableProfiling()
+	}
+
 	var hub explorer.QueryHub = s.services
 	var conductor explorer.QueryConductor = s.services
 
@@ -614,7 +795,7 @@ func (s *localAssetScanner) runQueryPack() (*AssetReport, error) {
 	logger.DebugDumpJSON("resolvedPack", resolvedPack)
 
 	features := cnquery.GetFeatures(s.job.Ctx)
-	e, err := executor.RunExecutionJob(s.Runtime, conductor, s.job.Asset.Mrn, resolvedPack.ExecutionJob, features, s.job.ProgressReporter)
+	e, err := executor.RunExecutionJob(s.Runtime, conductor, s.job.Asset.Mrn, resolvedPack.ExecutionJob, features, s.job.ProgressReporter, s.job.QueryTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -634,6 +815,9 @@ func (s *localAssetScanner) runQueryPack() (*AssetReport, error) {
 		Bundle:   assetBundle,
 		Resolved: resolvedPack,
 	}
+	if s.job.Profile {
+		ar.Profile = assetProfile(e.QueryCosts(), s.job.runtime.Profile())
+	}
 
 	log.Debug().Str("asset", s.job.Asset.Mrn).Msg("generate report")
 	report, err := conductor.GetReport(s.job.Ctx, &explorer.EntityDataRequest{
@@ -654,6 +838,25 @@ func (s *localAssetScanner) runQueryPack() (*AssetReport, error) {
 	return ar, nil
 }
 
+func assetProfile(queries []*explorer.QueryCost, profile *providers.Profile) *explorer.AssetProfile {
+	fields := profile.Fields()
+	res := &explorer.AssetProfile{
+		Queries: queries,
+		Fields:  make([]*explorer.FieldCost, len(fields)),
+	}
+	for i := range fields {
+		field := fields[i]
+		res.Fields[i] = &explorer.FieldCost{
+			Resource:  field.Resource,
+			Field:     field.Field,
+			Duration:  field.Duration.Nanoseconds(),
+			Calls:     field.Calls,
+			CacheHits: field.CacheHits,
+		}
+	}
+	return res
+}
+
 // FilterQueries returns all queries whose result is truthy
 func (s *localAssetScanner) FilterQueries(queries []*explorer.Mquery, timeout time.Duration) ([]*explorer.Mquery, []error) {
 	return executor.RunFilterQueries(s.Runtime, queries, timeout)
@@ -4,7 +4,9 @@
 package scan
 
 import (
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -27,3 +29,33 @@ func TestFilterPreprocess(t *testing.T) {
 		"//registry.mondoo.com/namespace/namespace3/querypacks/pack3",
 	}, preprocessed)
 }
+
+func TestParallelize(t *testing.T) {
+	s := NewLocalScanner(WithParallel(3))
+
+	var running, maxRunning atomic.Int32
+	called := make([]atomic.Bool, 10)
+	s.parallelize(len(called), func(i int) {
+		cur := running.Add(1)
+		for {
+			max := maxRunning.Load()
+			if cur <= max || maxRunning.CompareAndSwap(max, cur) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		called[i].Store(true)
+		running.Add(-1)
+	})
+
+	for i := range called {
+		assert.True(t, called[i].Load(), "index %d was not called", i)
+	}
+	assert.LessOrEqual(t, maxRunning.Load(), int32(3))
+	assert.Greater(t, maxRunning.Load(), int32(1))
+}
+
+func TestParallelizeDefault(t *testing.T) {
+	s := NewLocalScanner(WithParallel(0))
+	assert.Equal(t, 1, s.parallel)
+}
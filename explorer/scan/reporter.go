@@ -4,6 +4,8 @@
 package scan
 
 import (
+	"sync"
+
 	"go.mondoo.com/cnquery/explorer"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/utils/multierr"
@@ -14,11 +16,28 @@ type Reporter interface {
 	AddScanError(asset *inventory.Asset, err error)
 }
 
+// multiReporter passes all reports and errors on to each of its reporters
+type multiReporter []Reporter
+
+func (m multiReporter) AddReport(asset *inventory.Asset, results *AssetReport) {
+	for i := range m {
+		m[i].AddReport(asset, results)
+	}
+}
+
+func (m multiReporter) AddScanError(asset *inventory.Asset, err error) {
+	for i := range m {
+		m[i].AddScanError(asset, err)
+	}
+}
+
 type AssetReport struct {
 	Mrn      string
 	Bundle   *explorer.Bundle
 	Report   *explorer.Report
 	Resolved *explorer.ResolvedPack
+	// Profile is only set for scans with profiling
+	Profile *explorer.AssetProfile
 }
 
 type AggregateReporter struct {
@@ -27,6 +46,9 @@ type AggregateReporter struct {
 	assetErrors  map[string]error
 	bundle       *explorer.Bundle
 	resolved     map[string]*explorer.ResolvedPack
+	profiles     map[string]*explorer.AssetProfile
+	// assets may be scanned in parallel, so reports come in concurrently
+	lock sync.Mutex
 }
 
 func NewAggregateReporter(assetList []*inventory.Asset) *AggregateReporter {
@@ -34,8 +56,9 @@ func NewAggregateReporter(assetList []*inventory.Asset) *AggregateReporter {
 	for i := range assetList {
 		cur := assetList[i]
 		assets[cur.Mrn] = &explorer.Asset{
-			Mrn:  cur.Mrn,
-			Name: cur.Name,
+			Mrn:         cur.Mrn,
+			Name:        cur.Name,
+			PlatformIds: cur.PlatformIds,
 		}
 	}
 
@@ -44,20 +67,49 @@ func NewAggregateReporter(assetList []*inventory.Asset) *AggregateReporter {
 		assetReports: map[string]*explorer.Report{},
 		assetErrors:  map[string]error{},
 		resolved:     map[string]*explorer.ResolvedPack{},
+		profiles:     map[string]*explorer.AssetProfile{},
 	}
 }
 
 func (r *AggregateReporter) AddReport(asset *inventory.Asset, results *AssetReport) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
 	r.assetReports[asset.Mrn] = results.Report
 	r.resolved[asset.Mrn] = results.Resolved
+	if results.Profile != nil {
+		r.profiles[asset.Mrn] = results.Profile
+	}
 	r.bundle = results.Bundle
 }
 
 func (r *AggregateReporter) AddScanError(asset *inventory.Asset, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.assetErrors[asset.Mrn] = err
+}
+
+// AddFailedAsset adds an asset that couldn't be scanned at all, e.g. because
+// we failed to connect to it. It shows up in the report with its error.
+func (r *AggregateReporter) AddFailedAsset(asset *inventory.Asset, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	name := asset.Name
+	if name == "" && len(asset.Connections) > 0 {
+		name = asset.Connections[0].ToUrl()
+	}
+	r.assets[asset.Mrn] = &explorer.Asset{
+		Mrn:         asset.Mrn,
+		Name:        name,
+		PlatformIds: asset.PlatformIds,
+	}
 	r.assetErrors[asset.Mrn] = err
 }
 
 func (r *AggregateReporter) Reports() *explorer.ReportCollection {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	errors := make(map[string]*explorer.ErrorStatus, len(r.assetErrors))
 	for k, v := range r.assetErrors {
 		errors[k] = explorer.NewErrorStatus(v)
@@ -69,10 +121,14 @@ func (r *AggregateReporter) Reports() *explorer.ReportCollection {
 		Errors:   errors,
 		Bundle:   r.bundle,
 		Resolved: r.resolved,
+		Profiles: r.profiles,
 	}
 }
 
 func (r *AggregateReporter) Error() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	var err multierr.Errors
 	for _, curError := range r.assetErrors {
 		err.Add(curError)
@@ -0,0 +1,70 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scan
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/utils/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAggregateReporterFailedAssets(t *testing.T) {
+	scanned := &inventory.Asset{Mrn: "//asset/scanned", Name: "scanned"}
+	notApplicable := &inventory.Asset{Mrn: "//asset/na", Name: "na"}
+	unreachable := &inventory.Asset{
+		Mrn:         "//asset/unreachable",
+		Connections: []*inventory.Config{{Type: "ssh", Host: "10.0.0.1"}},
+	}
+
+	reporter := NewAggregateReporter([]*inventory.Asset{scanned, notApplicable})
+	reporter.AddReport(scanned, &AssetReport{Report: &explorer.Report{}})
+	reporter.AddScanError(notApplicable, explorer.NewAssetMatchError(notApplicable.Mrn, "query packs", "no-matching-packs", nil, nil))
+	reporter.AddFailedAsset(unreachable, errors.New("unable to connect to asset: connection refused"))
+
+	reports := reporter.Reports()
+	require.Len(t, reports.Assets, 3)
+	assert.Equal(t, "ssh://10.0.0.1", reports.Assets[unreachable.Mrn].Name)
+	require.Contains(t, reports.Errors, unreachable.Mrn)
+	assert.Equal(t, "unable to connect to asset: connection refused", reports.Errors[unreachable.Mrn].Message)
+
+	// not applicable assets are not failures
+	assert.Equal(t, []string{unreachable.Mrn}, reports.FailedAssets())
+}
+
+func TestAggregateReporterFailedAssetCode(t *testing.T) {
+	unreachable := &inventory.Asset{Mrn: "//asset/unreachable", Name: "unreachable"}
+	other := &inventory.Asset{Mrn: "//asset/other", Name: "other"}
+
+	reporter := NewAggregateReporter(nil)
+	reporter.AddFailedAsset(unreachable, multierr.Wrap(status.Error(codes.Unavailable, "connection refused"), "unable to connect to asset"))
+	reporter.AddFailedAsset(other, errors.New("something went wrong"))
+
+	reports := reporter.Reports()
+	assert.Equal(t, int32(codes.Unavailable), reports.Errors[unreachable.Mrn].Code)
+	assert.Equal(t, int32(codes.Unknown), reports.Errors[other.Mrn].Code)
+	assert.Equal(t, []string{other.Mrn, unreachable.Mrn}, reports.FailedAssets())
+}
+
+func TestAggregateReporterProfiles(t *testing.T) {
+	profiled := &inventory.Asset{Mrn: "//asset/profiled"}
+	other := &inventory.Asset{Mrn: "//asset/other"}
+
+	reporter := NewAggregateReporter([]*inventory.Asset{profiled, other})
+	reporter.AddReport(profiled, &AssetReport{
+		Report:  &explorer.Report{},
+		Profile: &explorer.AssetProfile{Queries: []*explorer.QueryCost{{CodeId: "query"}}},
+	})
+	reporter.AddReport(other, &AssetReport{Report: &explorer.Report{}})
+
+	reports := reporter.Reports()
+	require.Len(t, reports.Profiles, 1)
+	assert.Equal(t, "query", reports.Profiles[profiled.Mrn].Queries[0].CodeId)
+}
@@ -32,4 +32,7 @@ type AssetJob struct {
 	Reporter         Reporter
 	runtime          *providers.Runtime
 	ProgressReporter progress.Progress
+	QueryTimeout     time.Duration
+	// Profile accounts for the cost of all queries and fields on the asset
+	Profile bool
 }
@@ -17,8 +17,18 @@ type chunkHandlerV2 struct {
 	f        func(*blockExecutor, *RawData, *Chunk, uint64) (*RawData, uint64, error)
 	Label    string
 	Typ      types.Type
+	// static is set for comparisons whose result never depends on the values
+	static staticResult
 }
 
+type staticResult byte
+
+const (
+	notStatic staticResult = iota
+	alwaysTrue
+	alwaysFalse
+)
+
 // BuiltinFunctions for all builtin types
 var BuiltinFunctionsV2 map[types.Type]map[string]chunkHandlerV2
 
@@ -26,30 +36,30 @@ func init() {
 	BuiltinFunctionsV2 = map[types.Type]map[string]chunkHandlerV2{
 		types.Nil: {
 			// == / !=
-			string("==" + types.Nil):          {f: chunkEqTrueV2, Label: "=="},
-			string("!=" + types.Nil):          {f: chunkNeqFalseV2, Label: "!="},
-			string("==" + types.Bool):         {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Bool):         {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Int):          {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Int):          {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Float):        {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Float):        {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.String):       {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.String):       {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Regex):        {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Regex):        {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Time):         {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Time):         {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Dict):         {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Dict):         {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.ArrayLike):    {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):    {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.MapLike):      {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.MapLike):      {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.ResourceLike): {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ResourceLike): {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.FunctionLike): {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.FunctionLike): {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.Nil):          {f: chunkEqTrueV2, Label: "==", static: alwaysTrue},
+			string("!=" + types.Nil):          {f: chunkNeqFalseV2, Label: "!=", static: alwaysFalse},
+			string("==" + types.Bool):         {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Bool):         {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.Int):          {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Int):          {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.Float):        {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Float):        {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.String):       {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.String):       {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.Regex):        {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Regex):        {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.Time):         {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Time):         {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.Dict):         {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Dict):         {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.ArrayLike):    {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.ArrayLike):    {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.MapLike):      {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.MapLike):      {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.ResourceLike): {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.ResourceLike): {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.FunctionLike): {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.FunctionLike): {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
 		},
 		types.Bool: {
 			// == / !=
@@ -57,30 +67,30 @@ func init() {
 			string("!=" + types.Nil):                 {f: boolNotNilV2, Label: "!="},
 			string("==" + types.Bool):                {f: boolCmpBoolV2, Label: "=="},
 			string("!=" + types.Bool):                {f: boolNotBoolV2, Label: "!="},
-			string("==" + types.Int):                 {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Int):                 {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Float):               {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Float):               {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.Int):                 {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Int):                 {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.Float):               {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Float):               {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
 			string("==" + types.String):              {f: boolCmpStringV2, Label: "=="},
 			string("!=" + types.String):              {f: boolNotStringV2, Label: "!="},
-			string("==" + types.Regex):               {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Regex):               {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Time):                {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Time):                {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.Regex):               {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Regex):               {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.Time):                {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Time):                {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
 			string("==" + types.Dict):                {f: boolCmpDictV2, Label: "=="},
 			string("!=" + types.Dict):                {f: boolNotDictV2, Label: "!="},
-			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
 			string("==" + types.Array(types.Bool)):   {f: boolCmpBoolarrayV2, Label: "=="},
 			string("!=" + types.Array(types.Bool)):   {f: boolNotBoolarrayV2, Label: "!="},
 			string("==" + types.Array(types.String)): {f: boolCmpStringarrayV2, Label: "=="},
 			string("!=" + types.Array(types.String)): {f: boolNotStringarrayV2, Label: "!="},
-			string("==" + types.MapLike):             {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.MapLike):             {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.ResourceLike):        {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ResourceLike):        {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.FunctionLike):        {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.FunctionLike):        {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.MapLike):             {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.MapLike):             {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.ResourceLike):        {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.ResourceLike):        {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
+			string("==" + types.FunctionLike):        {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.FunctionLike):        {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
 			//
 			string("&&" + types.Bool):      {f: boolAndBoolV2, Label: "&&"},
 			string("||" + types.Bool):      {f: boolOrBoolV2, Label: "||"},
@@ -115,8 +125,8 @@ func init() {
 			string("!=" + types.Regex):               {f: intNotRegexV2, Label: "!="},
 			string("==" + types.Dict):                {f: intCmpDictV2, Label: "=="},
 			string("!=" + types.Dict):                {f: intNotDictV2, Label: "!="},
-			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
 			string("==" + types.Array(types.Int)):    {f: intCmpIntarrayV2, Label: "=="},
 			string("!=" + types.Array(types.Int)):    {f: intNotIntarrayV2, Label: "!="},
 			string("==" + types.Array(types.Float)):  {f: intCmpFloatarrayV2, Label: "=="},
@@ -183,8 +193,8 @@ func init() {
 			string("!=" + types.Regex):               {f: floatNotRegexV2, Label: "!="},
 			string("==" + types.Dict):                {f: floatCmpDictV2, Label: "=="},
 			string("!=" + types.Dict):                {f: floatNotDictV2, Label: "!="},
-			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
 			string("==" + types.Array(types.Int)):    {f: floatCmpIntarrayV2, Label: "=="},
 			string("!=" + types.Array(types.Int)):    {f: floatNotIntarrayV2, Label: "!="},
 			string("==" + types.Array(types.Float)):  {f: floatCmpFloatarrayV2, Label: "=="},
@@ -255,8 +265,8 @@ func init() {
 			string("!=" + types.Float):               {f: stringNotFloatV2, Label: "!="},
 			string("==" + types.Dict):                {f: stringCmpDictV2, Label: "=="},
 			string("!=" + types.Dict):                {f: stringNotDictV2, Label: "!="},
-			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
 			string("==" + types.Array(types.String)): {f: stringCmpStringarrayV2, Label: "=="},
 			string("!=" + types.Array(types.String)): {f: stringNotStringarrayV2, Label: "!="},
 			string("==" + types.Array(types.Bool)):   {f: stringCmpBoolarrayV2, Label: "=="},
@@ -307,14 +317,23 @@ func init() {
 			string("contains" + types.Array(types.Int)):    {f: stringContainsArrayIntV2, Label: "contains"},
 			string("contains" + types.Regex):               {f: stringContainsRegex, Label: "contains"},
 			string("contains" + types.Array(types.Regex)):  {f: stringContainsArrayRegex, Label: "contains"},
-			string("find"):      {f: stringFindV2, Label: "find"},
-			string("camelcase"): {f: stringCamelcaseV2, Label: "camelcase"},
-			string("downcase"):  {f: stringDowncaseV2, Label: "downcase"},
-			string("upcase"):    {f: stringUpcaseV2, Label: "upcase"},
-			string("length"):    {f: stringLengthV2, Label: "length"},
-			string("lines"):     {f: stringLinesV2, Label: "lines"},
-			string("split"):     {f: stringSplitV2, Label: "split"},
-			string("trim"):      {f: stringTrimV2, Label: "trim"},
+			string("find"):        {f: stringFindV2, Label: "find"},
+			string("camelcase"):   {f: stringCamelcaseV2, Label: "camelcase"},
+			string("downcase"):    {f: stringDowncaseV2, Label: "downcase"},
+			string("upcase"):      {f: stringUpcaseV2, Label: "upcase"},
+			string("length"):      {f: stringLengthV2, Label: "length"},
+			string("lines"):       {f: stringLinesV2, Label: "lines"},
+			string("split"):       {f: stringSplitV2, Label: "split"},
+			string("trim"):        {f: stringTrimV2, Label: "trim"},
+			string("startsWith"):  {f: stringStartsWithV2, Label: "startsWith"},
+			string("endsWith"):    {f: stringEndsWithV2, Label: "endsWith"},
+			string("replace"):     {f: stringReplaceV2, Label: "replace"},
+			string("matchGroups"): {f: stringMatchGroupsV2, Label: "matchGroups"},
+			string("in"):          {f: stringInV2, Label: "in"},
+			string("substring"):   {f: stringSubstringV2, Label: "substring"},
+			string("padLeft"):     {f: stringPadLeftV2, Label: "padLeft"},
+			string("padRight"):    {f: stringPadRightV2, Label: "padRight"},
+			string("format"):      {f: stringFormatV2, Label: "format"},
 		},
 		types.StringSlice: {
 			// TODO: implement the remaining calls for this type
@@ -340,8 +359,8 @@ func init() {
 			string("!=" + types.Nil):                 {f: stringNotNilV2, Label: "!="},
 			string("==" + types.Regex):               {f: stringCmpStringV2, Label: "=="},
 			string("!=" + types.Regex):               {f: stringNotStringV2, Label: "!="},
-			string("==" + types.Bool):                {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Bool):                {f: chunkNeqFalseV2, Label: "!="},
+			string("==" + types.Bool):                {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Bool):                {f: chunkNeqFalseV2, Label: "!=", static: alwaysFalse},
 			string("==" + types.Int):                 {f: regexCmpIntV2, Label: "=="},
 			string("!=" + types.Int):                 {f: regexNotIntV2, Label: "!="},
 			string("==" + types.Float):               {f: regexCmpFloatV2, Label: "=="},
@@ -350,8 +369,8 @@ func init() {
 			string("!=" + types.Dict):                {f: regexNotDictV2, Label: "!="},
 			string("==" + types.String):              {f: regexCmpStringV2, Label: "=="},
 			string("!=" + types.String):              {f: regexNotStringV2, Label: "!="},
-			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
 			string("==" + types.Array(types.Regex)):  {f: stringCmpStringarrayV2, Label: "=="},
 			string("!=" + types.Array(types.Regex)):  {f: stringNotStringarrayV2, Label: "!="},
 			string("==" + types.Array(types.Int)):    {f: regexCmpIntarrayV2, Label: "=="},
@@ -412,11 +431,56 @@ func init() {
 			string("*" + types.Float):      {f: timeTimesFloatV2, Label: "*", Typ: types.Time},
 			string("*" + types.Dict):       {f: timeTimesDictV2, Label: "*", Typ: types.Time},
 			// fields
-			string("seconds"): {f: timeSecondsV2, Label: "seconds"},
-			string("minutes"): {f: timeMinutesV2, Label: "minutes"},
-			string("hours"):   {f: timeHoursV2, Label: "hours"},
-			string("days"):    {f: timeDaysV2, Label: "days"},
-			string("unix"):    {f: timeUnixV2, Label: "unix"},
+			string("seconds"):       {f: timeSecondsV2, Label: "seconds"},
+			string("minutes"):       {f: timeMinutesV2, Label: "minutes"},
+			string("hours"):         {f: timeHoursV2, Label: "hours"},
+			string("days"):          {f: timeDaysV2, Label: "days"},
+			string("unix"):          {f: timeUnixV2, Label: "unix"},
+			string("format"):        {f: timeFormatV2, Label: "format"},
+			string("inTimezone"):    {f: timeInTimezoneV2, Label: "inTimezone"},
+			string("startOfMinute"): {f: timeStartOfMinuteV2, Label: "startOfMinute"},
+			string("startOfHour"):   {f: timeStartOfHourV2, Label: "startOfHour"},
+			string("startOfDay"):    {f: timeStartOfDayV2, Label: "startOfDay"},
+			string("startOfWeek"):   {f: timeStartOfWeekV2, Label: "startOfWeek"},
+			string("startOfMonth"):  {f: timeStartOfMonthV2, Label: "startOfMonth"},
+			string("startOfYear"):   {f: timeStartOfYearV2, Label: "startOfYear"},
+		},
+		types.Version: {
+			string("==" + types.Nil):     {f: versionCmpNilV2, Label: "=="},
+			string("!=" + types.Nil):     {f: versionNotNilV2, Label: "!="},
+			string("==" + types.Version): {f: versionCmpVersionV2, Label: "=="},
+			string("!=" + types.Version): {f: versionNotVersionV2, Label: "!="},
+			string("<" + types.Version):  {f: versionLTVersionV2, Label: "<"},
+			string("<=" + types.Version): {f: versionLTEVersionV2, Label: "<="},
+			string(">" + types.Version):  {f: versionGTVersionV2, Label: ">"},
+			string(">=" + types.Version): {f: versionGTEVersionV2, Label: ">="},
+			string("==" + types.String):  {f: versionCmpVersionV2, Label: "=="},
+			string("!=" + types.String):  {f: versionNotVersionV2, Label: "!="},
+			string("<" + types.String):   {f: versionLTVersionV2, Label: "<"},
+			string("<=" + types.String):  {f: versionLTEVersionV2, Label: "<="},
+			string(">" + types.String):   {f: versionGTVersionV2, Label: ">"},
+			string(">=" + types.String):  {f: versionGTEVersionV2, Label: ">="},
+			// fields
+			string("inRange"): {f: versionInRangeV2, Label: "inRange"},
+		},
+		types.IP: {
+			string("==" + types.Nil):    {f: ipCmpNilV2, Label: "=="},
+			string("!=" + types.Nil):    {f: ipNotNilV2, Label: "!="},
+			string("==" + types.IP):     {f: ipCmpIPV2, Label: "=="},
+			string("!=" + types.IP):     {f: ipNotIPV2, Label: "!="},
+			string("==" + types.String): {f: ipCmpIPV2, Label: "=="},
+			string("!=" + types.String): {f: ipNotIPV2, Label: "!="},
+			// fields
+			string("version"):       {f: ipVersionV2, Label: "version"},
+			string("address"):       {f: ipAddressV2, Label: "address"},
+			string("prefix"):        {f: ipPrefixV2, Label: "prefix"},
+			string("prefixLength"):  {f: ipPrefixLengthV2, Label: "prefixLength"},
+			string("isPrivate"):     {f: ipIsPrivateV2, Label: "isPrivate"},
+			string("isLoopback"):    {f: ipIsLoopbackV2, Label: "isLoopback"},
+			string("isUnspecified"): {f: ipIsUnspecifiedV2, Label: "isUnspecified"},
+			string("inRange"):       {f: ipInRangeV2, Label: "inRange"},
+			string("contains"):      {f: ipContainsV2, Label: "contains"},
+			string("overlaps"):      {f: ipOverlapsV2, Label: "overlaps"},
 		},
 		types.Dict: {
 			string("==" + types.Nil):                 {f: dictCmpNilV2, Label: "=="},
@@ -499,6 +563,15 @@ func init() {
 			"lines":                           {f: dictLinesV2, Label: "lines"},
 			"split":                           {f: dictSplitV2, Label: "split"},
 			"trim":                            {f: dictTrimV2, Label: "trim"},
+			"startsWith":                      {f: dictStartsWithV2, Label: "startsWith"},
+			"endsWith":                        {f: dictEndsWithV2, Label: "endsWith"},
+			"replace":                         {f: dictReplaceV2, Label: "replace"},
+			"matchGroups":                     {f: dictMatchGroupsV2, Label: "matchGroups"},
+			"in":                              {f: dictInV2, Label: "in"},
+			"substring":                       {f: dictSubstringV2, Label: "substring"},
+			"padLeft":                         {f: dictPadLeftV2, Label: "padLeft"},
+			"padRight":                        {f: dictPadRightV2, Label: "padRight"},
+			"format":                          {f: dictFormatV2, Label: "format"},
 			"keys":                            {f: dictKeysV2, Label: "keys"},
 			"values":                          {f: dictValuesV2, Label: "values"},
 			"where":                           {f: dictWhereV2, Label: "where"},
@@ -542,6 +615,18 @@ func init() {
 			"unique":                 {f: arrayUniqueV2},
 			"difference":             {f: arrayDifferenceV2},
 			"containsNone":           {f: arrayContainsNoneV2},
+			"sort":                   {f: arraySortV2},
+			"sortDesc":               {f: arraySortDescV2},
+			"fieldSort":              {f: arrayFieldSortV2},
+			"fieldSortDesc":          {f: arrayFieldSortDescV2},
+			"groupBy":                {f: arrayGroupByV2},
+			"min":                    {f: arrayMinV2},
+			"max":                    {f: arrayMaxV2},
+			"sum":                    {f: arraySumV2},
+			"avg":                    {f: arrayAvgV2},
+			"sample":                 {f: arraySampleV2},
+			"take":                   {f: arrayTakeV2},
+			"skip":                   {f: arraySkipV2},
 			"==":                     {Compiler: compileArrayOpArray("=="), f: tarrayCmpTarrayV2, Label: "=="},
 			"!=":                     {Compiler: compileArrayOpArray("!="), f: tarrayNotTarrayV2, Label: "!="},
 			"==" + string(types.Nil): {f: arrayCmpNilV2},
@@ -647,8 +732,8 @@ func init() {
 		},
 		types.ResourceLike: {
 			// == / !=
-			string("==" + types.Nil): {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Nil): {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.Nil): {f: chunkEqFalseV2, Label: "==", static: alwaysFalse},
+			string("!=" + types.Nil): {f: chunkNeqTrueV2, Label: "!=", static: alwaysTrue},
 			// fields
 			"where":     {f: resourceWhereV2},
 			"$whereNot": {f: resourceWhereNotV2},
@@ -657,8 +742,17 @@ func init() {
 			"{}": {f: func(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
 				return e.runBlock(bind, chunk.Function.Args[0], chunk.Function.Args[1:], ref)
 			}},
-			// TODO: [#32] unique builtin fields that need a long-term support in LR
-			string(types.Resource("parse") + ".date"): {f: resourceDateV2},
+			// code compiled by older versions calls parse.date by its full ID
+			string(types.Resource("parse") + ".date"): {f: timeParseV2},
+		},
+		// functions of core resources, which take arguments and can't be
+		// declared in LR
+		types.Resource("time"): {
+			"parse": {f: timeParseV2, Label: "parse"},
+		},
+		types.Resource("parse"): {
+			// date is an alias of time.parse
+			"date": {f: timeParseV2, Label: "date"},
 		},
 	}
 
@@ -758,6 +852,13 @@ func runResourceFunction(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint
 
 // BuiltinFunction provides the handler for this type's function
 func BuiltinFunctionV2(typ types.Type, name string) (*chunkHandlerV2, error) {
+	// functions of specific resources take precedence
+	if h, ok := BuiltinFunctionsV2[typ]; ok {
+		if fh, ok := h[name]; ok {
+			return &fh, nil
+		}
+	}
+
 	h, ok := BuiltinFunctionsV2[typ.Underlying()]
 	if !ok {
 		return nil, errors.New("cannot find functions for type '" + typ.Label() + "' (called '" + name + "')")
@@ -769,6 +870,25 @@ func BuiltinFunctionV2(typ types.Type, name string) (*chunkHandlerV2, error) {
 	return &fh, nil
 }
 
+// StaticComparison checks if a comparison on this type always has the same
+// result, e.g. when comparing a bool to a string. If it does, it returns
+// that result and true.
+func StaticComparison(typ types.Type, name string) (bool, bool) {
+	h, err := BuiltinFunctionV2(typ, name)
+	if err != nil {
+		return false, false
+	}
+
+	switch h.static {
+	case alwaysTrue:
+		return true, true
+	case alwaysFalse:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
 // this is called for objects that call a function
 func (e *blockExecutor) runBoundFunction(bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
 	log.Trace().Uint64("ref", ref).Str("id", chunk.Id).Msg("exec> run bound function")
@@ -5,6 +5,8 @@ package llx
 
 import (
 	"errors"
+	"math/rand"
+	"sort"
 	"strconv"
 
 	"go.mondoo.com/cnquery/types"
@@ -685,6 +687,432 @@ func arrayUniqueV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*
 	return &RawData{Type: bind.Type, Value: unique}, 0, nil
 }
 
+// lessFunc returns a function to sort values of the given type. Besides
+// all types in types.Less, it supports versions and dicts.
+func lessFunc(typ types.Type) (func(interface{}, interface{}) bool, bool) {
+	if f, ok := types.Less[typ]; ok {
+		return f, true
+	}
+
+	switch typ {
+	case types.Version:
+		return func(left interface{}, right interface{}) bool {
+			cmp, err := left.(Version).Compare(right.(Version).Value)
+			return err == nil && cmp < 0
+		}, true
+	case types.Dict:
+		return dictLess, true
+	default:
+		return nil, false
+	}
+}
+
+// dictLess sorts dict values of different kinds: null, booleans, numbers,
+// and strings, in that order. All other values are considered equal.
+func dictLess(left interface{}, right interface{}) bool {
+	lr, rr := dictSortRank(left), dictSortRank(right)
+	if lr != rr {
+		return lr < rr
+	}
+
+	switch l := left.(type) {
+	case bool:
+		return !l && right.(bool)
+	case string:
+		return l < right.(string)
+	case int64, float64:
+		lf, _ := toFloat64(l)
+		rf, _ := toFloat64(right)
+		return lf < rf
+	default:
+		return false
+	}
+}
+
+func dictSortRank(v interface{}) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case int64, float64:
+		return 2
+	case string:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch x := v.(type) {
+	case int64:
+		return float64(x), true
+	case float64:
+		return x, true
+	default:
+		return 0, false
+	}
+}
+
+// sortList sorts the list by its keys, which may be the list itself.
+// Null keys are always sorted to the end of the list.
+func sortList(list []interface{}, keys []interface{}, less func(interface{}, interface{}) bool, desc bool) []interface{} {
+	idx := make([]int, len(list))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	sort.SliceStable(idx, func(i, j int) bool {
+		left, right := keys[idx[i]], keys[idx[j]]
+		if left == nil || right == nil {
+			return right == nil && left != nil
+		}
+		if desc {
+			return less(right, left)
+		}
+		return less(left, right)
+	})
+
+	res := make([]interface{}, len(list))
+	for i := range idx {
+		res[i] = list[idx[i]]
+	}
+	return res
+}
+
+func arraySortV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arraySort(bind, false)
+}
+
+func arraySortDescV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arraySort(bind, true)
+}
+
+func arraySort(bind *RawData, desc bool) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	less, ok := lessFunc(bind.Type.Child())
+	if !ok {
+		return nil, 0, errors.New("cannot sort array of " + bind.Type.Child().Label())
+	}
+
+	list := bind.Value.([]interface{})
+	return &RawData{Type: bind.Type, Value: sortList(list, list, less, desc)}, 0, nil
+}
+
+func arrayFieldSortV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayFieldSort(e, bind, chunk, ref, false)
+}
+
+func arrayFieldSortDescV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayFieldSort(e, bind, chunk, ref, true)
+}
+
+// arrayFieldValues runs the function block of a chunk for every entry of
+// the array and calls onComplete with the block's value for every entry
+func arrayFieldValues(e *blockExecutor, chunk *Chunk, ref uint64, id string, onComplete func(items *RawData, values []*RawData)) (*RawData, uint64, error) {
+	itemsRef := chunk.Function.Args[0]
+	items, rref, err := e.resolveValue(itemsRef, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if items.Value == nil {
+		return &RawData{Type: items.Type}, 0, nil
+	}
+
+	list := items.Value.([]interface{})
+	if len(list) == 0 {
+		onComplete(items, nil)
+		return nil, 0, nil
+	}
+
+	arg1 := chunk.Function.Args[1]
+	fref, ok := arg1.RefV2()
+	if !ok {
+		return nil, 0, errors.New("Failed to retrieve function reference of '" + id + "' call")
+	}
+
+	dref, err := e.ensureArgsResolved(chunk.Function.Args[2:], ref)
+	if dref != 0 || err != nil {
+		return nil, dref, err
+	}
+
+	ct := items.Type.Child()
+	argsList := make([][]*RawData, len(list))
+	for i := range list {
+		argsList[i] = []*RawData{
+			{
+				Type:  ct,
+				Value: list[i],
+			},
+		}
+	}
+
+	err = e.runFunctionBlocks(argsList, fref, func(results []arrayBlockCallResult, errs []error) {
+		f := e.ctx.code.Block(fref)
+		epChecksum := e.ctx.code.Checksums[f.Entrypoints[0]]
+
+		values := make([]*RawData, len(results))
+		for i, res := range results {
+			if epVal, ok := res.entrypoints[epChecksum].(*RawData); ok {
+				values[i] = epVal
+			} else {
+				values[i] = &RawData{Type: types.Nil}
+			}
+		}
+		onComplete(items, values)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return nil, 0, nil
+}
+
+func (e *blockExecutor) storeAndTrigger(ref uint64, data *RawData) {
+	e.cache.Store(ref, &stepCache{
+		Result:   data,
+		IsStatic: false,
+	})
+	e.triggerChain(ref, data)
+}
+
+func arrayFieldSort(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, desc bool) (*RawData, uint64, error) {
+	return arrayFieldValues(e, chunk, ref, "sort", func(items *RawData, values []*RawData) {
+		list := items.Value.([]interface{})
+		keys := make([]interface{}, len(values))
+		keyType := types.Nil
+		for i := range values {
+			if values[i].Error != nil {
+				e.storeAndTrigger(ref, &RawData{Type: items.Type, Error: values[i].Error})
+				return
+			}
+			keys[i] = values[i].Value
+			if keys[i] != nil {
+				keyType = values[i].Type
+			}
+		}
+
+		less, ok := lessFunc(keyType)
+		if !ok && keyType != types.Nil {
+			e.storeAndTrigger(ref, &RawData{Type: items.Type, Error: errors.New("cannot sort by values of type " + keyType.Label())})
+			return
+		}
+
+		res := list
+		if less != nil {
+			res = sortList(list, keys, less, desc)
+		}
+		e.storeAndTrigger(ref, &RawData{Type: items.Type, Value: res})
+	})
+}
+
+func arrayGroupByV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayFieldValues(e, chunk, ref, "groupBy", func(items *RawData, values []*RawData) {
+		list := items.Value.([]interface{})
+		res := map[string]interface{}{}
+		for i := range values {
+			if values[i].Error != nil {
+				e.storeAndTrigger(ref, &RawData{Type: types.Map(types.String, items.Type), Error: values[i].Error})
+				return
+			}
+
+			key := groupKey(values[i])
+			group, _ := res[key].([]interface{})
+			res[key] = append(group, list[i])
+		}
+		e.storeAndTrigger(ref, &RawData{Type: types.Map(types.String, items.Type), Value: res})
+	})
+}
+
+// groupKey turns the value that entries are grouped by into a map key
+func groupKey(value *RawData) string {
+	if s, ok := value.Value.(string); ok {
+		return s
+	}
+	if value.Value == nil {
+		return ""
+	}
+	if value.Type == types.Dict {
+		return dictRawDataString(value.Value)
+	}
+	return rawDataString(value.Type, value.Value)
+}
+
+func arrayMinV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayExtreme(bind, "min", func(less func(interface{}, interface{}) bool, cur interface{}, next interface{}) bool {
+		return less(next, cur)
+	})
+}
+
+func arrayMaxV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayExtreme(bind, "max", func(less func(interface{}, interface{}) bool, cur interface{}, next interface{}) bool {
+		return less(cur, next)
+	})
+}
+
+// arrayExtreme finds the min or max entry of an array, ignoring nulls
+func arrayExtreme(bind *RawData, id string, replace func(less func(interface{}, interface{}) bool, cur interface{}, next interface{}) bool) (*RawData, uint64, error) {
+	ct := bind.Type.Child()
+	if bind.Value == nil {
+		return &RawData{Type: ct, Error: bind.Error}, 0, nil
+	}
+
+	less, ok := lessFunc(ct)
+	if !ok {
+		return nil, 0, errors.New("cannot get " + id + " of array of " + ct.Label())
+	}
+
+	var res interface{}
+	list := bind.Value.([]interface{})
+	for i := range list {
+		if list[i] == nil {
+			continue
+		}
+		if res == nil || replace(less, res, list[i]) {
+			res = list[i]
+		}
+	}
+	return &RawData{Type: ct, Value: res}, 0, nil
+}
+
+func arraySumV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	ct := bind.Type.Child()
+	if bind.Value == nil {
+		return &RawData{Type: numberSumType(ct), Error: bind.Error}, 0, nil
+	}
+
+	list := bind.Value.([]interface{})
+	if ct == types.Int {
+		var sum int64
+		for i := range list {
+			if v, ok := list[i].(int64); ok {
+				sum += v
+			}
+		}
+		return IntData(sum), 0, nil
+	}
+
+	var sum float64
+	for i := range list {
+		if v, ok := toFloat64(list[i]); ok {
+			sum += v
+		}
+	}
+	return FloatData(sum), 0, nil
+}
+
+// numberSumType is the type of the sum of an array of numbers. Only
+// integers add up to integers, everything else is a float.
+func numberSumType(typ types.Type) types.Type {
+	if typ == types.Int {
+		return types.Int
+	}
+	return types.Float
+}
+
+func arrayAvgV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Float, Error: bind.Error}, 0, nil
+	}
+
+	var sum float64
+	var cnt int
+	list := bind.Value.([]interface{})
+	for i := range list {
+		if v, ok := toFloat64(list[i]); ok {
+			sum += v
+			cnt++
+		}
+	}
+	if cnt == 0 {
+		return &RawData{Type: types.Float}, 0, nil
+	}
+	return FloatData(sum / float64(cnt)), 0, nil
+}
+
+// arrayCount resolves the number of entries that array functions like
+// take and skip are called with
+func arrayCount(e *blockExecutor, chunk *Chunk, ref uint64, id string) (int, uint64, error) {
+	if len(chunk.Function.Args) != 1 {
+		return 0, 0, errors.New("Called `" + id + "` with " + strconv.Itoa(len(chunk.Function.Args)) + " arguments, expected 1")
+	}
+
+	arg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return 0, rref, err
+	}
+
+	n, ok := arg.Value.(int64)
+	if !ok {
+		return 0, 0, errors.New("Called `" + id + "` with " + arg.Type.Label() + ", expected int")
+	}
+	if n < 0 {
+		n = 0
+	}
+	return int(n), 0, nil
+}
+
+func arrayTakeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	n, rref, err := arrayCount(e, chunk, ref, "take")
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	list := bind.Value.([]interface{})
+	if n > len(list) {
+		n = len(list)
+	}
+	return &RawData{Type: bind.Type, Value: list[:n]}, 0, nil
+}
+
+func arraySkipV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	n, rref, err := arrayCount(e, chunk, ref, "skip")
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	list := bind.Value.([]interface{})
+	if n > len(list) {
+		n = len(list)
+	}
+	return &RawData{Type: bind.Type, Value: list[n:]}, 0, nil
+}
+
+// arraySampleV2 picks n random entries of an array, which keep their order
+func arraySampleV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	n, rref, err := arrayCount(e, chunk, ref, "sample")
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	list := bind.Value.([]interface{})
+	if n >= len(list) {
+		return bind, 0, nil
+	}
+
+	idx := rand.Perm(len(list))[:n]
+	sort.Ints(idx)
+	res := make([]interface{}, n)
+	for i := range idx {
+		res[i] = list[idx[i]]
+	}
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
 func arrayDifferenceV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
 	if bind.Value == nil {
 		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
@@ -6,6 +6,7 @@ package llx
 import (
 	"errors"
 	"strconv"
+	"time"
 
 	"go.mondoo.com/cnquery/types"
 )
@@ -37,6 +38,10 @@ func init() {
 		"{}":             blockV2,
 		"return":         returnCallV2,
 		"createResource": globalCreateResource,
+		"version":        versionCallV2,
+		"ip":             ipCallV2,
+		"cidr":           cidrCallV2,
+		"duration":       durationCallV2,
 	}
 }
 
@@ -182,6 +187,81 @@ func typeofCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64,
 	return StringData(res.Type.Label()), 0, nil
 }
 
+func versionCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	if len(f.Args) != 1 && len(f.Args) != 2 {
+		return nil, 0, errors.New("Called `version` with " + strconv.Itoa(len(f.Args)) + " arguments, expected 1 or 2")
+	}
+
+	res, dref, err := e.resolveValue(f.Args[0], ref)
+	if err != nil || dref != 0 || res == nil {
+		return res, dref, err
+	}
+	if res.Error != nil {
+		return &RawData{Type: types.Version, Error: res.Error}, 0, nil
+	}
+
+	var format string
+	if len(f.Args) == 2 {
+		typ, dref, err := e.resolveValue(f.Args[1], ref)
+		if err != nil || dref != 0 || typ == nil {
+			return typ, dref, err
+		}
+		format, _ = typ.Value.(string)
+	}
+
+	switch v := res.Value.(type) {
+	case nil:
+		return &RawData{Type: types.Version}, 0, nil
+	case string:
+		return VersionData(NewVersion(v, format)), 0, nil
+	case Version:
+		if format != "" {
+			v = NewVersion(v.Value, format)
+		}
+		return VersionData(v), 0, nil
+	default:
+		return nil, 0, errors.New("cannot turn " + res.Type.Label() + " into a version")
+	}
+}
+
+func ipCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	return ipCall(e, f, ref, "ip", ParseIP)
+}
+
+func cidrCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	return ipCall(e, f, ref, "cidr", ParseCIDR)
+}
+
+func ipCall(e *blockExecutor, f *Function, ref uint64, id string, parse func(string) (IP, error)) (*RawData, uint64, error) {
+	if len(f.Args) != 1 {
+		return nil, 0, errors.New("Called `" + id + "` with " + strconv.Itoa(len(f.Args)) + " arguments, expected 1")
+	}
+
+	res, dref, err := e.resolveValue(f.Args[0], ref)
+	if err != nil || dref != 0 || res == nil {
+		return res, dref, err
+	}
+	if res.Error != nil {
+		return &RawData{Type: types.IP, Error: res.Error}, 0, nil
+	}
+
+	var ip IP
+	switch v := res.Value.(type) {
+	case nil:
+		return &RawData{Type: types.IP}, 0, nil
+	case string:
+		ip, err = parse(v)
+	case IP:
+		ip, err = parse(v.String())
+	default:
+		return nil, 0, errors.New("cannot turn " + res.Type.Label() + " into an IP")
+	}
+	if err != nil {
+		return &RawData{Type: types.IP, Error: err}, 0, nil
+	}
+	return IPData(ip), 0, nil
+}
+
 func expectV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
 	if len(f.Args) != 1 {
 		return nil, 0, errors.New("Called expect with " + strconv.Itoa(len(f.Args)) + " arguments, expected 1")
@@ -209,3 +289,38 @@ func returnCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64,
 	arg := f.Args[0]
 	return e.resolveValue(arg, ref)
 }
+
+// durationCallV2 turns a value into a duration. Strings are parsed like
+// 90d or P1Y2M, ints are seconds.
+func durationCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	if len(f.Args) != 1 {
+		return nil, 0, errors.New("Called `duration` with " + strconv.Itoa(len(f.Args)) + " arguments, expected 1")
+	}
+
+	res, dref, err := e.resolveValue(f.Args[0], ref)
+	if err != nil || dref != 0 || res == nil {
+		return res, dref, err
+	}
+	if res.Error != nil {
+		return &RawData{Type: types.Time, Error: res.Error}, 0, nil
+	}
+
+	switch v := res.Value.(type) {
+	case nil:
+		return &RawData{Type: types.Time}, 0, nil
+	case string:
+		seconds, err := ParseDuration(v)
+		if err != nil {
+			return &RawData{Type: types.Time, Error: err}, 0, nil
+		}
+		return DurationData(seconds), 0, nil
+	case int64:
+		return DurationData(v), 0, nil
+	case float64:
+		return DurationData(int64(v)), 0, nil
+	case *time.Time:
+		return TimeDataPtr(v), 0, nil
+	default:
+		return nil, 0, errors.New("cannot turn " + res.Type.Label() + " into a duration")
+	}
+}
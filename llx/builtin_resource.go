@@ -253,22 +253,32 @@ var defaultTimeFormatsOrder = []string{
 	time.Stamp,
 }
 
-func resourceDateV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+// timeParseV2 parses a string into a time. It is used by time.parse and
+// its older alias parse.date.
+func timeParseV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
 	args, rref, err := primitive2array(e, ref, chunk.Function.Args)
 	if err != nil || rref != 0 {
 		return nil, rref, err
 	}
 
+	if args[0] == nil {
+		return &RawData{Type: types.Time}, 0, nil
+	}
+	value, ok := args[0].(string)
+	if !ok {
+		return nil, 0, errors.New("failed to parse time: value must be a string")
+	}
+
 	var format string
 	if len(args) >= 2 {
-		format = args[1].(string)
+		format, _ = args[1].(string)
 		if f, ok := timeFormats[format]; ok {
 			format = f
 		}
 	}
 
 	if format != "" {
-		parsed, err := time.Parse(format, args[0].(string))
+		parsed, err := time.Parse(format, value)
 		if err != nil {
 			return nil, 0, errors.New("failed to parse time: " + err.Error())
 		}
@@ -278,7 +288,7 @@ func resourceDateV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (
 	// Note: Yes, this approach is much slower than giving us a hint
 	// about which time format is used.
 	for _, format := range defaultTimeFormatsOrder {
-		parsed, err := time.Parse(format, args[0].(string))
+		parsed, err := time.Parse(format, value)
 		if err != nil {
 			continue
 		}
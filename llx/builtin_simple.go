@@ -5,12 +5,14 @@ package llx
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"go.mondoo.com/cnquery/types"
 )
@@ -2276,6 +2278,282 @@ func stringTrimV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*R
 	return StringData(res), 0, nil
 }
 
+// stringArgs resolves all arguments of a string function
+func stringArgs(e *blockExecutor, chunk *Chunk, ref uint64) ([]*RawData, uint64, error) {
+	res := make([]*RawData, len(chunk.Function.Args))
+	for i := range chunk.Function.Args {
+		arg, rref, err := e.resolveValue(chunk.Function.Args[i], ref)
+		if err != nil || rref > 0 {
+			return nil, rref, err
+		}
+		res[i] = arg
+	}
+	return res, 0, nil
+}
+
+// stringAffixV2 checks if the string has any of the given affixes, which
+// are either a string or a list of strings
+func stringAffixV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, has func(string, string) bool) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Bool}, 0, nil
+	}
+
+	args, rref, err := stringArgs(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	s := bind.Value.(string)
+	switch v := args[0].Value.(type) {
+	case string:
+		return BoolData(has(s, v)), 0, nil
+	case []interface{}:
+		for i := range v {
+			if affix, ok := v[i].(string); ok && has(s, affix) {
+				return BoolData(true), 0, nil
+			}
+		}
+		return BoolData(false), 0, nil
+	default:
+		return BoolData(false), 0, nil
+	}
+}
+
+func stringStartsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return stringAffixV2(e, bind, chunk, ref, strings.HasPrefix)
+}
+
+func stringEndsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return stringAffixV2(e, bind, chunk, ref, strings.HasSuffix)
+}
+
+// stringReplaceV2 replaces all occurrences of a string or all matches of a
+// regex. Replacements for regex matches may refer to groups, e.g. via $1.
+func stringReplaceV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	args, rref, err := stringArgs(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	old, ok := args[0].Value.(string)
+	if !ok {
+		return &RawData{Type: types.String, Error: errors.New("failed to replace in string, search value was null")}, 0, nil
+	}
+	repl, ok := args[1].Value.(string)
+	if !ok {
+		return &RawData{Type: types.String, Error: errors.New("failed to replace in string, replacement was null")}, 0, nil
+	}
+
+	s := bind.Value.(string)
+	if args[0].Type != types.Regex {
+		return StringData(strings.ReplaceAll(s, old, repl)), 0, nil
+	}
+
+	re, err := regexp.Compile(old)
+	if err != nil {
+		return nil, 0, errors.New("Failed to compile regular expression: " + old)
+	}
+	return StringData(re.ReplaceAllString(s, repl)), 0, nil
+}
+
+// stringMatchGroupsV2 returns the groups of the first match of a regex.
+// Named groups are returned by their name, all others by their index.
+func stringMatchGroupsV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Map(types.String, types.String)
+	if bind.Value == nil {
+		return &RawData{Type: typ}, 0, nil
+	}
+
+	args, rref, err := stringArgs(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	reContent, ok := args[0].Value.(string)
+	if !ok {
+		return &RawData{Type: typ, Error: errors.New("failed to match string, regex was null")}, 0, nil
+	}
+	re, err := regexp.Compile(reContent)
+	if err != nil {
+		return nil, 0, errors.New("Failed to compile regular expression: " + reContent)
+	}
+
+	res := map[string]interface{}{}
+	match := re.FindStringSubmatch(bind.Value.(string))
+	names := re.SubexpNames()
+	for i := 1; i < len(match); i++ {
+		name := names[i]
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		res[name] = match[i]
+	}
+	return &RawData{Type: typ, Value: res}, 0, nil
+}
+
+func stringInV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Bool}, 0, nil
+	}
+
+	args, rref, err := stringArgs(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	list, _ := args[0].Value.([]interface{})
+	s := bind.Value.(string)
+	for i := range list {
+		if v, ok := list[i].(string); ok && v == s {
+			return BoolData(true), 0, nil
+		}
+	}
+	return BoolData(false), 0, nil
+}
+
+// stringSubstringV2 returns the characters between start and an optional
+// end, both of which are capped to the bounds of the string
+func stringSubstringV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	args, rref, err := stringArgs(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	runes := []rune(bind.Value.(string))
+	size := int64(len(runes))
+	start, _ := args[0].Value.(int64)
+	end := size
+	if len(args) > 1 {
+		if v, ok := args[1].Value.(int64); ok {
+			end = v
+		}
+	}
+
+	if start < 0 {
+		start = 0
+	} else if start > size {
+		start = size
+	}
+	if end > size {
+		end = size
+	} else if end < start {
+		end = start
+	}
+	return StringData(string(runes[start:end])), 0, nil
+}
+
+// maxPadLength is the longest string that padLeft and padRight create,
+// which keeps queries from allocating huge amounts of memory
+const maxPadLength = 1 << 20
+
+// stringPadV2 pads a string to the given length with spaces or the
+// optional padding string
+func stringPadV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, left bool) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	args, rref, err := stringArgs(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	length, _ := args[0].Value.(int64)
+	pad := " "
+	if len(args) > 1 {
+		if v, ok := args[1].Value.(string); ok && v != "" {
+			pad = v
+		}
+	}
+
+	s := bind.Value.(string)
+	if length > maxPadLength {
+		return nil, 0, errors.New("cannot pad string to more than " + strconv.Itoa(maxPadLength) + " characters")
+	}
+	missing := int(length) - utf8.RuneCountInString(s)
+	if missing <= 0 {
+		return StringData(s), 0, nil
+	}
+
+	repeat := missing/utf8.RuneCountInString(pad) + 1
+	padding := []rune(strings.Repeat(pad, repeat))[:missing]
+	if left {
+		return StringData(string(padding) + s), 0, nil
+	}
+	return StringData(s + string(padding)), 0, nil
+}
+
+func stringPadLeftV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return stringPadV2(e, bind, chunk, ref, true)
+}
+
+func stringPadRightV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return stringPadV2(e, bind, chunk, ref, false)
+}
+
+// stringFormatV2 uses the string as format for all arguments, the same way
+// that sprintf does
+func stringFormatV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	args, rref, err := stringArgs(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	values := make([]interface{}, len(args))
+	for i := range args {
+		switch v := args[i].Value.(type) {
+		case *time.Time:
+			if v != nil {
+				values[i] = *v
+			}
+		default:
+			values[i] = v
+		}
+	}
+	format := bind.Value.(string)
+	if err := checkFormatWidths(format); err != nil {
+		return nil, 0, err
+	}
+	return StringData(fmt.Sprintf(format, values...)), 0, nil
+}
+
+// checkFormatWidths makes sure that no verb in a format string has a width
+// or precision above maxPadLength, e.g. %0999999999d
+func checkFormatWidths(format string) error {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		// flags, argument indexes, width and precision come before the verb
+		for i++; i < len(format) && strings.IndexByte("+-# 0123456789.[]*", format[i]) != -1; i++ {
+			if format[i] < '1' || format[i] > '9' {
+				continue
+			}
+			n := 0
+			for ; i < len(format) && format[i] >= '0' && format[i] <= '9'; i++ {
+				n = n*10 + int(format[i]-'0')
+				if n > maxPadLength {
+					return errors.New("cannot format values wider than " + strconv.Itoa(maxPadLength) + " characters")
+				}
+			}
+			i--
+		}
+	}
+	return nil
+}
+
 // time methods
 
 // zeroTimeOffset to help convert unix times into base times that start at the year 0
@@ -2369,6 +2647,108 @@ func timeUnixV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*Raw
 	return IntData(int64(raw)), 0, nil
 }
 
+// timeFormatV2 formats the time with a Go layout like 2006-01-02 or one
+// of the named formats that parse.date supports, e.g. rfc3339
+func timeFormatV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	t, ok := bind.Value.(*time.Time)
+	if !ok || t == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	args, rref, err := stringArgs(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	layout, ok := args[0].Value.(string)
+	if !ok {
+		return &RawData{Type: types.String, Error: errors.New("the format of a time must be a string")}, 0, nil
+	}
+	if f, ok := timeFormats[layout]; ok {
+		layout = f
+	}
+	return StringData(t.Format(layout)), 0, nil
+}
+
+func timeInTimezoneV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	t, ok := bind.Value.(*time.Time)
+	if !ok || t == nil {
+		return &RawData{Type: types.Time}, 0, nil
+	}
+
+	args, rref, err := stringArgs(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	name, _ := args[0].Value.(string)
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return &RawData{Type: types.Time, Error: errors.New("unknown timezone '" + name + "'")}, 0, nil
+	}
+	return TimeData(t.In(loc)), 0, nil
+}
+
+// startOf truncates a time to the beginning of the given unit in the
+// time's own location
+func startOf(t time.Time, unit string) time.Time {
+	y, m, d := t.Date()
+	loc := t.Location()
+	switch unit {
+	case "minute":
+		return time.Date(y, m, d, t.Hour(), t.Minute(), 0, 0, loc)
+	case "hour":
+		return time.Date(y, m, d, t.Hour(), 0, 0, 0, loc)
+	case "day":
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	case "week":
+		// weeks start on Monday, following ISO 8601
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, loc)
+	case "month":
+		return time.Date(y, m, 1, 0, 0, 0, 0, loc)
+	case "year":
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, loc)
+	default:
+		return t
+	}
+}
+
+func timeStartOfV2(bind *RawData, unit string) (*RawData, uint64, error) {
+	t, ok := bind.Value.(*time.Time)
+	if !ok || t == nil {
+		return &RawData{Type: types.Time}, 0, nil
+	}
+	if *t == NeverPastTime || *t == NeverFutureTime {
+		return bind, 0, nil
+	}
+	return TimeData(startOf(*t, unit)), 0, nil
+}
+
+func timeStartOfMinuteV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return timeStartOfV2(bind, "minute")
+}
+
+func timeStartOfHourV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return timeStartOfV2(bind, "hour")
+}
+
+func timeStartOfDayV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return timeStartOfV2(bind, "day")
+}
+
+func timeStartOfWeekV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return timeStartOfV2(bind, "week")
+}
+
+func timeStartOfMonthV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return timeStartOfV2(bind, "month")
+}
+
+func timeStartOfYearV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return timeStartOfV2(bind, "year")
+}
+
 // stringslice methods
 
 func stringsliceEqString(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
@@ -2398,3 +2778,188 @@ func stringsliceEqArrayString(e *blockExecutor, bind *RawData, chunk *Chunk, ref
 		return StringData("")
 	})
 }
+
+// version methods
+
+func opVersionCmpNil(left *RawData, right *RawData) bool {
+	return left.Value == nil
+}
+
+func versionCmpNilV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolOpV2(e, bind, chunk, ref, opVersionCmpNil)
+}
+
+func versionNotNilV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolNotOpV2(e, bind, chunk, ref, opVersionCmpNil)
+}
+
+// versionOpV2 compares the version to the other operand, which is either
+// a version or a string, via the rules of the version's format
+func versionOpV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, f func(int) bool) (*RawData, uint64, error) {
+	return nonNilDataOpV2(e, bind, chunk, ref, types.Bool, func(left interface{}, right interface{}) *RawData {
+		cmp, err := left.(Version).Compare(versionArg(right))
+		if err != nil {
+			return &RawData{Type: types.Bool, Error: err}
+		}
+		return BoolData(f(cmp))
+	})
+}
+
+func versionCmpVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOpV2(e, bind, chunk, ref, func(cmp int) bool { return cmp == 0 })
+}
+
+func versionNotVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOpV2(e, bind, chunk, ref, func(cmp int) bool { return cmp != 0 })
+}
+
+func versionLTVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOpV2(e, bind, chunk, ref, func(cmp int) bool { return cmp < 0 })
+}
+
+func versionLTEVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOpV2(e, bind, chunk, ref, func(cmp int) bool { return cmp <= 0 })
+}
+
+func versionGTVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOpV2(e, bind, chunk, ref, func(cmp int) bool { return cmp > 0 })
+}
+
+func versionGTEVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOpV2(e, bind, chunk, ref, func(cmp int) bool { return cmp >= 0 })
+}
+
+func versionInRangeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Bool, Error: errors.New("cannot check range of a version that is null")}, 0, nil
+	}
+	if len(chunk.Function.Args) != 2 {
+		return nil, 0, errors.New("Called `inRange` with " + strconv.Itoa(len(chunk.Function.Args)) + " arguments, expected 2")
+	}
+
+	min, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	max, rref, err := e.resolveValue(chunk.Function.Args[1], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if min.Value == nil || max.Value == nil {
+		return &RawData{Type: types.Bool, Error: errors.New("range of a version needs a min and max")}, 0, nil
+	}
+
+	ok, err := bind.Value.(Version).InRange(versionArg(min.Value), versionArg(max.Value))
+	if err != nil {
+		return &RawData{Type: types.Bool, Error: err}, 0, nil
+	}
+	return BoolData(ok), 0, nil
+}
+
+// ip methods
+
+func opIPCmpNil(left *RawData, right *RawData) bool {
+	return left.Value == nil
+}
+
+func ipCmpNilV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolOpV2(e, bind, chunk, ref, opIPCmpNil)
+}
+
+func ipNotNilV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolNotOpV2(e, bind, chunk, ref, opIPCmpNil)
+}
+
+func opIPCmpIP(left interface{}, right interface{}) bool {
+	other, err := ipArg(right)
+	if err != nil {
+		return false
+	}
+	return left.(IP).Equal(other)
+}
+
+func ipCmpIPV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return boolOpV2(e, bind, chunk, ref, opIPCmpIP)
+}
+
+func ipNotIPV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return boolNotOpV2(e, bind, chunk, ref, opIPCmpIP)
+}
+
+// ipNetworkOpV2 runs an operation between this IP and another IP or
+// network, which may also be provided as a string
+func ipNetworkOpV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, f func(IP, IP) bool) (*RawData, uint64, error) {
+	return nonNilDataOpV2(e, bind, chunk, ref, types.Bool, func(left interface{}, right interface{}) *RawData {
+		other, err := ipArg(right)
+		if err != nil {
+			return &RawData{Type: types.Bool, Error: err}
+		}
+		return BoolData(f(left.(IP), other))
+	})
+}
+
+func ipInRangeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipNetworkOpV2(e, bind, chunk, ref, func(ip IP, network IP) bool {
+		return network.Contains(ip)
+	})
+}
+
+func ipContainsV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipNetworkOpV2(e, bind, chunk, ref, IP.Contains)
+}
+
+func ipOverlapsV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipNetworkOpV2(e, bind, chunk, ref, IP.Overlaps)
+}
+
+func ipVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Int}, 0, nil
+	}
+	if bind.Value.(IP).Prefix.Addr().Is4() {
+		return IntData(4), 0, nil
+	}
+	return IntData(6), 0, nil
+}
+
+func ipPrefixV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+	return StringData(bind.Value.(IP).Network().String()), 0, nil
+}
+
+func ipPrefixLengthV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Int}, 0, nil
+	}
+	return IntData(int64(bind.Value.(IP).Prefix.Bits())), 0, nil
+}
+
+func ipAddressV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+	return StringData(bind.Value.(IP).Prefix.Addr().String()), 0, nil
+}
+
+func ipIsPrivateV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Bool}, 0, nil
+	}
+	return BoolData(bind.Value.(IP).Prefix.Addr().IsPrivate()), 0, nil
+}
+
+func ipIsLoopbackV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Bool}, 0, nil
+	}
+	return BoolData(bind.Value.(IP).Prefix.Addr().IsLoopback()), 0, nil
+}
+
+func ipIsUnspecifiedV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Bool}, 0, nil
+	}
+	return BoolData(bind.Value.(IP).Prefix.Addr().IsUnspecified()), 0, nil
+}
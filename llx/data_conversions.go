@@ -35,6 +35,8 @@ func init() {
 		types.String:       string2result,
 		types.Regex:        regex2result,
 		types.Time:         time2result,
+		types.Version:      version2result,
+		types.IP:           ip2result,
 		types.Dict:         dict2result,
 		types.Score:        score2result,
 		types.Block:        block2result,
@@ -53,6 +55,8 @@ func init() {
 		types.String:       pstring2raw,
 		types.Regex:        pregex2raw,
 		types.Time:         ptime2raw,
+		types.Version:      pversion2raw,
+		types.IP:           pip2raw,
 		types.Dict:         pdict2raw,
 		types.Score:        pscore2raw,
 		types.Block:        pblock2rawV2,
@@ -201,6 +205,22 @@ func time2result(value interface{}, typ types.Type) (*Primitive, error) {
 	return TimePrimitive(v), nil
 }
 
+func version2result(value interface{}, typ types.Type) (*Primitive, error) {
+	v, ok := value.(Version)
+	if !ok {
+		return nil, errInvalidConversion(value, typ)
+	}
+	return VersionPrimitive(v), nil
+}
+
+func ip2result(value interface{}, typ types.Type) (*Primitive, error) {
+	v, ok := value.(IP)
+	if !ok {
+		return nil, errInvalidConversion(value, typ)
+	}
+	return IPPrimitive(v), nil
+}
+
 func dict2result(value interface{}, typ types.Type) (*Primitive, error) {
 	prim, err := dict2primitive(value)
 	if err != nil {
@@ -534,6 +554,20 @@ func ptime2raw(p *Primitive) *RawData {
 	return TimeData(bytes2time(p.Value))
 }
 
+func pversion2raw(p *Primitive) *RawData {
+	if p.Value == nil {
+		return &RawData{Type: types.Version}
+	}
+	return VersionData(bytes2version(p.Value))
+}
+
+func pip2raw(p *Primitive) *RawData {
+	if p.Value == nil {
+		return &RawData{Type: types.IP}
+	}
+	return IPData(bytes2ip(p.Value))
+}
+
 func pdict2raw(p *Primitive) *RawData {
 	if p.Value == nil {
 		return &RawData{
@@ -0,0 +1,169 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const (
+	secondsPerMinute = 60
+	secondsPerHour   = 60 * secondsPerMinute
+	secondsPerDay    = 24 * secondsPerHour
+	secondsPerWeek   = 7 * secondsPerDay
+	// months and years don't have a fixed length, so durations use
+	// 30 and 365 days for them
+	secondsPerMonth = 30 * secondsPerDay
+	secondsPerYear  = 365 * secondsPerDay
+)
+
+var durationUnits = map[string]int64{
+	"s": 1,
+	"m": secondsPerMinute,
+	"h": secondsPerHour,
+	"d": secondsPerDay,
+	"w": secondsPerWeek,
+	"y": secondsPerYear,
+}
+
+// ParseDuration parses a duration into seconds. It supports short
+// durations like 90d or 1h30m, with the units s, m, h, d, w and y, as
+// well as ISO 8601 durations like P1Y2M10DT2H30M.
+func ParseDuration(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, errors.New("failed to parse empty duration")
+	}
+
+	neg := false
+	raw := s
+	if raw[0] == '-' || raw[0] == '+' {
+		neg = raw[0] == '-'
+		raw = raw[1:]
+	}
+
+	var res int64
+	var err error
+	if len(raw) > 0 && (raw[0] == 'P' || raw[0] == 'p') {
+		res, err = parseISODuration(raw[1:])
+	} else {
+		res, err = parseShortDuration(raw)
+	}
+	if err != nil {
+		return 0, errors.New("failed to parse '" + s + "' as duration: " + err.Error())
+	}
+
+	if neg {
+		return -res, nil
+	}
+	return res, nil
+}
+
+func parseShortDuration(s string) (int64, error) {
+	if s == "" {
+		return 0, errors.New("missing value")
+	}
+	// a plain number is a duration in seconds
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+
+	var res int64
+	for s != "" {
+		n, rest, err := leadingInt(s)
+		if err != nil {
+			return 0, err
+		}
+		i := 0
+		for i < len(rest) && (rest[i] < '0' || rest[i] > '9') {
+			i++
+		}
+		unit, ok := durationUnits[strings.ToLower(rest[:i])]
+		if !ok {
+			return 0, errors.New("unknown unit '" + rest[:i] + "'")
+		}
+		res += n * unit
+		s = rest[i:]
+	}
+	return res, nil
+}
+
+func parseISODuration(s string) (int64, error) {
+	if s == "" {
+		return 0, errors.New("missing value")
+	}
+
+	var res int64
+	inTime := false
+	for s != "" {
+		if s[0] == 'T' || s[0] == 't' {
+			if inTime {
+				return 0, errors.New("duplicate time designator")
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+
+		n, rest, err := leadingInt(s)
+		if err != nil {
+			return 0, err
+		}
+		if rest == "" {
+			return 0, errors.New("missing unit")
+		}
+
+		var unit int64
+		switch strings.ToUpper(rest[:1]) {
+		case "Y":
+			unit = secondsPerYear
+		case "M":
+			if inTime {
+				unit = secondsPerMinute
+			} else {
+				unit = secondsPerMonth
+			}
+		case "W":
+			unit = secondsPerWeek
+		case "D":
+			unit = secondsPerDay
+		case "H":
+			unit = secondsPerHour
+		case "S":
+			unit = 1
+		default:
+			return 0, errors.New("unknown unit '" + rest[:1] + "'")
+		}
+		if inTime != (unit < secondsPerDay) {
+			return 0, errors.New("unit '" + rest[:1] + "' is in the wrong place")
+		}
+
+		res += n * unit
+		s = rest[1:]
+	}
+	return res, nil
+}
+
+func leadingInt(s string) (int64, string, error) {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return 0, s, errors.New("expected a number before '" + s + "'")
+	}
+	n, err := strconv.ParseInt(s[:i], 10, 64)
+	if err != nil {
+		return 0, s, err
+	}
+	return n, s[i:], nil
+}
+
+// DurationData creates raw data for a duration in seconds. Durations are
+// times relative to the year 0, like the result of subtracting two times.
+func DurationData(seconds int64) *RawData {
+	return TimeData(DurationToTime(seconds))
+}
@@ -0,0 +1,48 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in  string
+		out int64
+	}{
+		{"90", 90},
+		{"90s", 90},
+		{"5m", 5 * 60},
+		{"1h30m", 90 * 60},
+		{"90d", 90 * 24 * 60 * 60},
+		{"2w", 14 * 24 * 60 * 60},
+		{"1y", 365 * 24 * 60 * 60},
+		{"-1d", -24 * 60 * 60},
+		{"P1Y2M", (365 + 2*30) * 24 * 60 * 60},
+		{"P10D", 10 * 24 * 60 * 60},
+		{"P1W", 7 * 24 * 60 * 60},
+		{"PT2H30M", 150 * 60},
+		{"P1DT1S", 24*60*60 + 1},
+		{"-PT1M", -60},
+	}
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.in, func(t *testing.T) {
+			res, err := ParseDuration(cur.in)
+			require.NoError(t, err)
+			assert.Equal(t, cur.out, res)
+		})
+	}
+
+	for _, in := range []string{"", "d", "1x", "P", "PT1D", "P1H", "P1", "1d2"} {
+		t.Run("invalid "+in, func(t *testing.T) {
+			_, err := ParseDuration(in)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+
+	"go.mondoo.com/cnquery/types"
+)
+
+// IP is an IPv4 or IPv6 address. IPs that were created with a prefix, like
+// 10.0.0.0/8, are networks in CIDR notation and can contain other IPs.
+type IP struct {
+	Prefix    netip.Prefix
+	HasPrefix bool
+}
+
+// ParseIP parses an address like 10.0.0.1 or a network like 10.0.0.0/8
+func ParseIP(s string) (IP, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return IP{}, errors.New("failed to parse '" + s + "' as CIDR")
+		}
+		addr := prefix.Addr().Unmap()
+		bits := prefix.Bits()
+		if prefix.Addr().Is4In6() {
+			bits -= 96
+		}
+		return IP{Prefix: netip.PrefixFrom(addr, bits), HasPrefix: true}, nil
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSuffix(strings.TrimPrefix(s, "["), "]"))
+	if err != nil {
+		return IP{}, errors.New("failed to parse '" + s + "' as IP address")
+	}
+	addr = addr.Unmap()
+	return IP{Prefix: netip.PrefixFrom(addr, addr.BitLen())}, nil
+}
+
+// ParseCIDR parses a network like 10.0.0.0/8. Addresses without a
+// prefix are networks that only contain themselves, e.g. 10.0.0.1/32.
+func ParseCIDR(s string) (IP, error) {
+	res, err := ParseIP(s)
+	res.HasPrefix = true
+	return res, err
+}
+
+func (ip IP) String() string {
+	if ip.HasPrefix {
+		return ip.Prefix.String()
+	}
+	return ip.Prefix.Addr().String()
+}
+
+// MarshalText encodes the IP in the same notation that it was parsed from
+func (ip IP) MarshalText() ([]byte, error) {
+	return []byte(ip.String()), nil
+}
+
+// UnmarshalText parses an IP address or network
+func (ip *IP) UnmarshalText(text []byte) error {
+	res, err := ParseIP(string(text))
+	if err != nil {
+		return err
+	}
+	*ip = res
+	return nil
+}
+
+// Equal is true if both IPs have the same address and prefix length
+func (ip IP) Equal(other IP) bool {
+	return ip.Prefix == other.Prefix
+}
+
+// Network returns the network of this IP, e.g. 10.0.0.0/8 for 10.1.2.3/8
+func (ip IP) Network() netip.Prefix {
+	return ip.Prefix.Masked()
+}
+
+// Contains is true if the other IP, including all its addresses if it is
+// a network, is part of this IP's network
+func (ip IP) Contains(other IP) bool {
+	return ip.Prefix.Bits() <= other.Prefix.Bits() && ip.Network().Contains(other.Prefix.Addr())
+}
+
+// Overlaps is true if both networks have at least one address in common
+func (ip IP) Overlaps(other IP) bool {
+	return ip.Network().Overlaps(other.Network())
+}
+
+// IPPrimitive creates a primitive from an IP
+func IPPrimitive(ip IP) *Primitive {
+	return &Primitive{
+		Type:  string(types.IP),
+		Value: []byte(ip.String()),
+	}
+}
+
+func bytes2ip(b []byte) IP {
+	res, _ := ParseIP(string(b))
+	return res
+}
+
+// IPData creates raw data from an IP
+func IPData(ip IP) *RawData {
+	return &RawData{
+		Type:  types.IP,
+		Value: ip,
+	}
+}
+
+// ipArg turns the other operand of an IP operation into an IP
+func ipArg(v interface{}) (IP, error) {
+	switch x := v.(type) {
+	case IP:
+		return x, nil
+	case string:
+		return ParseIP(x)
+	default:
+		return IP{}, errors.New("cannot turn value into an IP")
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIP(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"10.0.0.1", "10.0.0.1"},
+		{" 10.0.0.1 ", "10.0.0.1"},
+		{"10.1.2.3/8", "10.1.2.3/8"},
+		{"::ffff:10.0.0.1", "10.0.0.1"},
+		{"::ffff:10.0.0.0/104", "10.0.0.0/8"},
+		{"[fe80::1]", "fe80::1"},
+		{"2001:db8::/32", "2001:db8::/32"},
+	}
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.in, func(t *testing.T) {
+			ip, err := ParseIP(cur.in)
+			require.NoError(t, err)
+			assert.Equal(t, cur.out, ip.String())
+		})
+	}
+
+	_, err := ParseIP("10.0.0.256")
+	assert.EqualError(t, err, "failed to parse '10.0.0.256' as IP address")
+	_, err = ParseIP("10.0.0.0/33")
+	assert.EqualError(t, err, "failed to parse '10.0.0.0/33' as CIDR")
+}
+
+func TestIP_Networks(t *testing.T) {
+	network, err := ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	single, err := ParseCIDR("10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1/32", single.String())
+
+	assert.True(t, network.Contains(single))
+	assert.False(t, single.Contains(network))
+	assert.True(t, single.Overlaps(network))
+
+	other, err := ParseIP("192.168.0.0/16")
+	require.NoError(t, err)
+	assert.False(t, network.Overlaps(other))
+}
+
+func TestIP_JSON(t *testing.T) {
+	ip, err := ParseIP("10.0.0.1")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(IPData(ip))
+	require.NoError(t, err)
+
+	var res RawData
+	require.NoError(t, json.Unmarshal(data, &res))
+	assert.Equal(t, IPData(ip), &res)
+	assert.Equal(t, ip, bytes2ip(IPPrimitive(ip).Value))
+}
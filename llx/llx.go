@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	uuid "github.com/gofrs/uuid"
 	"github.com/rs/zerolog/log"
@@ -127,6 +128,8 @@ type MQLExecutorV2 struct {
 	lock           sync.Mutex
 	blockExecutors []*blockExecutor
 	unregistered   bool
+	// stats are optional and collected for all executed chunks
+	stats *ExecutionStats
 }
 
 func (c *blockExecutor) watcherUID(ref uint64) string {
@@ -233,6 +236,11 @@ func (c *MQLExecutorV2) _newBlockExecutor(blockRef uint64, callback ResultCallba
 	return res, nil
 }
 
+// WithStats collects the stats of all executed chunks. It must be set before the code is run.
+func (c *MQLExecutorV2) WithStats(stats *ExecutionStats) {
+	c.stats = stats
+}
+
 // NoRun returns error for all callbacks and don't run code
 func (c *MQLExecutorV2) NoRun(err error) {
 	callback := c.blockExecutors[0].callback
@@ -866,8 +874,11 @@ func (e *blockExecutor) runChain(start uint64) {
 			res = cached.Result
 			nextRef = 0
 			err = nil
+			e.ctx.stats.addCacheHit(curRef)
 		} else {
+			start := time.Now()
 			res, nextRef, err = e.runRef(curRef)
+			e.ctx.stats.addCall(curRef, time.Since(start))
 		}
 
 		// stop this chain of execution, if it didn't return anything and
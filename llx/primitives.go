@@ -457,6 +457,16 @@ func (p *Primitive) LabelV1(code *CodeV1) string {
 		return fmt.Sprintf("/%s/", string(p.Value))
 	case types.Time:
 		return "<...>"
+	case types.Version:
+		if len(p.Value) == 0 {
+			return "null"
+		}
+		return "version(" + PrettyPrintString(bytes2version(p.Value).Value) + ")"
+	case types.IP:
+		if len(p.Value) == 0 {
+			return "null"
+		}
+		return "ip(" + PrettyPrintString(string(p.Value)) + ")"
 	case types.Dict:
 		return "<...>"
 	case types.Score:
@@ -534,6 +544,16 @@ func (p *Primitive) LabelV2(code *CodeV2) string {
 		return fmt.Sprintf("/%s/", string(p.Value))
 	case types.Time:
 		return "<...>"
+	case types.Version:
+		if len(p.Value) == 0 {
+			return "null"
+		}
+		return "version(" + PrettyPrintString(bytes2version(p.Value).Value) + ")"
+	case types.IP:
+		if len(p.Value) == 0 {
+			return "null"
+		}
+		return "ip(" + PrettyPrintString(string(p.Value)) + ")"
 	case types.Dict:
 		return "<...>"
 	case types.Score:
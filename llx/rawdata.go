@@ -49,6 +49,18 @@ func (r *RawData) UnmarshalJSON(data []byte) error {
 				return errors.New("failed to parse time into raw data: " + err.Error())
 			}
 			r.Value = &v
+		case types.Version:
+			v, _ := r.Value.(map[string]interface{})
+			value, _ := v["value"].(string)
+			format, _ := v["format"].(string)
+			r.Value = Version{Value: value, Format: format}
+		case types.IP:
+			v, _ := r.Value.(string)
+			ip, err := ParseIP(v)
+			if err != nil {
+				return errors.New("failed to parse ip into raw data: " + err.Error())
+			}
+			r.Value = ip
 		}
 		return nil
 	}
@@ -129,6 +141,10 @@ func rawDataString(typ types.Type, value interface{}) string {
 		return "/" + value.(string) + "/"
 	case types.Time:
 		return value.(*time.Time).String()
+	case types.Version:
+		return value.(Version).Value
+	case types.IP:
+		return value.(IP).String()
 	case types.Dict:
 		return dictRawDataString(value)
 	case types.Score:
@@ -251,6 +267,12 @@ func isTruthy(data interface{}, typ types.Type) (bool, bool) {
 
 		return !dt.IsZero(), true
 
+	case types.Version:
+		return data.(Version).Value != "", true
+
+	case types.IP:
+		return data.(IP).Prefix.IsValid(), true
+
 	case types.Block:
 		res := true
 
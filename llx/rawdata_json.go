@@ -336,6 +336,14 @@ func rawDataJSON(typ types.Type, data interface{}, codeID string, bundle *CodeBu
 		buf.WriteByte(raw[len(raw)-1])
 		return nil
 
+	case types.Version:
+		buf.WriteString(string2json(data.(Version).Value))
+		return nil
+
+	case types.IP:
+		buf.WriteString(string2json(data.(IP).String()))
+		return nil
+
 	case types.Time:
 		time := data.(*time.Time)
 		if time == nil {
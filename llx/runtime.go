@@ -3,7 +3,12 @@
 
 package llx
 
-import "go.mondoo.com/cnquery/providers-sdk/v1/resources"
+import (
+	"context"
+	"time"
+
+	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
+)
 
 type Runtime interface {
 	AssetMRN() string
@@ -15,6 +20,23 @@ type Runtime interface {
 	Close()
 }
 
+// ContextRuntime is a runtime whose calls to providers can be canceled
+type ContextRuntime interface {
+	Runtime
+	// WithContext returns a runtime which cancels all its calls to providers
+	// once the context is done
+	WithContext(ctx context.Context) Runtime
+}
+
+// QueryTimeoutError is the error of queries that didn't finish in time
+type QueryTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e *QueryTimeoutError) Error() string {
+	return "query timed out after " + e.Timeout.String()
+}
+
 type Schema interface {
 	Lookup(resource string) *resources.ResourceInfo
 	LookupField(resource string, field string) (*resources.ResourceInfo, *resources.Field)
@@ -0,0 +1,81 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"sync"
+	"time"
+)
+
+// ChunkStats describe how often a chunk was executed and how long it took
+type ChunkStats struct {
+	// Calls counts how often the chunk was executed
+	Calls int
+	// CacheHits counts how often the chunk's result was taken from cache
+	CacheHits int
+	// Duration is the total time spent executing the chunk. It doesn't include
+	// the time that asynchronous results took to arrive.
+	Duration time.Duration
+}
+
+// ExecutionStats collect the stats of all chunks while code is executed.
+// It is safe to use by multiple executors at once.
+type ExecutionStats struct {
+	lock   sync.Mutex
+	chunks map[uint64]*ChunkStats
+}
+
+// NewExecutionStats creates an empty collection of execution stats
+func NewExecutionStats() *ExecutionStats {
+	return &ExecutionStats{
+		chunks: map[uint64]*ChunkStats{},
+	}
+}
+
+// Chunk returns the stats of a chunk by its ref and false
+// if it was never executed
+func (s *ExecutionStats) Chunk(ref uint64) (ChunkStats, bool) {
+	if s == nil {
+		return ChunkStats{}, false
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	res, ok := s.chunks[ref]
+	if !ok {
+		return ChunkStats{}, false
+	}
+	return *res, true
+}
+
+func (s *ExecutionStats) chunk(ref uint64) *ChunkStats {
+	res, ok := s.chunks[ref]
+	if !ok {
+		res = &ChunkStats{}
+		s.chunks[ref] = res
+	}
+	return res
+}
+
+func (s *ExecutionStats) addCall(ref uint64, duration time.Duration) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	cur := s.chunk(ref)
+	cur.Calls++
+	cur.Duration += duration
+	s.lock.Unlock()
+}
+
+func (s *ExecutionStats) addCacheHit(ref uint64) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	s.chunk(ref).CacheHits++
+	s.lock.Unlock()
+}
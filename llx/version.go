@@ -0,0 +1,90 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"strings"
+
+	"go.mondoo.com/cnquery/types"
+	"go.mondoo.com/cnquery/utils/versions/generic"
+)
+
+// Version is a version string together with the format that decides how it
+// is compared, e.g. deb, rpm, apk, or semver. Versions without a format are
+// compared as semantic versions if possible and as plain strings otherwise.
+type Version struct {
+	Value  string `json:"value"`
+	Format string `json:"format,omitempty"`
+}
+
+// NewVersion creates a version of the given format. Formats are the same
+// as those of packages, so that package versions can be compared directly.
+func NewVersion(value string, format string) Version {
+	return Version{
+		Value:  strings.TrimSpace(value),
+		Format: strings.ToLower(strings.TrimSpace(format)),
+	}
+}
+
+// Compare returns -1, 0, or 1 if the version is older than, the same as, or
+// newer than the other version. Versions are always compared via the rules
+// of this version's format.
+func (v Version) Compare(other string) (int, error) {
+	return generic.Compare(v.Format, v.Value, other)
+}
+
+func (v Version) String() string {
+	return v.Value
+}
+
+// InRange checks if the version is between min and max, including both
+func (v Version) InRange(min string, max string) (bool, error) {
+	cmp, err := v.Compare(min)
+	if err != nil || cmp < 0 {
+		return false, err
+	}
+
+	cmp, err = v.Compare(max)
+	if err != nil {
+		return false, err
+	}
+	return cmp <= 0, nil
+}
+
+// VersionPrimitive creates a primitive from a version
+func VersionPrimitive(v Version) *Primitive {
+	return &Primitive{
+		Type:  string(types.Version),
+		Value: []byte(v.Format + "\x00" + v.Value),
+	}
+}
+
+func bytes2version(b []byte) Version {
+	format, value, ok := strings.Cut(string(b), "\x00")
+	if !ok {
+		return Version{Value: format}
+	}
+	return Version{Value: value, Format: format}
+}
+
+// VersionData creates raw data from a version
+func VersionData(v Version) *RawData {
+	return &RawData{
+		Type:  types.Version,
+		Value: v,
+	}
+}
+
+// versionArg turns the other operand of a version operation into the
+// string that is compared to the version
+func versionArg(v interface{}) string {
+	switch x := v.(type) {
+	case Version:
+		return x.Value
+	case string:
+		return x
+	default:
+		return ""
+	}
+}
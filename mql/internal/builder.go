@@ -42,6 +42,8 @@ type GraphBuilder struct {
 	// queryTimeout is the amount of time to wait for the underlying lumi
 	// runtime to send all the expected datapoints.
 	queryTimeout time.Duration
+	// executionStats are optional and collect the stats of all executed chunks
+	executionStats *llx.ExecutionStats
 
 	featureBoolAssertions bool
 }
@@ -98,6 +100,11 @@ func (b *GraphBuilder) WithQueryTimeout(timeout time.Duration) {
 	b.queryTimeout = timeout
 }
 
+// WithExecutionStats collects the stats of all executed chunks
+func (b *GraphBuilder) WithExecutionStats(stats *llx.ExecutionStats) {
+	b.executionStats = stats
+}
+
 func (b *GraphBuilder) WithFeatureBoolAssertions(featureBoolAssertions bool) {
 	b.featureBoolAssertions = featureBoolAssertions
 }
@@ -116,7 +123,7 @@ func (b *GraphBuilder) Build(schema llx.Schema, runtime llx.Runtime, assetMrn st
 		priorityMap:  map[NodeID]int{},
 		queryTimeout: b.queryTimeout,
 		executionManager: newExecutionManager(schema, runtime, make(chan runQueueItem, len(queries)),
-			resultChan, b.queryTimeout),
+			resultChan, b.queryTimeout, b.executionStats),
 		resultChan: resultChan,
 		doneChan:   make(chan struct{}),
 	}
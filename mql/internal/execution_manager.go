@@ -30,6 +30,8 @@ type executionManager struct {
 	// stopChan is a channel that is closed when a stop is requested
 	stopChan chan struct{}
 	wg       sync.WaitGroup
+	// stats are optional and collect the stats of all executed chunks
+	stats *llx.ExecutionStats
 }
 
 type runQueueItem struct {
@@ -38,7 +40,7 @@ type runQueueItem struct {
 }
 
 func newExecutionManager(schema llx.Schema, runtime llx.Runtime, runQueue chan runQueueItem,
-	resultChan chan *llx.RawResult, timeout time.Duration,
+	resultChan chan *llx.RawResult, timeout time.Duration, stats *llx.ExecutionStats,
 ) *executionManager {
 	return &executionManager{
 		runQueue:   runQueue,
@@ -48,6 +50,7 @@ func newExecutionManager(schema llx.Schema, runtime llx.Runtime, runQueue chan r
 		errChan:    make(chan error, 1),
 		stopChan:   make(chan struct{}),
 		timeout:    timeout,
+		stats:      stats,
 	}
 }
 
@@ -166,6 +169,7 @@ func (em *executionManager) executeCodeBundle(codeBundle *llx.CodeBundle, props
 	// checksum
 	x, err := llx.NewExecutorV2(codeBundle.CodeV2, em.runtime, props, sendResult)
 	if err == nil {
+		x.WithStats(em.stats)
 		x.Run()
 	}
 	executor = x
@@ -72,8 +72,15 @@ func Exec(query string, runtime llx.Runtime, features cnquery.Features, props ma
 }
 
 func ExecuteCode(runtime llx.Runtime, codeBundle *llx.CodeBundle, props map[string]*llx.Primitive, features cnquery.Features) (map[string]*llx.RawResult, error) {
+	return ExecuteCodeWithStats(runtime, codeBundle, props, features, nil)
+}
+
+// ExecuteCodeWithStats executes the code and collects the stats of all
+// executed chunks, e.g. to explain how the code ran
+func ExecuteCodeWithStats(runtime llx.Runtime, codeBundle *llx.CodeBundle, props map[string]*llx.Primitive, features cnquery.Features, stats *llx.ExecutionStats) (map[string]*llx.RawResult, error) {
 	builder := internal.NewBuilder()
 	builder.WithFeatureBoolAssertions(features.IsActive(cnquery.BoolAssertions))
+	builder.WithExecutionStats(stats)
 
 	builder.AddQuery(codeBundle, nil, props)
 	for _, checksum := range internal.CodepointChecksums(codeBundle) {
@@ -11,6 +11,7 @@ import (
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/mqlc/parser"
 	"go.mondoo.com/cnquery/types"
+	"go.mondoo.com/cnquery/utils/multierr"
 )
 
 func extractComments(c *parser.Expression) string {
@@ -175,7 +176,7 @@ func compileAssertionMsg(msg string, c *compiler) (*llx.AssertionMessage, error)
 
 		ref, err := c.compileAndAddExpression(expression)
 		if err != nil {
-			return nil, errors.New("failed to compile comment: " + err.Error())
+			return nil, multierr.Wrap(err, "failed to compile comment")
 		}
 
 		res.Refs = append(res.Refs, ref)
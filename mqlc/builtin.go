@@ -19,51 +19,116 @@ type compileHandler struct {
 }
 
 var (
+	sameType        = func(t types.Type) types.Type { return t }
 	childType       = func(t types.Type) types.Type { return t.Child() }
 	arrayBlockType  = func(t types.Type) types.Type { return types.Array(types.Map(types.Int, types.Block)) }
 	boolType        = func(t types.Type) types.Type { return types.Bool }
 	intType         = func(t types.Type) types.Type { return types.Int }
 	stringType      = func(t types.Type) types.Type { return types.String }
+	timeType        = func(t types.Type) types.Type { return types.Time }
 	stringArrayType = func(t types.Type) types.Type { return types.Array(types.String) }
 	dictType        = func(t types.Type) types.Type { return types.Dict }
 	blockType       = func(t types.Type) types.Type { return types.Block }
 	dictArrayType   = func(t types.Type) types.Type { return types.Array(types.Dict) }
+	stringMapType   = func(t types.Type) types.Type { return types.Map(types.String, types.String) }
 )
 
+// allowed argument types of string functions
+var (
+	stringOnly     = []types.Type{types.String}
+	stringOrList   = []types.Type{types.String, types.Array(types.String)}
+	stringOrRegex  = []types.Type{types.String, types.Regex}
+	stringListOnly = []types.Type{types.Array(types.String)}
+	intOnly        = []types.Type{types.Int}
+	// format can be called with up to 16 values of any type
+	formatArgs = anyArgs(16)
+)
+
+func anyArgs(n int) [][]types.Type {
+	res := make([][]types.Type, n)
+	for i := range res {
+		res[i] = []types.Type{types.Any}
+	}
+	return res
+}
+
 var builtinFunctions map[types.Type]map[string]compileHandler
 
 func init() {
+	timeParse := compileHandler{compile: compileTimeParse, typ: timeType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String, types.String}}}
+
 	builtinFunctions = map[types.Type]map[string]compileHandler{
 		types.String: {
-			"contains":  {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"find":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
-			"length":    {typ: intType, signature: FunctionSignature{}},
-			"camelcase": {typ: stringType, signature: FunctionSignature{}},
-			"downcase":  {typ: stringType, signature: FunctionSignature{}},
-			"upcase":    {typ: stringType, signature: FunctionSignature{}},
-			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
-			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"contains":    {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"find":        {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"length":      {typ: intType, signature: FunctionSignature{}},
+			"camelcase":   {typ: stringType, signature: FunctionSignature{}},
+			"downcase":    {typ: stringType, signature: FunctionSignature{}},
+			"upcase":      {typ: stringType, signature: FunctionSignature{}},
+			"lines":       {typ: stringArrayType, signature: FunctionSignature{}},
+			"split":       {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trim":        {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"startsWith":  {compile: compileStringArgs(types.Bool, 1, stringOrList), typ: boolType},
+			"endsWith":    {compile: compileStringArgs(types.Bool, 1, stringOrList), typ: boolType},
+			"replace":     {compile: compileStringArgs(types.String, 2, stringOrRegex, stringOnly), typ: stringType},
+			"matchGroups": {compile: compileStringArgs(types.Map(types.String, types.String), 1, stringOrRegex), typ: stringMapType},
+			"in":          {compile: compileStringArgs(types.Bool, 1, stringListOnly), typ: boolType},
+			"substring":   {compile: compileStringArgs(types.String, 1, intOnly, intOnly), typ: stringType},
+			"padLeft":     {compile: compileStringArgs(types.String, 1, intOnly, stringOnly), typ: stringType},
+			"padRight":    {compile: compileStringArgs(types.String, 1, intOnly, stringOnly), typ: stringType},
+			"format":      {compile: compileStringArgs(types.String, 0, formatArgs...), typ: stringType},
 		},
 		types.Time: {
-			"seconds": {typ: intType, signature: FunctionSignature{}},
-			"minutes": {typ: intType, signature: FunctionSignature{}},
-			"hours":   {typ: intType, signature: FunctionSignature{}},
-			"days":    {typ: intType, signature: FunctionSignature{}},
-			"unix":    {typ: intType, signature: FunctionSignature{}},
+			"seconds":       {typ: intType, signature: FunctionSignature{}},
+			"minutes":       {typ: intType, signature: FunctionSignature{}},
+			"hours":         {typ: intType, signature: FunctionSignature{}},
+			"days":          {typ: intType, signature: FunctionSignature{}},
+			"unix":          {typ: intType, signature: FunctionSignature{}},
+			"format":        {compile: compileStringArgs(types.String, 1, stringOnly), typ: stringType},
+			"inTimezone":    {compile: compileStringArgs(types.Time, 1, stringOnly), typ: timeType},
+			"startOfMinute": {typ: timeType, signature: FunctionSignature{}},
+			"startOfHour":   {typ: timeType, signature: FunctionSignature{}},
+			"startOfDay":    {typ: timeType, signature: FunctionSignature{}},
+			"startOfWeek":   {typ: timeType, signature: FunctionSignature{}},
+			"startOfMonth":  {typ: timeType, signature: FunctionSignature{}},
+			"startOfYear":   {typ: timeType, signature: FunctionSignature{}},
+		},
+		types.Version: {
+			"inRange": {compile: compileVersionInRange, typ: boolType, signature: FunctionSignature{Required: 2, Args: []types.Type{types.String, types.String}}},
+		},
+		types.IP: {
+			"version":       {typ: intType, signature: FunctionSignature{}},
+			"address":       {typ: stringType, signature: FunctionSignature{}},
+			"prefix":        {typ: stringType, signature: FunctionSignature{}},
+			"prefixLength":  {typ: intType, signature: FunctionSignature{}},
+			"isPrivate":     {typ: boolType, signature: FunctionSignature{}},
+			"isLoopback":    {typ: boolType, signature: FunctionSignature{}},
+			"isUnspecified": {typ: boolType, signature: FunctionSignature{}},
+			"inRange":       {compile: compileIPNetwork, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"contains":      {compile: compileIPNetwork, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"overlaps":      {compile: compileIPNetwork, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
 		},
 		types.Dict: {
 			"[]": {typ: dictType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
 			"{}": {typ: blockType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			// string-ish
-			"find":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
-			"length":    {typ: intType, signature: FunctionSignature{}},
-			"camelcase": {typ: stringType, signature: FunctionSignature{}},
-			"downcase":  {typ: stringType, signature: FunctionSignature{}},
-			"upcase":    {typ: stringType, signature: FunctionSignature{}},
-			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
-			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"find":        {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"length":      {typ: intType, signature: FunctionSignature{}},
+			"camelcase":   {typ: stringType, signature: FunctionSignature{}},
+			"downcase":    {typ: stringType, signature: FunctionSignature{}},
+			"upcase":      {typ: stringType, signature: FunctionSignature{}},
+			"lines":       {typ: stringArrayType, signature: FunctionSignature{}},
+			"split":       {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trim":        {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"startsWith":  {compile: compileStringArgs(types.Bool, 1, stringOrList), typ: boolType},
+			"endsWith":    {compile: compileStringArgs(types.Bool, 1, stringOrList), typ: boolType},
+			"replace":     {compile: compileStringArgs(types.String, 2, stringOrRegex, stringOnly), typ: stringType},
+			"matchGroups": {compile: compileStringArgs(types.Map(types.String, types.String), 1, stringOrRegex), typ: stringMapType},
+			"in":          {compile: compileStringArgs(types.Bool, 1, stringListOnly), typ: boolType},
+			"substring":   {compile: compileStringArgs(types.String, 1, intOnly, intOnly), typ: stringType},
+			"padLeft":     {compile: compileStringArgs(types.String, 1, intOnly, stringOnly), typ: stringType},
+			"padRight":    {compile: compileStringArgs(types.String, 1, intOnly, stringOnly), typ: stringType},
+			"format":      {compile: compileStringArgs(types.String, 0, formatArgs...), typ: stringType},
 			// array- or map-ish
 			"first":        {typ: dictType, signature: FunctionSignature{}},
 			"last":         {typ: dictType, signature: FunctionSignature{}},
@@ -99,6 +164,16 @@ func init() {
 			"none":         {compile: compileArrayNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":          {compile: compileArrayMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"flat":         {compile: compileArrayFlat, signature: FunctionSignature{}},
+			"sort":         {compile: compileArraySort, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"sortDesc":     {compile: compileArraySort, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"groupBy":      {compile: compileArrayGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"min":          {compile: compileArrayMinMax, signature: FunctionSignature{}},
+			"max":          {compile: compileArrayMinMax, signature: FunctionSignature{}},
+			"sum":          {compile: compileArraySumAvg, signature: FunctionSignature{}},
+			"avg":          {compile: compileArraySumAvg, signature: FunctionSignature{}},
+			"sample":       {typ: sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"take":         {typ: sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"skip":         {typ: sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
 		},
 		types.MapLike: {
 			"[]":     {typ: childType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
@@ -119,17 +194,21 @@ func init() {
 			"none":     {compile: compileResourceNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":      {compile: compileResourceMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 		},
-		// TODO: [#32] unique builtin fields that need a long-term support in LR
+		// functions of core resources, which take arguments and can't be
+		// declared in LR
+		types.Resource("time"): {
+			"parse": timeParse,
+		},
 		types.Resource("parse"): {
-			"date": {compile: compileResourceParseDate, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String, types.String}}},
+			// date is an alias of time.parse
+			"date": timeParse,
 		},
 	}
 }
 
 // Note: Call it with the full type, not just the underlying type
 func builtinFunction(typ types.Type, id string) (*compileHandler, error) {
-	// TODO: [#32] special handlers for specific types, which are builtin and should
-	// be removed long-term, one the resource is native in LR
+	// functions of specific types, e.g. of core resources, take precedence
 	fh, ok := builtinFunctions[typ]
 	if ok {
 		c, ok := fh[id]
@@ -512,3 +512,165 @@ func compileArrayFlat(c *compiler, typ types.Type, ref uint64, id string, call *
 	})
 	return typ, nil
 }
+
+func isSortable(typ types.Type) bool {
+	if _, ok := types.Less[typ]; ok {
+		return true
+	}
+	return typ == types.Version || typ == types.Dict
+}
+
+// compileArrayFieldBlock compiles the argument of array functions that are
+// called with a field of every entry, e.g. sort(name) or groupBy(name).
+// It returns the chunk's binding, args, and the type of the field.
+func compileArrayFieldBlock(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (uint64, []*llx.Primitive, types.Type, error) {
+	arg := call.Function[0]
+	if arg.Name != "" {
+		return 0, nil, types.Nil, errors.New("called '" + id + "' with a named parameter, which is not supported")
+	}
+
+	refs, err := c.blockExpressions([]*parser.Expression{arg.Value}, typ, ref)
+	if err != nil {
+		return 0, nil, types.Nil, err
+	}
+	if refs.block == 0 {
+		return 0, nil, types.Nil, errors.New("called '" + id + "' without a function block")
+	}
+
+	block := c.Result.CodeV2.Block(refs.block)
+	if len(block.Entrypoints) != 1 {
+		return 0, nil, types.Nil, errors.New("called '" + id + "' with a bad function block, you can only return 1 value")
+	}
+	fieldType := c.Result.CodeV2.DereferencedBlockType(block)
+
+	args := []*llx.Primitive{
+		llx.RefPrimitiveV2(refs.binding),
+		llx.FunctionPrimitive(refs.block),
+	}
+	for _, v := range refs.deps {
+		if c.isInMyBlock(v) {
+			args = append(args, llx.RefPrimitiveV2(v))
+		}
+	}
+	c.blockDeps = append(c.blockDeps, refs.deps...)
+
+	return refs.binding, args, fieldType, nil
+}
+
+func compileArraySort(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 1 {
+		return types.Nil, errors.New("too many arguments when calling '" + id + "'")
+	}
+
+	if call != nil && len(call.Function) == 1 {
+		binding, args, fieldType, err := compileArrayFieldBlock(c, typ, ref, id, call)
+		if err != nil {
+			return types.Nil, err
+		}
+		if !isSortable(fieldType) {
+			return types.Nil, errors.New("cannot sort by a field of type " + fieldType.Label())
+		}
+
+		fieldID := "fieldSort"
+		if id == "sortDesc" {
+			fieldID = "fieldSortDesc"
+		}
+		c.addChunk(&llx.Chunk{
+			Call: llx.Chunk_FUNCTION,
+			Id:   fieldID,
+			Function: &llx.Function{
+				Type:    string(typ),
+				Binding: binding,
+				Args:    args,
+			},
+		})
+		return typ, nil
+	}
+
+	if !isSortable(typ.Child()) {
+		return types.Nil, errors.New("cannot sort array of " + typ.Child().Label() + ", try sorting by one of its fields")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(typ),
+			Binding: ref,
+		},
+	})
+	return typ, nil
+}
+
+func compileArrayGroupBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) != 1 {
+		return types.Nil, errors.New("'" + id + "' needs one argument, the field to group by")
+	}
+
+	binding, args, fieldType, err := compileArrayFieldBlock(c, typ, ref, id, call)
+	if err != nil {
+		return types.Nil, err
+	}
+	if fieldType.IsArray() || fieldType.IsMap() || fieldType.IsResource() {
+		return types.Nil, errors.New("cannot group by a field of type " + fieldType.Label())
+	}
+
+	resType := types.Map(types.String, typ)
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: binding,
+			Args:    args,
+		},
+	})
+	return resType, nil
+}
+
+func compileArrayMinMax(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("too many arguments when calling '" + id + "'")
+	}
+
+	ct := typ.Child()
+	if !isSortable(ct) {
+		return types.Nil, errors.New("cannot get " + id + " of array of " + ct.Label())
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(ct),
+			Binding: ref,
+		},
+	})
+	return ct, nil
+}
+
+func compileArraySumAvg(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("too many arguments when calling '" + id + "'")
+	}
+
+	ct := typ.Child()
+	if ct != types.Int && ct != types.Float && ct != types.Dict {
+		return types.Nil, errors.New("cannot get " + id + " of array of " + ct.Label() + ", only numbers are supported")
+	}
+
+	resType := types.Float
+	if id == "sum" && ct == types.Int {
+		resType = types.Int
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: ref,
+		},
+	})
+	return resType, nil
+}
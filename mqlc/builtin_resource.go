@@ -18,7 +18,7 @@ func compileResourceDefault(c *compiler, typ types.Type, ref uint64, id string,
 	name := typ.ResourceName()
 	resource := c.Schema.Lookup(name)
 	if resource == nil {
-		return types.Nil, errors.New("cannot find resource '" + name + "' when compiling field '" + id + "'")
+		return types.Nil, unknownIdentifier(name, "cannot find resource '"+name+"' when compiling field '"+id+"'")
 	}
 
 	// special case that we can optimize: the previous call was a resource
@@ -38,7 +38,7 @@ func compileResourceDefault(c *compiler, typ types.Type, ref uint64, id string,
 	fieldPath, fieldinfos, ok := c.findField(resource, id)
 	if !ok {
 		addFieldSuggestions(publicFieldsInfo(c, resource), id, c.Result)
-		return "", errors.New("cannot find field '" + id + "' in resource " + resource.Name)
+		return "", unknownIdentifier(id, "cannot find field '"+id+"' in resource "+resource.Name)
 	}
 
 	lastRef := ref
@@ -117,7 +117,7 @@ func listResource(c *compiler, typ types.Type) (*resources.ResourceInfo, error)
 	name := typ.ResourceName()
 	resource := c.Schema.Lookup(name)
 	if resource == nil {
-		return nil, errors.New("cannot find resource '" + name + "'")
+		return nil, unknownIdentifier(name, "cannot find resource '"+name+"'")
 	}
 	if resource.ListType == "" {
 		return nil, errors.New("resource '" + name + "' is not a list type")
@@ -467,20 +467,19 @@ func compileResourceLength(c *compiler, typ types.Type, ref uint64, id string, c
 	return typ, nil
 }
 
-func compileResourceParseDate(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+// compileTimeParse compiles time.parse and its alias parse.date
+func compileTimeParse(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
 	if call == nil {
 		return types.Nil, errors.New("missing arguments to parse date")
 	}
 
-	functionID := string(typ) + "." + id
-
 	init := &resources.Init{
 		Args: []*resources.TypedArg{
 			{Name: "value", Type: string(types.String)},
 			{Name: "format", Type: string(types.String)},
 		},
 	}
-	args, err := c.unnamedArgs("parse."+id, init, call.Function)
+	args, err := c.unnamedArgs(typ.ResourceName()+"."+id, init, call.Function)
 	if err != nil {
 		return types.Nil, err
 	}
@@ -496,7 +495,7 @@ func compileResourceParseDate(c *compiler, typ types.Type, ref uint64, id string
 
 	c.addChunk(&llx.Chunk{
 		Call: llx.Chunk_FUNCTION,
-		Id:   functionID,
+		Id:   id,
 		Function: &llx.Function{
 			Type:    string(types.Time),
 			Binding: ref,
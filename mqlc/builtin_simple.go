@@ -5,6 +5,7 @@ package mqlc
 
 import (
 	"errors"
+	"strconv"
 
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/mqlc/parser"
@@ -102,3 +103,151 @@ func compileStringContains(c *compiler, typ types.Type, ref uint64, id string, c
 		return types.Nil, errors.New("cannot find #string.contains with this type " + types.Type(val.Type).Label())
 	}
 }
+
+func compileVersionInRange(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) != 2 {
+		return types.Nil, errors.New("function " + id + " needs two arguments, the min and max version")
+	}
+
+	args := make([]*llx.Primitive, 2)
+	for i := range call.Function {
+		arg := call.Function[i]
+		if arg.Name != "" {
+			return types.Nil, errors.New("function " + id + " doesn't support named arguments")
+		}
+
+		val, err := c.compileExpression(arg.Value)
+		if err != nil {
+			return types.Nil, err
+		}
+		valType, err := c.dereferenceType(val)
+		if err != nil {
+			return types.Nil, err
+		}
+		if valType != types.String && valType != types.Version && valType != types.Dict {
+			return types.Nil, errors.New("function " + id + " needs versions or strings as arguments, got " + valType.Label())
+		}
+		args[i] = val
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(types.Bool),
+			Binding: ref,
+			Args:    args,
+		},
+	})
+	return types.Bool, nil
+}
+
+func compileIPNetwork(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) != 1 {
+		return types.Nil, errors.New("function " + id + " needs one argument, the IP or network")
+	}
+
+	arg := call.Function[0]
+	if arg.Name != "" {
+		return types.Nil, errors.New("function " + id + " doesn't support named arguments")
+	}
+
+	val, err := c.compileExpression(arg.Value)
+	if err != nil {
+		return types.Nil, err
+	}
+	valType, err := c.dereferenceType(val)
+	if err != nil {
+		return types.Nil, err
+	}
+	if valType != types.String && valType != types.IP && valType != types.Dict {
+		return types.Nil, errors.New("function " + id + " needs an IP or string as argument, got " + valType.Label())
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(types.Bool),
+			Binding: ref,
+			Args:    []*llx.Primitive{val},
+		},
+	})
+	return types.Bool, nil
+}
+
+// compileStringArgs compiles string functions whose arguments may have one
+// of multiple types. Arguments after the required ones are optional and
+// dict values are accepted for all arguments.
+func compileStringArgs(resType types.Type, required int, argTypes ...[]types.Type) func(*compiler, types.Type, uint64, string, *parser.Call) (types.Type, error) {
+	return func(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+		// Dicts often have keys that are named like these functions, e.g.
+		// a format key. Without a call they are still accessed like before.
+		if typ == types.Dict && call == nil {
+			c.addChunk(&llx.Chunk{
+				Call: llx.Chunk_FUNCTION,
+				Id:   "[]",
+				Function: &llx.Function{
+					Type:    string(types.Dict),
+					Binding: ref,
+					Args:    []*llx.Primitive{llx.StringPrimitive(id)},
+				},
+			})
+			return types.Dict, nil
+		}
+
+		var args []*parser.Arg
+		if call != nil {
+			args = call.Function
+		}
+		if len(args) < required {
+			return types.Nil, errors.New("function " + id + " needs at least " + strconv.Itoa(required) + " arguments, got " + strconv.Itoa(len(args)))
+		}
+		if len(args) > len(argTypes) {
+			return types.Nil, errors.New("function " + id + " takes at most " + strconv.Itoa(len(argTypes)) + " arguments, got " + strconv.Itoa(len(args)))
+		}
+
+		res := make([]*llx.Primitive, len(args))
+		for i := range args {
+			if args[i].Name != "" {
+				return types.Nil, errors.New("function " + id + " doesn't support named arguments")
+			}
+
+			val, err := c.compileExpression(args[i].Value)
+			if err != nil {
+				return types.Nil, err
+			}
+			valType, err := c.dereferenceType(val)
+			if err != nil {
+				return types.Nil, err
+			}
+			if !isArgType(valType, argTypes[i]) {
+				return types.Nil, errors.New("function " + id + " doesn't support argument " + strconv.Itoa(i+1) + " of type " + valType.Label())
+			}
+			res[i] = val
+		}
+
+		c.addChunk(&llx.Chunk{
+			Call: llx.Chunk_FUNCTION,
+			Id:   id,
+			Function: &llx.Function{
+				Type:    string(resType),
+				Binding: ref,
+				Args:    res,
+			},
+		})
+		return resType, nil
+	}
+}
+
+func isArgType(typ types.Type, allowed []types.Type) bool {
+	if typ == types.Dict {
+		return true
+	}
+	for i := range allowed {
+		if allowed[i] == typ || allowed[i] == types.Any {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package mqlc
+
+// UnknownIdentifierError is returned when MQL calls a resource or field
+// that doesn't exist in the schema
+type UnknownIdentifierError struct {
+	// ID of the resource or field that was called
+	ID  string
+	msg string
+}
+
+func (e *UnknownIdentifierError) Error() string {
+	return e.msg
+}
+
+func unknownIdentifier(id string, msg string) error {
+	return &UnknownIdentifierError{ID: id, msg: msg}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package mqlc
+
+import (
+	"errors"
+	"strconv"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc/parser"
+	"go.mondoo.com/cnquery/types"
+	"go.mondoo.com/cnquery/utils/multierr"
+)
+
+// function is a user-defined function, e.g.:
+//
+//	def isWeakCipher(c: string) { c == /RC4|DES/ }
+//
+// Functions are inlined into the code wherever they are called.
+type function struct {
+	name   string
+	params []functionParam
+	body   []*parser.Expression
+	// scope in which the function was defined, which is where
+	// its body looks up other functions
+	scope *varmap
+	// inlining is set while the function's body is compiled, to
+	// detect recursive calls
+	inlining bool
+}
+
+type functionParam struct {
+	name string
+	// typ is Any for untyped params
+	typ types.Type
+}
+
+// simpleTypes are all the types that function params can be declared with,
+// besides resources
+var simpleTypes = map[string]types.Type{
+	"any":     types.Any,
+	"bool":    types.Bool,
+	"int":     types.Int,
+	"float":   types.Float,
+	"string":  types.String,
+	"regex":   types.Regex,
+	"time":    types.Time,
+	"dict":    types.Dict,
+	"version": types.Version,
+	"ip":      types.IP,
+}
+
+func isFunctionDef(expression *parser.Expression) bool {
+	return expression.Operand != nil && expression.Operand.Value != nil &&
+		expression.Operand.Value.Ident != nil && *expression.Operand.Value.Ident == "def" &&
+		len(expression.Operand.Calls) != 0
+}
+
+// compileFunctionDef registers a function in the current scope. It doesn't
+// generate any code, that only happens when the function is called.
+func (c *compiler) compileFunctionDef(expression *parser.Expression) error {
+	operand := expression.Operand
+	if len(expression.Operations) != 0 {
+		return errors.New("function definitions cannot be used in operations")
+	}
+
+	if len(operand.Calls) != 2 || operand.Calls[0].Ident == nil || operand.Calls[1].Function == nil {
+		return errors.New("invalid function definition, expected: def name(args) { body }")
+	}
+	name := *operand.Calls[0].Ident
+
+	if _, ok := operatorsCompilers[name]; ok || name == "props" {
+		return errors.New("cannot define function '" + name + "', it is a builtin function")
+	}
+	if c.Schema.Lookup(name) != nil {
+		return errors.New("cannot define function '" + name + "', there is a resource with the same name")
+	}
+	if len(operand.Block) == 0 {
+		return errors.New("function '" + name + "' needs a body")
+	}
+
+	args := operand.Calls[1].Function
+	params := make([]functionParam, len(args))
+	seen := map[string]struct{}{}
+	for i := range args {
+		param, err := c.functionParam(name, args[i])
+		if err != nil {
+			return err
+		}
+		if _, ok := seen[param.name]; ok {
+			return errors.New("function '" + name + "' has more than one param called '" + param.name + "'")
+		}
+		seen[param.name] = struct{}{}
+		params[i] = param
+	}
+
+	c.vars.addFunction(&function{
+		name:   name,
+		params: params,
+		body:   operand.Block,
+		scope:  c.vars,
+	})
+	return nil
+}
+
+// functionParam turns an argument of a function definition into a param,
+// it is either a name or a name with a type, e.g. `c` or `c: string`
+func (c *compiler) functionParam(funcName string, arg *parser.Arg) (functionParam, error) {
+	ident := ""
+	if arg.Value != nil && len(arg.Value.Operations) == 0 && arg.Value.Operand != nil &&
+		arg.Value.Operand.Value != nil && arg.Value.Operand.Value.Ident != nil && len(arg.Value.Operand.Calls) == 0 {
+		ident = *arg.Value.Operand.Value.Ident
+	}
+	if ident == "" {
+		return functionParam{}, errors.New("invalid param in function '" + funcName + "', expected a name or name: type")
+	}
+
+	if arg.Name == "" {
+		return functionParam{name: ident, typ: types.Any}, nil
+	}
+
+	if typ, ok := simpleTypes[ident]; ok {
+		return functionParam{name: arg.Name, typ: typ}, nil
+	}
+	if c.Schema.Lookup(ident) != nil {
+		return functionParam{name: arg.Name, typ: types.Resource(ident)}, nil
+	}
+	return functionParam{}, errors.New("unknown type '" + ident + "' for param '" + arg.Name + "' in function '" + funcName + "'")
+}
+
+// compileFunctionCall inlines a user-defined function. Its args are
+// compiled in the current scope, while its body only sees its params.
+func (c *compiler) compileFunctionCall(f *function, call *parser.Call) (types.Type, error) {
+	if f.inlining {
+		return types.Nil, errors.New("function '" + f.name + "' calls itself, recursive functions are not supported")
+	}
+
+	if len(call.Function) != len(f.params) {
+		return types.Nil, errors.New("function '" + f.name + "' expects " + strconv.Itoa(len(f.params)) +
+			" arguments, got " + strconv.Itoa(len(call.Function)))
+	}
+
+	vars := newvarmap(c.blockRef, nil)
+	for scope := f.scope; scope != nil; scope = scope.parent {
+		for name, fun := range scope.funcs {
+			if _, ok := vars.funcs[name]; !ok {
+				vars.funcs[name] = fun
+			}
+		}
+	}
+
+	for i := range call.Function {
+		arg := call.Function[i]
+		param := f.params[i]
+		if arg.Name != "" {
+			return types.Nil, errors.New("function '" + f.name + "' doesn't support named arguments")
+		}
+		if arg.Value == nil {
+			return types.Nil, errors.New("missing value for param '" + param.name + "' in function '" + f.name + "'")
+		}
+
+		ref, err := c.compileAndAddExpression(arg.Value)
+		if err != nil {
+			return types.Nil, err
+		}
+		typ, err := c.dereferenceType(llx.RefPrimitiveV2(ref))
+		if err != nil {
+			return types.Nil, err
+		}
+		if param.typ != types.Any && typ != param.typ && typ != types.Dict {
+			return types.Nil, errors.New("function '" + f.name + "' expects param '" + param.name +
+				"' to be " + param.typ.Label() + ", got " + typ.Label())
+		}
+
+		vars.add(param.name, variable{
+			name: param.name,
+			ref:  ref,
+			typ:  typ,
+		})
+	}
+
+	fc := compiler{
+		compilerConfig: c.compilerConfig,
+		Result:         c.Result,
+		vars:           vars,
+		parent:         c,
+		block:          c.block,
+		blockRef:       c.blockRef,
+		blockDeps:      c.blockDeps,
+		props:          c.props,
+		standalone:     c.standalone,
+	}
+
+	f.inlining = true
+	ref, err := fc.compileFunctionBody(f)
+	f.inlining = false
+	c.blockDeps = fc.blockDeps
+	c.standalone = fc.standalone
+	if err != nil {
+		return types.Nil, multierr.Wrap(err, "failed to compile function '"+f.name+"'")
+	}
+
+	// the result of the function must be the last value on the stack,
+	// so that it can be used in calls
+	if ref != c.tailRef() {
+		c.addChunk(&llx.Chunk{
+			Call:      llx.Chunk_PRIMITIVE,
+			Primitive: llx.RefPrimitiveV2(ref),
+		})
+	}
+
+	return c.dereferenceType(llx.RefPrimitiveV2(ref))
+}
+
+// compileFunctionBody compiles all expressions of the function and
+// returns the ref of the last one, which is the function's result
+func (c *compiler) compileFunctionBody(f *function) (uint64, error) {
+	expressions := filterEmptyExpressions(f.body)
+	for i := range expressions {
+		if err := expressions[i].ProcessOperators(); err != nil {
+			return 0, err
+		}
+	}
+
+	var ref uint64
+	var hasResult bool
+	for i := range expressions {
+		expression := expressions[i]
+		if isFunctionDef(expression) {
+			if err := c.compileFunctionDef(expression); err != nil {
+				return 0, err
+			}
+			hasResult = false
+			continue
+		}
+
+		var err error
+		ref, err = c.compileAndAddExpression(expression)
+		if err != nil {
+			return 0, err
+		}
+		hasResult = true
+	}
+
+	if !hasResult {
+		return 0, errors.New("the last expression in a function must return a value")
+	}
+	return ref, nil
+}
@@ -19,6 +19,7 @@ import (
 	"go.mondoo.com/cnquery/mqlc/parser"
 	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
 	"go.mondoo.com/cnquery/types"
+	"go.mondoo.com/cnquery/utils/multierr"
 	"go.mondoo.com/cnquery/utils/sortx"
 )
 
@@ -38,6 +39,7 @@ type varmap struct {
 	blockref uint64
 	parent   *varmap
 	vars     map[string]variable
+	funcs    map[string]*function
 }
 
 func newvarmap(blockref uint64, parent *varmap) *varmap {
@@ -45,6 +47,7 @@ func newvarmap(blockref uint64, parent *varmap) *varmap {
 		blockref: blockref,
 		parent:   parent,
 		vars:     map[string]variable{},
+		funcs:    map[string]*function{},
 	}
 }
 
@@ -62,6 +65,20 @@ func (vm *varmap) add(name string, v variable) {
 	vm.vars[name] = v
 }
 
+func (vm *varmap) lookupFunction(name string) (*function, bool) {
+	if f, ok := vm.funcs[name]; ok {
+		return f, true
+	}
+	if vm.parent == nil {
+		return nil, false
+	}
+	return vm.parent.lookupFunction(name)
+}
+
+func (vm *varmap) addFunction(f *function) {
+	vm.funcs[f.name] = f
+}
+
 func (vm *varmap) len() int {
 	return len(vm.vars)
 }
@@ -741,7 +758,7 @@ func (c *compiler) unnamedArgs(callerLabel string, init *resources.Init, args []
 
 		v, err := c.compileExpression(arg.Value)
 		if err != nil {
-			return nil, errors.New("addResourceCall error: " + err.Error())
+			return nil, multierr.Wrap(err, "addResourceCall error")
 		}
 
 		vType := types.Type(v.Type)
@@ -802,7 +819,7 @@ func (c *compiler) resourceArgs(resource *resources.ResourceInfo, args []*parser
 
 		v, err := c.compileExpression(arg.Value)
 		if err != nil {
-			return nil, errors.New("resourceArgs error: " + err.Error())
+			return nil, multierr.Wrap(err, "resourceArgs error")
 		}
 
 		vt, err := c.dereferenceType(v)
@@ -950,7 +967,7 @@ func (c *compiler) compileBoundIdentifierWithMqlCtx(id string, binding *variable
 	if typ.IsResource() {
 		resource, _ := c.Schema.LookupField(typ.ResourceName(), id)
 		if resource == nil {
-			return true, types.Nil, errors.New("cannot find resource that is called by '" + id + "' of type " + typ.Label())
+			return true, types.Nil, unknownIdentifier(id, "cannot find resource that is called by '"+id+"' of type "+typ.Label())
 		}
 
 		fieldPath, fieldinfos, ok := c.findField(resource, id)
@@ -1045,7 +1062,7 @@ func (c *compiler) compileBoundIdentifierWithoutMqlCtx(id string, binding *varia
 	if typ.IsResource() {
 		resource, fieldinfo := c.Schema.LookupField(typ.ResourceName(), id)
 		if resource == nil {
-			return true, types.Nil, errors.New("cannot find resource that is called by '" + id + "' of type " + typ.Label())
+			return true, types.Nil, unknownIdentifier(id, "cannot find resource that is called by '"+id+"' of type "+typ.Label())
 		}
 
 		if fieldinfo != nil {
@@ -1163,6 +1180,14 @@ func (c *compiler) compileIdentifier(id string, callBinding *variable, calls []*
 		restCalls = calls[1:]
 	}
 
+	// user-defined functions are always called with arguments
+	if call != nil {
+		if f, ok := c.vars.lookupFunction(id); ok {
+			typ, err := c.compileFunctionCall(f, call)
+			return restCalls, typ, err
+		}
+	}
+
 	var typ types.Type
 	var err error
 	var found bool
@@ -1227,10 +1252,12 @@ func (c *compiler) compileIdentifier(id string, callBinding *variable, calls []*
 			return nil, types.Nil, errors.New("not sure how to handle implicit calls around `_`")
 		}
 
-		found, typ, err = c.compileBoundIdentifier(id, callBinding, call)
-		if found {
-			c.standalone = false
-			return restCalls, typ, err
+		if !isGlobalCall(id, call) {
+			found, typ, err = c.compileBoundIdentifier(id, callBinding, call)
+			if found {
+				c.standalone = false
+				return restCalls, typ, err
+			}
 		}
 	} // end bound functions
 
@@ -1285,10 +1312,28 @@ func (c *compiler) compileIdentifier(id string, callBinding *variable, calls []*
 	// suggestions
 	if callBinding == nil {
 		addResourceSuggestions(c.Schema, id, c.Result)
-		return nil, types.Nil, errors.New("cannot find resource for identifier '" + id + "'")
+		return nil, types.Nil, unknownIdentifier(id, "cannot find resource for identifier '"+id+"'")
 	}
 	addFieldSuggestions(availableFields(c, callBinding.typ), id, c.Result)
-	return nil, types.Nil, errors.New("cannot find field or resource '" + id + "' in block for type '" + c.Binding.typ.Label() + "'")
+	return nil, types.Nil, unknownIdentifier(id, "cannot find field or resource '"+id+"' in block for type '"+c.Binding.typ.Label()+"'")
+}
+
+// globalsNamedLikeFields are global functions that share their name with
+// resource fields, like version() or ip(). Fields can't be called with
+// arguments, so calls with arguments go to the global function, even in
+// blocks.
+var globalsNamedLikeFields = map[string]struct{}{
+	"version": {},
+	"ip":      {},
+	"cidr":    {},
+}
+
+func isGlobalCall(id string, call *parser.Call) bool {
+	if call == nil || len(call.Function) == 0 {
+		return false
+	}
+	_, ok := globalsNamedLikeFields[id]
+	return ok
 }
 
 // compileProps handles built-in properties for this code
@@ -1528,7 +1573,7 @@ func (c *compiler) compileOperand(operand *parser.Operand) (*llx.Primitive, erro
 			if !found {
 				if typ != types.Dict || !reAccessor.MatchString(id) {
 					addFieldSuggestions(availableFields(c, typ), id, c.Result)
-					return nil, errors.New("cannot find field '" + id + "' in " + typ.Label())
+					return nil, unknownIdentifier(id, "cannot find field '"+id+"' in "+typ.Label())
 				}
 
 				// Support easy accessors for dicts and maps, e.g:
@@ -1634,6 +1679,13 @@ func (c *compiler) compileExpressions(expressions []*parser.Expression) error {
 	var prev string
 	for idx := range expressions {
 		expression := expressions[idx]
+		if isFunctionDef(expression) {
+			if err := c.compileFunctionDef(expression); err != nil {
+				return err
+			}
+			continue
+		}
+
 		prev = ident
 		ident = ""
 		if expression.Operand != nil && expression.Operand.Value != nil && expression.Operand.Value.Ident != nil {
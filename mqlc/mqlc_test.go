@@ -211,6 +211,20 @@ func TestCompiler_Buggy(t *testing.T) {
 	}
 }
 
+func TestCompiler_UnknownIdentifier(t *testing.T) {
+	for _, code := range []string{`file(not-there)`, `sshd.unknown`, `unknown.field`} {
+		t.Run(code, func(t *testing.T) {
+			_, err := mqlc.Compile(code, nil, conf)
+			var unknown *mqlc.UnknownIdentifierError
+			assert.ErrorAs(t, err, &unknown)
+		})
+	}
+
+	_, err := mqlc.Compile(`users.list[]`, nil, conf)
+	var unknown *mqlc.UnknownIdentifierError
+	assert.False(t, errors.As(err, &unknown))
+}
+
 func TestCompiler_Semicolon(t *testing.T) {
 	compileT(t, "mondoo.version;mondoo.build", func(res *llx.CodeBundle) {
 		require.Len(t, res.CodeV2.Blocks, 1)
@@ -2159,3 +2173,125 @@ func allCodepoints(code *llx.CodeV2) ([]uint64, []uint64) {
 	}
 	return entrypoints, datapoints
 }
+
+func TestCompiler_Version(t *testing.T) {
+	compileT(t, "version('1.2', type: 'rpm')", func(res *llx.CodeBundle) {
+		assertFunction(t, "version", &llx.Function{
+			Type: string(types.Version),
+			Args: []*llx.Primitive{llx.StringPrimitive("1.2"), llx.StringPrimitive("rpm")},
+		}, res.CodeV2.Blocks[0].Chunks[0])
+	})
+
+	compileT(t, "version(package('acl').version)", func(res *llx.CodeBundle) {
+		chunks := res.CodeV2.Blocks[0].Chunks
+		require.Len(t, chunks, 4)
+		assertFunction(t, "format", &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 1,
+		}, chunks[2])
+		assertFunction(t, "version", &llx.Function{
+			Type: string(types.Version),
+			Args: []*llx.Primitive{llx.RefPrimitiveV2((1 << 32) | 2), llx.RefPrimitiveV2((1 << 32) | 3)},
+		}, chunks[3])
+	})
+
+	compileT(t, "packages { version(version) >= '1.0' }", func(res *llx.CodeBundle) {
+		assertFunction(t, "version", &llx.Function{
+			Type: string(types.Version),
+			Args: []*llx.Primitive{llx.RefPrimitiveV2((2 << 32) | 2), llx.RefPrimitiveV2((2 << 32) | 3)},
+		}, res.CodeV2.Blocks[1].Chunks[3])
+	})
+
+	compileErroneous(t, "version(1)", errors.New("cannot turn int into a version"), nil)
+}
+
+func TestCompiler_IP(t *testing.T) {
+	compileT(t, "cidr('10.0.0.0/8').contains('10.0.0.1')", func(res *llx.CodeBundle) {
+		assertFunction(t, "cidr", &llx.Function{
+			Type: string(types.IP),
+			Args: []*llx.Primitive{llx.StringPrimitive("10.0.0.0/8")},
+		}, res.CodeV2.Blocks[0].Chunks[0])
+		assertFunction(t, "contains", &llx.Function{
+			Type:    string(types.Bool),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.StringPrimitive("10.0.0.1")},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileErroneous(t, "ip(1)", errors.New("cannot turn int into an IP"), nil)
+	compileErroneous(t, "ip('10.0.0.1').contains(1)", errors.New("function contains needs an IP or string as argument, got int"), nil)
+}
+
+func TestCompiler_Time(t *testing.T) {
+	compileT(t, "duration('90d')", func(res *llx.CodeBundle) {
+		assertFunction(t, "duration", &llx.Function{
+			Type: string(types.Time),
+			Args: []*llx.Primitive{llx.StringPrimitive("90d")},
+		}, res.CodeV2.Blocks[0].Chunks[0])
+	})
+
+	compileT(t, "time.parse('2023-12-23').format('2006')", func(res *llx.CodeBundle) {
+		assertFunction(t, "parse", &llx.Function{
+			Type:    string(types.Time),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.StringPrimitive("2023-12-23")},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+		assertFunction(t, "format", &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 2,
+			Args:    []*llx.Primitive{llx.StringPrimitive("2006")},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+	})
+
+	compileT(t, "parse.date('2023-12-23')", func(res *llx.CodeBundle) {
+		assertFunction(t, "date", &llx.Function{
+			Type:    string(types.Time),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.StringPrimitive("2023-12-23")},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileErroneous(t, "duration(true)", errors.New("cannot turn bool into a duration"), nil)
+	compileErroneous(t, "time.now.format(1)", errors.New("function format doesn't support argument 1 of type int"), nil)
+}
+
+func TestCompiler_Functions(t *testing.T) {
+	compileT(t, "def isWeak(c: string) { c == 'RC4' }\nisWeak('DES')", func(res *llx.CodeBundle) {
+		require.Len(t, res.CodeV2.Blocks[0].Chunks, 3)
+		assertPrimitive(t, llx.StringPrimitive("DES"), res.CodeV2.Blocks[0].Chunks[0])
+		assertPrimitive(t, llx.RefPrimitiveV2((1<<32)|1), res.CodeV2.Blocks[0].Chunks[1])
+		assertFunction(t, string("=="+types.String), &llx.Function{
+			Type:    string(types.Bool),
+			Binding: (1 << 32) | 2,
+			Args:    []*llx.Primitive{llx.StringPrimitive("RC4")},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+		assert.Equal(t, []uint64{(1 << 32) | 3}, res.CodeV2.Blocks[0].Entrypoints)
+	})
+
+	compileErroneous(t, "def f(a: int) { a }\nf('a')", errors.New("function 'f' expects param 'a' to be int, got string"), nil)
+	compileErroneous(t, "def f(a) { a }\nf(1, 2)", errors.New("function 'f' expects 1 arguments, got 2"), nil)
+	compileErroneous(t, "def f(a) { f(a) }\nf(1)", errors.New("failed to compile function 'f': function 'f' calls itself, recursive functions are not supported"), nil)
+	compileErroneous(t, "def f(a: nope) { a }", errors.New("unknown type 'nope' for param 'a' in function 'f'"), nil)
+	compileErroneous(t, "def f(a, a) { a }", errors.New("function 'f' has more than one param called 'a'"), nil)
+	compileErroneous(t, "def if(a) { a }", errors.New("cannot define function 'if', it is a builtin function"), nil)
+	// functions only see their params, not the variables where they are called
+	compileErroneous(t, "x = 1\ndef f() { x }\nf()", errors.New("failed to compile function 'f': cannot find resource for identifier 'x'"), nil)
+}
+
+func TestCompiler_ArraySort(t *testing.T) {
+	compileT(t, "[2,1].sortDesc", func(res *llx.CodeBundle) {
+		assertFunction(t, "sortDesc", &llx.Function{
+			Type:    string(types.Array(types.Int)),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "packages.list.sort(name)", func(res *llx.CodeBundle) {
+		chunk := res.CodeV2.Blocks[0].Chunks[2]
+		assert.Equal(t, "fieldSort", chunk.Id)
+		assert.Equal(t, string(types.Array(types.Resource("package"))), chunk.Function.Type)
+	})
+
+	compileErroneous(t, "[{a: 1}].sort", errors.New("cannot sort array of map[string]int, try sorting by one of its fields"), nil)
+	compileErroneous(t, "['a'].sum", errors.New("cannot get sum of array of string, only numbers are supported"), nil)
+}
@@ -10,6 +10,7 @@ import (
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/mqlc/parser"
 	"go.mondoo.com/cnquery/types"
+	"go.mondoo.com/cnquery/utils/multierr"
 )
 
 type fieldCompiler func(*compiler, string, *parser.Call) (types.Type, error)
@@ -18,30 +19,34 @@ var operatorsCompilers map[string]fieldCompiler
 
 func init() {
 	operatorsCompilers = map[string]fieldCompiler{
-		"==":     compileComparable,
-		"=~":     compileComparable,
-		"!=":     compileComparable,
-		"!~":     compileComparable,
-		">=":     compileComparable,
-		">":      compileComparable,
-		"<=":     compileComparable,
-		"<":      compileComparable,
-		"+":      compileTransformation,
-		"-":      compileTransformation,
-		"*":      compileTransformation,
-		"/":      compileTransformation,
-		"%":      nil,
-		"=":      compileAssignment,
-		"||":     compileComparable,
-		"&&":     compileComparable,
-		"{}":     compileBlock,
-		"if":     compileIf,
-		"else":   compileElse,
-		"expect": compileExpect,
-		"score":  compileScore,
-		"typeof": compileTypeof,
-		"switch": compileSwitch,
-		"Never":  compileNever,
+		"==":       compileComparable,
+		"=~":       compileComparable,
+		"!=":       compileComparable,
+		"!~":       compileComparable,
+		">=":       compileComparable,
+		">":        compileComparable,
+		"<=":       compileComparable,
+		"<":        compileComparable,
+		"+":        compileTransformation,
+		"-":        compileTransformation,
+		"*":        compileTransformation,
+		"/":        compileTransformation,
+		"%":        nil,
+		"=":        compileAssignment,
+		"||":       compileComparable,
+		"&&":       compileComparable,
+		"{}":       compileBlock,
+		"if":       compileIf,
+		"else":     compileElse,
+		"expect":   compileExpect,
+		"score":    compileScore,
+		"typeof":   compileTypeof,
+		"version":  compileVersion,
+		"ip":       compileIP,
+		"cidr":     compileIP,
+		"duration": compileDuration,
+		"switch":   compileSwitch,
+		"Never":    compileNever,
 	}
 }
 
@@ -167,7 +172,7 @@ func compileAssignment(c *compiler, id string, call *parser.Call) (types.Type, e
 func compileComparable(c *compiler, id string, call *parser.Call) (types.Type, error) {
 	leftRef, left, right, assertionMsg, err := compileABOperation(c, id, call)
 	if err != nil {
-		return types.Nil, errors.New("failed to compile: " + err.Error())
+		return types.Nil, multierr.Wrap(err, "failed to compile")
 	}
 
 	for left.Type() == types.Ref {
@@ -486,6 +491,191 @@ func compileTypeof(c *compiler, id string, call *parser.Call) (types.Type, error
 	return types.String, nil
 }
 
+// compileVersion turns a string into a version, e.g. version("1.2.3") or
+// version(v, type: "deb"). Without a type, package versions are compared
+// via the rules of their package's format.
+func compileVersion(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) < 1 {
+		return types.Nil, errors.New("missing parameter for '" + id + "', it requires 1")
+	}
+	if len(call.Function) > 2 {
+		return types.Nil, errors.New("too many arguments for '" + id + "', it takes a version and an optional type")
+	}
+
+	var value, format *llx.Primitive
+	for i := range call.Function {
+		arg := call.Function[i]
+		if arg == nil || arg.Value == nil {
+			return types.Nil, errors.New("failed to get parameter for '" + id + "'")
+		}
+
+		argValue, err := c.compileExpression(arg.Value)
+		if err != nil {
+			return types.Nil, err
+		}
+		argType, err := c.dereferenceType(argValue)
+		if err != nil {
+			return types.Nil, err
+		}
+
+		switch arg.Name {
+		case "":
+			if value != nil {
+				return types.Nil, errors.New("'" + id + "' takes only one version, use type: to set its format")
+			}
+			if argType != types.String && argType != types.Version && argType != types.Dict {
+				return types.Nil, errors.New("cannot turn " + argType.Label() + " into a version")
+			}
+			value = argValue
+		case "type":
+			if argType != types.String && argType != types.Dict {
+				return types.Nil, errors.New("the type of a version must be a string, got " + argType.Label())
+			}
+			format = argValue
+		default:
+			return types.Nil, errors.New("unknown argument '" + arg.Name + "' for '" + id + "', only type: is supported")
+		}
+	}
+	if value == nil {
+		return types.Nil, errors.New("missing version for '" + id + "'")
+	}
+
+	args := []*llx.Primitive{value}
+	if format == nil {
+		format = c.packageFormat(value)
+	}
+	if format != nil {
+		args = append(args, format)
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   "version",
+		Function: &llx.Function{
+			Type: string(types.Version),
+			Args: args,
+		},
+	})
+
+	return types.Version, nil
+}
+
+// packageFormat returns the format of the resource that a version field
+// belongs to, e.g. for package.version it returns package.format. It
+// returns nil if there is no such field.
+func (c *compiler) packageFormat(value *llx.Primitive) *llx.Primitive {
+	ref, ok := value.RefV2()
+	if !ok {
+		return nil
+	}
+
+	chunk := c.Result.CodeV2.Chunk(ref)
+	if chunk == nil || chunk.Function == nil || chunk.Id != "version" || chunk.Function.Binding == 0 {
+		return nil
+	}
+
+	binding := c.Result.CodeV2.Chunk(chunk.Function.Binding)
+	if binding == nil {
+		return nil
+	}
+	typ := binding.DereferencedTypeV2(c.Result.CodeV2)
+	if !typ.IsResource() {
+		return nil
+	}
+	_, field := c.Schema.LookupField(typ.ResourceName(), "format")
+	if field == nil || types.Type(field.Type) != types.String {
+		return nil
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   "format",
+		Function: &llx.Function{
+			Type:    string(types.String),
+			Binding: chunk.Function.Binding,
+		},
+	})
+	return llx.RefPrimitiveV2(c.tailRef())
+}
+
+// compileIP handles both ip() and cidr(), which only differ in how their
+// argument is parsed at runtime
+func compileIP(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) != 1 {
+		return types.Nil, errors.New("'" + id + "' requires exactly one argument")
+	}
+
+	arg := call.Function[0]
+	if arg == nil || arg.Value == nil {
+		return types.Nil, errors.New("failed to get parameter for '" + id + "'")
+	}
+	if arg.Name != "" {
+		return types.Nil, errors.New("'" + id + "' doesn't support named arguments")
+	}
+
+	value, err := c.compileExpression(arg.Value)
+	if err != nil {
+		return types.Nil, err
+	}
+	valueType, err := c.dereferenceType(value)
+	if err != nil {
+		return types.Nil, err
+	}
+	if valueType != types.String && valueType != types.IP && valueType != types.Dict {
+		return types.Nil, errors.New("cannot turn " + valueType.Label() + " into an IP")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type: string(types.IP),
+			Args: []*llx.Primitive{value},
+		},
+	})
+
+	return types.IP, nil
+}
+
+func compileDuration(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) != 1 {
+		return types.Nil, errors.New("'" + id + "' requires exactly one argument")
+	}
+
+	arg := call.Function[0]
+	if arg == nil || arg.Value == nil {
+		return types.Nil, errors.New("failed to get parameter for '" + id + "'")
+	}
+	if arg.Name != "" {
+		return types.Nil, errors.New("'" + id + "' doesn't support named arguments")
+	}
+
+	value, err := c.compileExpression(arg.Value)
+	if err != nil {
+		return types.Nil, err
+	}
+	valueType, err := c.dereferenceType(value)
+	if err != nil {
+		return types.Nil, err
+	}
+	switch valueType {
+	case types.String, types.Int, types.Float, types.Time, types.Dict:
+	default:
+		return types.Nil, errors.New("cannot turn " + valueType.Label() + " into a duration")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type: string(types.Time),
+			Args: []*llx.Primitive{value},
+		},
+	})
+
+	return types.Time, nil
+}
+
 func compileSwitch(c *compiler, id string, call *parser.Call) (types.Type, error) {
 	var ref *llx.Primitive
 
@@ -150,6 +150,7 @@ var (
 	trueBool  bool = true
 	falseBool bool = false
 	neverRef       = "Never"
+	defIdent       = "def"
 
 	trueValue     = Value{Bool: &trueBool}
 	falseValue    = Value{Bool: &falseBool}
@@ -523,6 +524,22 @@ func (p *parser) parseOperand() (*Operand, bool, error) {
 	}
 	p.nextToken()
 
+	// function definitions: def name(arg: type, ...) { expressions }
+	// the function name is added as the first call, followed by its arguments
+	// and its body as block
+	if value.Ident != nil && *value.Ident == defIdent && p.token.Type == Ident {
+		name := p.token.Value
+		res.Calls = append(res.Calls, &Call{Ident: &name})
+		p.nextToken()
+
+		if p.token.Value != "(" {
+			if p.token.EOF() {
+				return &res, false, &ErrIncomplete{missing: "arguments for function '" + name + "'", pos: p.token.Pos, Indent: p.indent}
+			}
+			return &res, false, &ErrIncorrect{expected: "'(' after function name", got: p.token.Value, pos: p.token.Pos}
+		}
+	}
+
 	for {
 		switch p.token.Value {
 		case ".":
@@ -703,6 +720,11 @@ func (p *parser) parseOperand() (*Operand, bool, error) {
 			p.indent--
 			p.nextToken()
 
+			// nothing can be called on function definitions
+			if res.Value.Ident != nil && *res.Value.Ident == defIdent && len(res.Calls) == 2 {
+				return &res, false, nil
+			}
+
 		default:
 			return &res, false, nil
 		}
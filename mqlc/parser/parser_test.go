@@ -342,3 +342,41 @@ func TestParser_Multiline(t *testing.T) {
 		}},
 	})
 }
+
+func TestParser_Def(t *testing.T) {
+	runMultiTest(t, []multiTest{
+		{"def isWeak(c: string) { c == 'RC4' }\nisWeak('DES')", []*Expression{
+			{Operand: &Operand{
+				Value: vIdent("def"),
+				Calls: []*Call{
+					callIdent("isWeak"),
+					{Function: []*Arg{{Name: "c", Value: &Expression{Operand: &Operand{Value: vIdent("string")}}}}},
+				},
+				Block: []*Expression{
+					{
+						Operand: &Operand{Value: vIdent("c")},
+						Operations: []*Operation{
+							{Operator: OpEqual, Operand: &Operand{Value: vString("RC4")}},
+						},
+					},
+				},
+			}},
+			{Operand: &Operand{
+				Value: vIdent("isWeak"),
+				Calls: []*Call{
+					{Function: []*Arg{{Value: &Expression{Operand: &Operand{Value: vString("DES")}}}}},
+				},
+			}},
+		}},
+		{"def none() { true }", []*Expression{
+			{Operand: &Operand{
+				Value: vIdent("def"),
+				Calls: []*Call{callIdent("none"), {Function: []*Arg{}}},
+				Block: []*Expression{{Operand: &Operand{Value: vBool(true)}}},
+			}},
+		}},
+	})
+
+	_, err := Parse("def isWeak { true }")
+	assert.EqualError(t, err, "expected '(' after function name, got '{' at <source>:1:12")
+}
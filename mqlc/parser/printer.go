@@ -0,0 +1,484 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package parser
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxLineWidth is the width after which the printer wraps lines
+const maxLineWidth = 80
+
+// Format parses MQL and prints it in its canonical style:
+// operators are surrounded by spaces, blocks are indented by two spaces,
+// and long call chains, arguments, and conditions are wrapped.
+// Comments are kept, but are always placed on their own lines.
+func Format(input string) (string, error) {
+	ast, err := Parse(input)
+	if err != nil {
+		return "", err
+	}
+
+	p := printer{octals: octalLiterals(input)}
+	return p.expressions(ast.Expressions, 0, false), nil
+}
+
+// octalLiterals maps the values of all octal integers in the input to
+// how they were written, since the AST only retains their value
+func octalLiterals(input string) map[int64]string {
+	tokens, err := Lex(input)
+	if err != nil {
+		return nil
+	}
+
+	res := map[int64]string{}
+	for i := range tokens {
+		v := tokens[i].Value
+		if tokens[i].Type != Int || len(v) < 2 || v[0] != '0' {
+			continue
+		}
+		if n, err := strconv.ParseInt(v, 8, 64); err == nil {
+			res[n] = v
+		}
+	}
+	return res
+}
+
+type printer struct {
+	octals map[int64]string
+	// broken is set when something can't be printed on a single line
+	broken bool
+}
+
+func indentation(indent int) string {
+	return strings.Repeat(" ", indent)
+}
+
+// fits tries to print everything on one line at the given indentation
+func (p *printer) fits(indent int, print func() string) (string, bool) {
+	p.broken = false
+	res := print()
+	ok := !p.broken && indent+len(res) <= maxLineWidth
+	p.broken = false
+	return res, ok
+}
+
+func isCommentOnly(x *Expression) bool {
+	return x != nil && len(x.Operations) == 0 && x.Operand != nil &&
+		x.Operand.Comments != "" && x.Operand.Value == nil && len(x.Operand.Calls) == 0 && len(x.Operand.Block) == 0
+}
+
+func isReturn(x *Expression) bool {
+	return x != nil && len(x.Operations) == 0 && x.Operand != nil && x.Operand.Value != nil &&
+		x.Operand.Value.Ident != nil && *x.Operand.Value.Ident == "return" && len(x.Operand.Calls) == 0
+}
+
+// comments prints comments as lines, which must be followed by a newline
+func (p *printer) comments(comments string, indent int, flat bool) string {
+	if comments == "" {
+		return ""
+	}
+	if flat {
+		p.broken = true
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimSuffix(comments, "\n"), "\n")
+	for i := range lines {
+		if lines[i] == "" {
+			lines[i] = "#"
+		} else {
+			lines[i] = "# " + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n"+indentation(indent))
+}
+
+func (p *printer) expressions(list []*Expression, indent int, flat bool) string {
+	sep := "\n" + indentation(indent)
+	if flat {
+		sep = " "
+	}
+
+	var res strings.Builder
+	for i := 0; i < len(list); i++ {
+		if i != 0 {
+			res.WriteString(sep)
+		}
+		// return statements are standalone and followed by what they return
+		if isReturn(list[i]) && i+1 < len(list) {
+			res.WriteString("return ")
+			i++
+		}
+		res.WriteString(p.expression(list[i], indent, flat))
+	}
+	return res.String()
+}
+
+func (p *printer) expression(x *Expression, indent int, flat bool) string {
+	if x == nil || x.Operand == nil {
+		return ""
+	}
+	if isCommentOnly(x) {
+		return p.comments(x.Operand.Comments, indent, flat)
+	}
+
+	if !flat {
+		if res, ok := p.fits(indent, func() string { return p.expression(x, indent, true) }); ok {
+			return res
+		}
+	}
+
+	ind := indentation(indent)
+	var res strings.Builder
+	if x.Operand.Comments != "" {
+		res.WriteString(p.comments(x.Operand.Comments, indent, flat) + "\n" + ind)
+	}
+	res.WriteString(p.operand(x.Operand, indent, flat))
+
+	for _, op := range x.Operations {
+		res.WriteString(" " + op.Operator.String())
+		if op.Operand == nil {
+			continue
+		}
+
+		// long conditions are wrapped after each && and ||
+		wrap := op.Operator == OpAnd || op.Operator == OpOr || op.Operand.Comments != ""
+		if flat || !wrap {
+			res.WriteString(" " + p.comments(op.Operand.Comments, indent, flat) + p.operand(op.Operand, indent, flat))
+			continue
+		}
+
+		res.WriteString("\n" + ind)
+		if op.Operand.Comments != "" {
+			res.WriteString(p.comments(op.Operand.Comments, indent, flat) + "\n" + ind)
+		}
+		res.WriteString(p.operand(op.Operand, indent, flat))
+	}
+
+	return res.String()
+}
+
+// segment of a call chain, which starts with a field, e.g. `.where(...)`
+type segment struct {
+	comments string
+	ident    string
+	calls    []*Call
+}
+
+func (s *segment) isFunction() bool {
+	for i := range s.calls {
+		if s.calls[i].Function != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *printer) operand(o *Operand, indent int, flat bool) string {
+	if o.Value == nil {
+		return ""
+	}
+
+	if o.Value.Ident != nil {
+		switch *o.Value.Ident {
+		case defIdent:
+			if len(o.Calls) == 2 && o.Calls[0].Ident != nil {
+				return "def " + *o.Calls[0].Ident + p.args(o.Calls[1].Function, indent, flat) + " " + p.block(o.Block, indent, flat)
+			}
+		case "switch":
+			if len(o.Block) != 0 {
+				return p.switchBlock(o, indent, flat)
+			}
+		}
+
+		// operators in switch cases are already turned into function calls
+		if _, ok := operatorsMap[*o.Value.Ident]; ok && len(o.Calls) == 1 && len(o.Calls[0].Function) == 2 {
+			args := o.Calls[0].Function
+			return p.expression(args[0].Value, indent, flat) + " " + *o.Value.Ident + " " + p.expression(args[1].Value, indent, flat)
+		}
+	}
+
+	head := &segment{}
+	segments := []*segment{head}
+	for _, call := range o.Calls {
+		if call.Ident != nil {
+			segments = append(segments, &segment{comments: call.Comments, ident: *call.Ident})
+			continue
+		}
+		cur := segments[len(segments)-1]
+		cur.calls = append(cur.calls, call)
+	}
+
+	chain := func(indent int, flat bool) string {
+		res := p.value(o.Value, indent, flat) + p.calls(head.calls, indent, flat)
+		for _, s := range segments[1:] {
+			res += p.comments(s.comments, indent, flat) + "." + s.ident + p.calls(s.calls, indent, flat)
+		}
+		return res
+	}
+
+	var res string
+	lineIndent := indent
+	if flat {
+		res = chain(indent, true)
+	} else if line, ok := p.fits(indent, func() string { return chain(indent, true) }); ok {
+		res = line
+	} else {
+		res, lineIndent = p.wrapChain(o.Value, segments, indent)
+	}
+
+	if o.Block != nil {
+		res += " " + p.block(o.Block, lineIndent, flat)
+	}
+	return res
+}
+
+// wrapChain puts every function call of a long call chain on its own line,
+// e.g. `packages.\n  where(...)`. It returns the indentation of the last line.
+func (p *printer) wrapChain(value *Value, segments []*segment, indent int) (string, int) {
+	res := p.value(value, indent, false) + p.calls(segments[0].calls, indent, false)
+
+	lineIndent := indent
+	ind := indentation(indent + 2)
+	for _, s := range segments[1:] {
+		if !s.isFunction() && s.comments == "" {
+			res += "." + s.ident + p.calls(s.calls, lineIndent, false)
+			continue
+		}
+
+		lineIndent = indent + 2
+		res += ".\n" + ind
+		if s.comments != "" {
+			res += p.comments(s.comments, lineIndent, false) + "\n" + ind
+		}
+		res += s.ident + p.calls(s.calls, lineIndent, false)
+	}
+	return res, lineIndent
+}
+
+func (p *printer) calls(calls []*Call, indent int, flat bool) string {
+	var res strings.Builder
+	for _, call := range calls {
+		if call.Function != nil {
+			res.WriteString(p.args(call.Function, indent, flat))
+		} else if call.Accessor != nil {
+			res.WriteString("[" + p.expression(call.Accessor, indent, flat) + "]")
+		}
+	}
+	return res.String()
+}
+
+func (p *printer) arg(arg *Arg, indent int, flat bool) string {
+	if arg.Name != "" {
+		return arg.Name + ": " + p.expression(arg.Value, indent, flat)
+	}
+	return p.expression(arg.Value, indent, flat)
+}
+
+func (p *printer) args(args []*Arg, indent int, flat bool) string {
+	if len(args) == 0 {
+		return "()"
+	}
+
+	if !flat {
+		if res, ok := p.fits(indent, func() string { return p.args(args, indent, true) }); ok {
+			return res
+		}
+	}
+
+	list := make([]*Expression, len(args))
+	for i := range args {
+		list[i] = args[i].Value
+	}
+	return p.list("(", ")", len(args), list, func(i int, indent int) string {
+		return p.arg(args[i], indent, flat)
+	}, indent, flat)
+}
+
+// list prints comma-separated items, either on one line or one per line
+func (p *printer) list(open string, close string, n int, items []*Expression, print func(i int, indent int) string, indent int, flat bool) string {
+	if flat {
+		parts := make([]string, n)
+		for i := 0; i < n; i++ {
+			parts[i] = print(i, indent)
+		}
+		return open + strings.Join(parts, ", ") + close
+	}
+
+	ind := indentation(indent + 2)
+	var res strings.Builder
+	res.WriteString(open)
+	for i := 0; i < n; i++ {
+		res.WriteString("\n" + ind + print(i, indent+2))
+		// comments at the end of a list don't need a separator
+		if i != n-1 && !isCommentOnly(items[i]) {
+			res.WriteString(",")
+		}
+	}
+	res.WriteString("\n" + indentation(indent) + close)
+	return res.String()
+}
+
+func (p *printer) block(list []*Expression, indent int, flat bool) string {
+	if len(list) == 0 {
+		return "{}"
+	}
+
+	if flat {
+		return "{ " + p.expressions(list, indent, true) + " }"
+	}
+	if res, ok := p.fits(indent, func() string { return p.block(list, indent, true) }); ok {
+		return res
+	}
+
+	return "{\n" + indentation(indent+2) + p.expressions(list, indent+2, false) + "\n" + indentation(indent) + "}"
+}
+
+// switchBlock prints switch statements, whose block alternates between
+// the condition of a case (nil for default) and the case's block
+func (p *printer) switchBlock(o *Operand, indent int, flat bool) string {
+	if flat {
+		p.broken = true
+		return ""
+	}
+
+	var res strings.Builder
+	res.WriteString("switch" + p.calls(o.Calls, indent, false) + " {")
+	ind := indentation(indent + 2)
+	for i := 0; i+1 < len(o.Block); i += 2 {
+		if o.Block[i] == nil {
+			res.WriteString("\n" + ind + "default:")
+		} else {
+			res.WriteString("\n" + ind + "case " + p.expression(o.Block[i], indent+2, false) + ":")
+		}
+
+		if block := o.Block[i+1]; block != nil && block.Operand != nil {
+			res.WriteString("\n" + indentation(indent+4) + p.expressions(block.Operand.Block, indent+4, false))
+		}
+	}
+	res.WriteString("\n" + indentation(indent) + "}")
+	return res.String()
+}
+
+var (
+	identRegex    = regexp.MustCompile(`^[a-zA-Z$_][a-zA-Z0-9_]*$`)
+	regexModifier = regexp.MustCompile(`^\(\?([msi]+)\)`)
+)
+
+func (p *printer) value(v *Value, indent int, flat bool) string {
+	switch {
+	case v.Bool != nil:
+		return strconv.FormatBool(*v.Bool)
+
+	case v.String != nil:
+		return quoteString(*v.String)
+
+	case v.Int != nil:
+		if octal, ok := p.octals[*v.Int]; ok {
+			return octal
+		}
+		return strconv.FormatInt(*v.Int, 10)
+
+	case v.Float != nil:
+		return formatFloat(*v.Float)
+
+	case v.Regex != nil:
+		// modifiers are stored as prefix of the regex, e.g. (?i)
+		re := *v.Regex
+		if m := regexModifier.FindStringSubmatch(re); m != nil {
+			return "/" + re[len(m[0]):] + "/" + m[1]
+		}
+		return "/" + re + "/"
+
+	case v.Array != nil:
+		if len(v.Array) == 0 {
+			return "[]"
+		}
+		if !flat {
+			if res, ok := p.fits(indent, func() string { return p.value(v, indent, true) }); ok {
+				return res
+			}
+		}
+		return p.list("[", "]", len(v.Array), v.Array, func(i int, indent int) string {
+			return p.expression(v.Array[i], indent, flat)
+		}, indent, flat)
+
+	case v.Map != nil:
+		if len(v.Map) == 0 {
+			return "{}"
+		}
+		if !flat {
+			if res, ok := p.fits(indent, func() string { return p.value(v, indent, true) }); ok {
+				return res
+			}
+		}
+
+		// the parser doesn't retain the order of keys, so we sort them
+		keys := make([]string, 0, len(v.Map))
+		for k := range v.Map {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		items := make([]*Expression, len(keys))
+		for i := range keys {
+			items[i] = v.Map[keys[i]]
+		}
+
+		return p.list("{", "}", len(keys), items, func(i int, indent int) string {
+			key := keys[i]
+			if !identRegex.MatchString(key) {
+				key = quoteString(key)
+			}
+			return key + ": " + p.expression(items[i], indent, flat)
+		}, indent, flat)
+
+	case v.Ident != nil:
+		return *v.Ident
+
+	default:
+		return "null"
+	}
+}
+
+var escapeReplacer = strings.NewReplacer(
+	"\\", "\\\\",
+	"\"", "\\\"",
+	"\n", "\\n",
+	"\t", "\\t",
+	"\v", "\\v",
+	"\b", "\\b",
+	"\f", "\\f",
+	"\x00", "\\0",
+)
+
+// quoteString uses double quotes, unless the string is easier to read in
+// single quotes, which don't support escape sequences
+func quoteString(s string) string {
+	if strings.ContainsAny(s, "\\\"") && !strings.ContainsAny(s, "'\n\t\v\b\f\x00") {
+		return "'" + s + "'"
+	}
+	return "\"" + escapeReplacer.Replace(s) + "\""
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	}
+
+	res := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(res, ".") {
+		res += ".0"
+	}
+	return res
+}
@@ -0,0 +1,85 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		code string
+		res  string
+	}{
+		{"packages.where(name==/ssh/i)", "packages.where(name == /ssh/i)"},
+		{"a+ 1*2 - 3>=4", "a + 1 * 2 - 3 >= 4"},
+		{"file('/etc/passwd').permissions.mode==0644", `file("/etc/passwd").permissions.mode == 0644`},
+		{`[1,2.0,'x"y',"it's",true,null]`, `[1, 2.0, 'x"y', "it's", true, null]`},
+		{"{b: 1, 'a c': 2}", `{"a c": 2, b: 1}`},
+		{"x[\"y\"] != 1 || x{*}", `x["y"] != 1 || x { * }`},
+		{"users {\n  name\n    uid\n}", "users { name uid }"},
+		{
+			"packages.where(name == /ssh/).where(version == \"1.2.3\").where(installed == true).where(arch==\"amd64\")",
+			"packages.\n  where(name == /ssh/).\n  where(version == \"1.2.3\").\n  where(installed == true).\n  where(arch == \"amd64\")",
+		},
+		{
+			"users.where(name == \"root\" && uid == 0 && gid == 0 && shell != \"/bin/bash\" && home != \"/root\").list { name uid }",
+			"users.\n  where(\n    name == \"root\" &&\n    uid == 0 &&\n    gid == 0 &&\n    shell != \"/bin/bash\" &&\n    home != \"/root\"\n  ).list { name uid }",
+		},
+		{
+			"k8s.pod { initContainers { securityContext name image } containers { securityContext name image } }",
+			"k8s.pod {\n  initContainers { securityContext name image }\n  containers { securityContext name image }\n}",
+		},
+		{
+			"# hello\n# world\nasset.name # trailing\nusers {\n name\n # inner\n uid }",
+			"# hello\n# world\nasset.name\n# trailing\nusers {\n  name\n  # inner\n  uid\n}",
+		},
+		{
+			"users\n # only root\n .where(name == 'root')",
+			"users.\n  # only root\n  where(name == \"root\")",
+		},
+		{
+			"switch(asset.family) { case _.contains('unix'): 1; default: 2 }",
+			"switch(asset.family) {\n  case _.contains(\"unix\"):\n    1\n  default:\n    2\n}",
+		},
+		{
+			"def long(s: string) {\nreturn s.length > 3 }\nlong('abc')",
+			"def long(s: string) { return s.length > 3 }\nlong(\"abc\")",
+		},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.code, func(t *testing.T) {
+			res, err := Format(cur.code)
+			require.NoError(t, err)
+			assert.Equal(t, cur.res, res)
+
+			// formatting is stable and doesn't change the code
+			again, err := Format(res)
+			require.NoError(t, err)
+			assert.Equal(t, res, again)
+
+			expected, err := Parse(cur.code)
+			require.NoError(t, err)
+			actual, err := Parse(res)
+			require.NoError(t, err)
+			if strings.Contains(cur.code, "#") {
+				// comments may move to the next line
+				assert.Equal(t, len(expected.Expressions), len(actual.Expressions))
+			} else {
+				assert.Equal(t, expected, actual)
+			}
+		})
+	}
+
+	t.Run("invalid code", func(t *testing.T) {
+		_, err := Format("users.where(")
+		assert.Error(t, err)
+	})
+}
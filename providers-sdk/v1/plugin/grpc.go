@@ -12,6 +12,8 @@ import (
 func init() {
 	var x ProviderPlugin = &GRPCClient{}
 	_ = x
+	var y ContextProviderPlugin = &GRPCClient{}
+	_ = y
 }
 
 // GRPCClient is an implementation of KV that talks over RPC.
@@ -58,6 +60,10 @@ func (m *GRPCClient) GetData(req *DataReq) (*DataRes, error) {
 	return m.client.GetData(context.Background(), req)
 }
 
+func (m *GRPCClient) GetDataWithContext(ctx context.Context, req *DataReq) (*DataRes, error) {
+	return m.client.GetData(ctx, req)
+}
+
 func (m *GRPCClient) StoreData(req *StoreReq) (*StoreRes, error) {
 	return m.client.StoreData(context.Background(), req)
 }
@@ -92,6 +98,10 @@ func (m *GRPCServer) Shutdown(ctx context.Context, req *ShutdownReq) (*ShutdownR
 }
 
 func (m *GRPCServer) GetData(ctx context.Context, req *DataReq) (*DataRes, error) {
+	// providers that support it stop their work once the host stops waiting
+	if p, ok := m.Impl.(ContextProviderPlugin); ok {
+		return p.GetDataWithContext(ctx, req)
+	}
 	return m.Impl.GetData(req)
 }
 
@@ -37,6 +37,11 @@ type ProviderPlugin interface {
 	StoreData(req *StoreReq) (*StoreRes, error)
 }
 
+// ContextProviderPlugin is a provider plugin that can cancel data requests
+type ContextProviderPlugin interface {
+	GetDataWithContext(ctx context.Context, req *DataReq) (*DataRes, error)
+}
+
 // This is the implementation of plugin.Plugin so we can serve/consume this.
 // We also implement GRPCPlugin so that this plugin can be served over
 // gRPC.
@@ -4,7 +4,9 @@
 package plugin
 
 import (
+	"context"
 	"errors"
+	"sync"
 
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers-sdk/v1/upstream"
@@ -19,6 +21,15 @@ type Runtime struct {
 	HasRecording   bool
 	CreateResource CreateNamedResource
 	Upstream       *upstream.UpstreamClient
+
+	// calls from the host that are currently active, see StartCall
+	callsLock sync.Mutex
+	calls     map[*call]struct{}
+}
+
+type call struct {
+	ctx  context.Context
+	done chan struct{}
 }
 
 type Connection interface{}
@@ -30,6 +41,63 @@ type Resource interface {
 	MqlName() string
 }
 
+// StartCall registers a call from the host, which is active until the
+// returned function is called. Work done during a call can be canceled once
+// the call's context is, see CallContext.
+func (r *Runtime) StartCall(ctx context.Context) func() {
+	c := &call{ctx: ctx, done: make(chan struct{})}
+	r.callsLock.Lock()
+	if r.calls == nil {
+		r.calls = map[*call]struct{}{}
+	}
+	r.calls[c] = struct{}{}
+	r.callsLock.Unlock()
+
+	return func() {
+		r.callsLock.Lock()
+		delete(r.calls, c)
+		r.callsLock.Unlock()
+		close(c.done)
+	}
+}
+
+// CallContext returns a context for work that is shared by all calls which
+// are currently active, e.g. a command that runs on the connection. It is
+// canceled once none of these calls is waiting for the result anymore.
+// Work outside of calls, e.g. while connecting, is never canceled.
+// Callers must call the cancel function once the work is done.
+func (r *Runtime) CallContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r.callsLock.Lock()
+	calls := make([]*call, 0, len(r.calls))
+	for c := range r.calls {
+		if c.ctx.Done() == nil {
+			r.callsLock.Unlock()
+			return ctx, cancel
+		}
+		calls = append(calls, c)
+	}
+	r.callsLock.Unlock()
+
+	if len(calls) == 0 {
+		return ctx, cancel
+	}
+
+	go func() {
+		for _, c := range calls {
+			select {
+			case <-c.ctx.Done():
+			case <-c.done:
+			case <-ctx.Done():
+				return
+			}
+		}
+		cancel()
+	}()
+	return ctx, cancel
+}
+
 func (r *Runtime) ResourceFromRecording(name string, id string) (map[string]*llx.RawData, error) {
 	data, err := r.Callback.GetRecording(&DataReq{
 		Resource:   name,
@@ -6,6 +6,7 @@ package provider
 import (
 	"errors"
 	"strconv"
+	"sync"
 
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
@@ -28,6 +29,9 @@ const (
 type Service struct {
 	runtimes         map[uint32]*plugin.Runtime
 	lastConnectionID uint32
+	// mutex guards runtimes and lastConnectionID, since assets are
+	// connected while others are still being queried
+	mutex sync.Mutex
 }
 
 func Init() *Service {
@@ -37,6 +41,20 @@ func Init() *Service {
 	}
 }
 
+func (s *Service) nextConnectionID() uint32 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lastConnectionID++
+	return s.lastConnectionID
+}
+
+func (s *Service) runtime(id uint32) (*plugin.Runtime, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	runtime, ok := s.runtimes[id]
+	return runtime, ok
+}
+
 func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error) {
 	flags := req.Flags
 	if flags == nil {
@@ -124,18 +142,15 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 
 	switch conf.Type {
 	case SshConnectionType:
-		s.lastConnectionID++
-		conn, err = osconnection.NewSshConnection(s.lastConnectionID, conf, asset)
+		conn, err = osconnection.NewSshConnection(s.nextConnectionID(), conf, asset)
 		if pf, ok := detector.DetectOS(conn); ok {
 			conn.Asset().Platform = pf
 		}
 
 	case RegistryImageConnectionType:
-		s.lastConnectionID++
-		conn, err = osconnection.NewContainerRegistryImage(s.lastConnectionID, conf, asset)
+		conn, err = osconnection.NewContainerRegistryImage(s.nextConnectionID(), conf, asset)
 	default:
-		s.lastConnectionID++
-		conn, err = connection.NewAwsConnection(s.lastConnectionID, asset, conf)
+		conn, err = connection.NewAwsConnection(s.nextConnectionID(), asset, conf)
 
 	}
 	if err != nil {
@@ -151,13 +166,16 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	}
 
 	asset.Connections[0].Id = conn.ID()
-	s.runtimes[conn.ID()] = &plugin.Runtime{
+	runtime := &plugin.Runtime{
 		Connection:     conn,
 		Callback:       callback,
 		HasRecording:   req.HasRecording,
 		CreateResource: resources.CreateResource,
 		Upstream:       upstream,
 	}
+	s.mutex.Lock()
+	s.runtimes[conn.ID()] = runtime
+	s.mutex.Unlock()
 
 	return conn, err
 }
@@ -180,7 +198,7 @@ func (s *Service) Shutdown(req *plugin.ShutdownReq) (*plugin.ShutdownRes, error)
 }
 
 func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
@@ -234,7 +252,7 @@ func (s *Service) discover(conn *connection.AwsConnection) (*inventory.Inventory
 		return nil, nil
 	}
 
-	runtime, ok := s.runtimes[conn.ID()]
+	runtime, ok := s.runtime(conn.ID())
 	if !ok {
 		// no connection found, this should never happen
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(conn.ID()), 10) + " not found")
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"sync"
 
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
@@ -25,6 +26,9 @@ const (
 type Service struct {
 	runtimes         map[uint32]*plugin.Runtime
 	lastConnectionID uint32
+	// mutex guards runtimes and lastConnectionID, since assets are
+	// connected while others are still being queried
+	mutex sync.Mutex
 }
 
 func Init() *Service {
@@ -33,6 +37,20 @@ func Init() *Service {
 	}
 }
 
+func (s *Service) nextConnectionID() uint32 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lastConnectionID++
+	return s.lastConnectionID
+}
+
+func (s *Service) runtime(id uint32) (*plugin.Runtime, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	runtime, ok := s.runtimes[id]
+	return runtime, ok
+}
+
 func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error) {
 	flags := req.GetFlags()
 
@@ -145,8 +163,7 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 
 	asset := req.Asset
 	conf := asset.Connections[0]
-	s.lastConnectionID++
-	conn, err := connection.NewAzureConnection(s.lastConnectionID, asset, conf)
+	conn, err := connection.NewAzureConnection(s.nextConnectionID(), asset, conf)
 	if err != nil {
 		return nil, err
 	}
@@ -160,13 +177,16 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	}
 
 	asset.Connections[0].Id = conn.ID()
-	s.runtimes[conn.ID()] = &plugin.Runtime{
+	runtime := &plugin.Runtime{
 		Connection:     conn,
 		Callback:       callback,
 		HasRecording:   req.HasRecording,
 		CreateResource: resources.CreateResource,
 		Upstream:       upstream,
 	}
+	s.mutex.Lock()
+	s.runtimes[conn.ID()] = runtime
+	s.mutex.Unlock()
 
 	return conn, err
 }
@@ -177,7 +197,7 @@ func (s *Service) detect(asset *inventory.Asset, conn *connection.AzureConnectio
 }
 
 func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
@@ -224,7 +244,7 @@ func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
 }
 
 func (s *Service) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
@@ -268,7 +288,7 @@ func (s *Service) discover(conn *connection.AzureConnection, conf *inventory.Con
 		return nil, nil
 	}
 
-	runtime, ok := s.runtimes[conn.ID()]
+	runtime, ok := s.runtime(conn.ID())
 	if !ok {
 		// no connection found, this should never happen
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(conn.ID()), 10) + " not found")
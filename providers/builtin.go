@@ -49,6 +49,16 @@ var builtinProviders = map[string]*builtinProvider{
 		},
 		Config: &coreconf.Config,
 	},
+	RecordingProviderID: {
+		Runtime: &RunningProvider{
+			Name:     recordingProviderConfig.Name,
+			ID:       RecordingProviderID,
+			Plugin:   newReplayProvider(),
+			Schema:   &resources.Schema{Resources: map[string]*resources.ResourceInfo{}},
+			isClosed: false,
+		},
+		Config: &recordingProviderConfig,
+	},
 	// osconf.Config.ID: {
 	// 	Runtime: &RunningProvider{
 	// 		Name:     osconf.Config.Name,
@@ -15,7 +15,7 @@ import (
 	"github.com/rs/zerolog/log"
 	pp "go.mondoo.com/cnquery/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
-	"go.mondoo.com/cnquery/providers/core/resources/versions/semver"
+	"go.mondoo.com/cnquery/utils/versions/semver"
 )
 
 var Coordinator = coordinator{
@@ -36,6 +36,11 @@ type RunningProvider struct {
 	Schema *resources.Schema
 
 	isClosed bool
+	// connections counts the runtimes that use this provider as their main
+	// provider. Runtimes share running providers, so the provider is only
+	// shut down once the last of them is closed.
+	connections int
+	lock        sync.Mutex
 }
 
 type UpdateProvidersConfig struct {
@@ -47,14 +52,26 @@ type UpdateProvidersConfig struct {
 
 func (c *coordinator) Start(id string, update UpdateProvidersConfig) (*RunningProvider, error) {
 	if x, ok := builtinProviders[id]; ok {
-		// We don't warn for the core provider, which is the only provider expected
-		// to be built into the binary for now.
-		if id != BuiltinCoreID {
+		// We don't warn for the core and recording providers, which are the only
+		// providers expected to be built into the binary for now.
+		if id != BuiltinCoreID && id != RecordingProviderID {
 			log.Warn().Msg("using builtin provider for " + x.Config.Name)
 		}
 		return x.Runtime, nil
 	}
 
+	// All runtimes share the running providers, so every provider is only
+	// ever started once. We hold the lock for the entire startup, so that
+	// concurrent runtimes don't spawn duplicate processes.
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for i := range c.Running {
+		if c.Running[i].ID == id && !c.Running[i].isClosed {
+			return c.Running[i], nil
+		}
+	}
+
 	if c.Providers == nil {
 		var err error
 		c.Providers, err = ListActive()
@@ -128,9 +145,7 @@ func (c *coordinator) Start(id string, update UpdateProvidersConfig) (*RunningPr
 		Schema: provider.Schema,
 	}
 
-	c.mutex.Lock()
 	c.Running = append(c.Running, res)
-	c.mutex.Unlock()
 
 	return res, nil
 }
@@ -205,21 +220,25 @@ func (c *coordinator) tryProviderUpdate(provider *Provider, update UpdateProvide
 }
 
 func (c *coordinator) Close(p *RunningProvider) {
-	if !p.isClosed {
-		p.isClosed = true
-		if p.Client != nil {
-			p.Client.Kill()
-		}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !p.isClosed && p.Client != nil {
+		p.Client.Kill()
 	}
+	c.unlist(p)
+}
 
-	c.mutex.Lock()
+// unlist marks a provider as closed and removes it from the running
+// providers, so it isn't handed out anymore. The caller must hold the lock.
+func (c *coordinator) unlist(p *RunningProvider) {
+	p.isClosed = true
 	for i := range c.Running {
 		if c.Running[i] == p {
 			c.Running = append(c.Running[0:i], c.Running[i+1:]...)
 			break
 		}
 	}
-	c.mutex.Unlock()
 }
 
 func (c *coordinator) Shutdown() {
@@ -237,6 +256,9 @@ func (c *coordinator) LoadSchema(name string) (*resources.Schema, error) {
 		return x.Runtime.Schema, nil
 	}
 
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
 	provider, ok := c.Providers[name]
 	if !ok {
 		return nil, errors.New("cannot find provider '" + name + "'")
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"sync"
 
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
@@ -19,6 +20,9 @@ const defaultConnection uint32 = 1
 type Service struct {
 	runtimes         map[uint32]*plugin.Runtime
 	lastConnectionID uint32
+	// core is built into the binary and called directly,
+	// so it has to guard its connections itself
+	mutex sync.Mutex
 }
 
 func Init() *Service {
@@ -36,13 +40,15 @@ func (s *Service) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 		return nil, errors.New("no connection data provided")
 	}
 
-	s.lastConnectionID++
-	connID := s.lastConnectionID
 	runtime := &plugin.Runtime{
 		Callback:     callback,
 		HasRecording: req.HasRecording,
 	}
+	s.mutex.Lock()
+	s.lastConnectionID++
+	connID := s.lastConnectionID
 	s.runtimes[connID] = runtime
+	s.mutex.Unlock()
 
 	asset := req.Asset
 	_, err := resources.CreateResource(runtime, "asset", map[string]*llx.RawData{
@@ -85,8 +91,15 @@ func (s *Service) Shutdown(req *plugin.ShutdownReq) (*plugin.ShutdownRes, error)
 	return &plugin.ShutdownRes{}, nil
 }
 
+func (s *Service) runtime(id uint32) (*plugin.Runtime, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	runtime, ok := s.runtimes[id]
+	return runtime, ok
+}
+
 func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
@@ -114,7 +127,7 @@ func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
 }
 
 func (s *Service) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
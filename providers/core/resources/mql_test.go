@@ -414,6 +414,81 @@ func TestString_Methods(t *testing.T) {
 			Code:        "'hello ' + 'world'",
 			Expectation: "hello world",
 		},
+		{
+			Code:        "'hello'.startsWith('he')",
+			Expectation: true,
+		},
+		{
+			Code:        "'hello'.startsWith(['x', 'y'])",
+			Expectation: false,
+		},
+		{
+			Code:        "'hello'.endsWith('llo')",
+			Expectation: true,
+		},
+		{
+			Code:        "'a-b-c'.replace('-', '+')",
+			Expectation: "a+b+c",
+		},
+		{
+			Code:        "'port 22'.replace(/port (\\d+)/, '$1')",
+			Expectation: "22",
+		},
+		{
+			Code:        "'user=bob id=1'.matchGroups(/user=(?P<user>\\w+) id=(\\d+)/)",
+			Expectation: map[string]interface{}{"user": "bob", "2": "1"},
+		},
+		{
+			Code:        "'b'.in(['a', 'b'])",
+			Expectation: true,
+		},
+		{
+			Code:        "'hello'.substring(1, 3)",
+			Expectation: "el",
+		},
+		{
+			Code:        "'hello'.substring(3)",
+			Expectation: "lo",
+		},
+		{
+			Code:        "'hello'.substring(-1, 100)",
+			Expectation: "hello",
+		},
+		{
+			Code:        "'7'.padLeft(3, '0')",
+			Expectation: "007",
+		},
+		{
+			Code:        "'ab'.padRight(5, 'xy')",
+			Expectation: "abxyx",
+		},
+		{
+			Code:        "'x'.padLeft(4, 'äö')",
+			Expectation: "äöäx",
+		},
+		{
+			Code:        "'%s:%d'.format('host', 22)",
+			Expectation: "host:22",
+		},
+		{
+			Code:        "'%05d|%-3s|%.2f|100%%'.format(7, 'a', 1.5)",
+			Expectation: "00007|a  |1.50|100%",
+		},
+	})
+
+	x.TestSimpleErrors(t, []testutils.SimpleTest{
+		{
+			Code:        "'x'.padLeft(2000000)",
+			Expectation: "cannot pad string to more than 1048576 characters",
+		},
+		{
+			Code:        "'%0999999999d'.format(1)",
+			Expectation: "cannot format values wider than 1048576 characters",
+		},
+		{
+			Code:        "'%.9999999f'.format(1.5)",
+			Expectation: "cannot format values wider than 1048576 characters",
+		},
 	})
 }
 
@@ -453,6 +528,115 @@ func TestTypeof_Methods(t *testing.T) {
 	})
 }
 
+func TestVersion_Methods(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock())
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "typeof(version('1.2'))",
+			Expectation: "version",
+		},
+		{
+			Code:        "version('1.10') > '1.9'",
+			ResultIndex: 1,
+			Expectation: true,
+		},
+		{
+			Code:        "version('1.10') != version('1.10.1')",
+			ResultIndex: 2,
+			Expectation: true,
+		},
+		{
+			Code:        "version('1:1.2-1', type: 'deb') >= '1.9'",
+			ResultIndex: 1,
+			Expectation: true,
+		},
+		{
+			Code:        "version('1.2.3-beta', type: 'semver') < '1.2.3'",
+			ResultIndex: 1,
+			Expectation: true,
+		},
+		{
+			Code:        "version('3.0.7-r0', type: 'apk').inRange('3.0.0', '3.0.7-r0')",
+			Expectation: true,
+		},
+		{
+			Code:        "version('3.1.0', type: 'apk').inRange('3.0.0', '3.0.7-r0')",
+			Expectation: false,
+		},
+	})
+}
+
+func TestIP_Methods(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock())
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "typeof(ip('10.0.0.1'))",
+			Expectation: "ip",
+		},
+		{
+			Code:        "ip('10.1.2.3').inRange('10.0.0.0/8')",
+			Expectation: true,
+		},
+		{
+			Code:        "ip('192.168.1.1').inRange(cidr('10.0.0.0/8'))",
+			Expectation: false,
+		},
+		{
+			Code:        "ip('192.168.1.1').isPrivate",
+			Expectation: true,
+		},
+		{
+			Code:        "ip('::1').isLoopback",
+			Expectation: true,
+		},
+		{
+			Code:        "ip('0.0.0.0/0').isUnspecified",
+			Expectation: true,
+		},
+		{
+			Code:        "ip('2001:db8::1').version",
+			Expectation: int64(6),
+		},
+		{
+			Code:        "ip('10.1.2.3/8').prefix",
+			Expectation: "10.0.0.0/8",
+		},
+		{
+			Code:        "cidr('10.1.2.3').prefixLength",
+			Expectation: int64(32),
+		},
+		{
+			Code:        "cidr('10.0.0.0/8').contains('10.20.0.0/16')",
+			Expectation: true,
+		},
+		{
+			Code:        "cidr('10.20.0.0/16').contains('10.0.0.0/8')",
+			Expectation: false,
+		},
+		{
+			Code:        "cidr('10.20.0.0/16').overlaps('10.0.0.0/8')",
+			Expectation: true,
+		},
+		{
+			Code:        "ip('10.0.0.1') == '10.0.0.1'",
+			ResultIndex: 1,
+			Expectation: true,
+		},
+		{
+			Code:        "ip('10.0.0.1') != ip('10.0.0.2')",
+			ResultIndex: 2,
+			Expectation: true,
+		},
+	})
+
+	x.TestSimpleErrors(t, []testutils.SimpleTest{
+		{
+			Code:        "ip('10.0.0.256')",
+			Expectation: "failed to parse '10.0.0.256' as IP address",
+		},
+	})
+}
+
 func TestArray_Access(t *testing.T) {
 	x := testutils.InitTester(testutils.LinuxMock())
 	x.TestSimpleErrors(t, []testutils.SimpleTest{
@@ -631,6 +815,94 @@ func TestArray(t *testing.T) {
 			Code:        "[3,1,3,4,2] - [3,4,5]",
 			Expectation: []interface{}{int64(1), int64(2)},
 		},
+		{
+			Code:        "[3,1,2].sort",
+			Expectation: []interface{}{int64(1), int64(2), int64(3)},
+		},
+		{
+			Code:        "['b','c','a'].sortDesc",
+			Expectation: []interface{}{"c", "b", "a"},
+		},
+		{
+			Code:        "[{n: 3}, {n: 1}, {n: 2}].sort(_['n']).map(_['n'])",
+			Expectation: []interface{}{int64(1), int64(2), int64(3)},
+		},
+		{
+			Code:        "[{n: 3}, {n: 1}, {n: 2}].sortDesc(_['n']).take(2).map(_['n'])",
+			Expectation: []interface{}{int64(3), int64(2)},
+		},
+		{
+			Code:        "[{ns: 'a'}, {ns: 'b'}, {ns: 'a'}].groupBy(_['ns']).keys.sort",
+			Expectation: []interface{}{"a", "b"},
+		},
+		{
+			Code:        "[{ns: 'a'}, {ns: 'b'}, {ns: 'a'}].groupBy(_['ns'])['a'].length",
+			Expectation: int64(2),
+		},
+		{
+			Code:        "[3,1,2].min",
+			Expectation: int64(1),
+		},
+		{
+			Code:        "['b','c','a'].max",
+			Expectation: "c",
+		},
+		{
+			Code:        "[1,2,3].sum",
+			Expectation: int64(6),
+		},
+		{
+			Code:        "[1.5,2.5].sum",
+			Expectation: float64(4),
+		},
+		{
+			Code:        "[1,2,3,4].avg",
+			Expectation: float64(2.5),
+		},
+		{
+			Code:        "[1,2,3,4].skip(3)",
+			Expectation: []interface{}{int64(4)},
+		},
+		{
+			Code:        "[1,2,3].take(5)",
+			Expectation: []interface{}{int64(1), int64(2), int64(3)},
+		},
+		{
+			Code:        "[1,2,3].sample(2).length",
+			Expectation: int64(2),
+		},
+	})
+}
+
+func TestFunctions(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock())
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "def isWeak(c: string) { c == /RC4|DES/ }\nisWeak('RC4-SHA')",
+			ResultIndex: 1,
+			Expectation: true,
+		},
+		{
+			Code:        "def isWeak(c: string) { c == /RC4|DES/ }\n['AES', 'DES'].where(isWeak(_))",
+			Expectation: []interface{}{"DES"},
+		},
+		{
+			Code:        "def add(a: int, b: int) { a + b }\nadd(1, 2) + 3",
+			Expectation: int64(6),
+		},
+		{
+			Code:        "def twice(a) { x = a * 2; x }\ntwice(21)",
+			Expectation: int64(42),
+		},
+		{
+			Code:        "def outer(a: int) { def inner(b: int) { b + 1 }; inner(a) * 2 }\nouter(1)",
+			Expectation: int64(4),
+		},
+		{
+			Code:        "def size(s: string) { s.length }\nsize('abc') == 3",
+			ResultIndex: 2,
+			Expectation: true,
+		},
 	})
 }
 
@@ -208,3 +208,88 @@ func TestTime_Methods(t *testing.T) {
 		},
 	})
 }
+
+func TestTime_Durations(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock())
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "duration('90d')",
+			Expectation: duration(90 * 24 * 60 * 60),
+		},
+		{
+			Code:        "duration('1h30m').minutes",
+			Expectation: int64(90),
+		},
+		{
+			Code:        "duration('P1Y2M').days",
+			Expectation: int64(365 + 60),
+		},
+		{
+			Code:        "duration(120)",
+			Expectation: duration(120),
+		},
+		{
+			Code:        "duration('2h') == 2 * time.hour",
+			ResultIndex: 2,
+			Expectation: true,
+		},
+		{
+			Code:        "time.now - duration('90d') < time.now",
+			ResultIndex: 2,
+			Expectation: true,
+		},
+	})
+
+	x.TestSimpleErrors(t, []testutils.SimpleTest{
+		{
+			Code:        "duration('3 weeks')",
+			Expectation: "failed to parse '3 weeks' as duration: unknown unit ' weeks'",
+		},
+	})
+}
+
+func TestTime_Format(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock())
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "time.parse('2023-12-23T10:11:12Z').format('2006-01-02')",
+			Expectation: "2023-12-23",
+		},
+		{
+			Code:        "time.parse('2023/12/23', '2006/01/02').inTimezone('UTC').format('rfc3339')",
+			Expectation: "2023-12-23T00:00:00Z",
+		},
+		{
+			Code:        "time.parse('2023-12-23T22:00:00-05:00').inTimezone('UTC').format('2006-01-02 15:04')",
+			Expectation: "2023-12-24 03:00",
+		},
+		{
+			Code:        "time.parse('2023-12-23T10:11:12Z').inTimezone('UTC').startOfMonth.format('rfc3339')",
+			Expectation: "2023-12-01T00:00:00Z",
+		},
+		{
+			Code:        "time.parse('2023-12-23T10:11:12Z').inTimezone('UTC').startOfWeek.format('rfc3339')",
+			Expectation: "2023-12-18T00:00:00Z",
+		},
+		{
+			Code:        "time.parse('2023-12-23T10:11:12Z').inTimezone('UTC').startOfYear.format('rfc3339')",
+			Expectation: "2023-01-01T00:00:00Z",
+		},
+		{
+			Code:        "time.parse('2023-12-23T10:11:12Z').inTimezone('UTC').startOfHour.format('rfc3339')",
+			Expectation: "2023-12-23T10:00:00Z",
+		},
+		{
+			Code:        "time.parse('2023-12-23') == parse.date('2023-12-23')",
+			ResultIndex: 2,
+			Expectation: true,
+		},
+	})
+
+	x.TestSimpleErrors(t, []testutils.SimpleTest{
+		{
+			Code:        "time.now.inTimezone('Mars/Olympus_Mons')",
+			Expectation: "unknown timezone 'Mars/Olympus_Mons'",
+		},
+	})
+}
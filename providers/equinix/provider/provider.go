@@ -8,6 +8,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
@@ -23,6 +24,9 @@ const ConnectionType = "equinix"
 type Service struct {
 	runtimes         map[uint32]*plugin.Runtime
 	lastConnectionID uint32
+	// mutex guards runtimes and lastConnectionID, since assets are
+	// connected while others are still being queried
+	mutex sync.Mutex
 }
 
 func Init() *Service {
@@ -32,6 +36,20 @@ func Init() *Service {
 	}
 }
 
+func (s *Service) nextConnectionID() uint32 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lastConnectionID++
+	return s.lastConnectionID
+}
+
+func (s *Service) runtime(id uint32) (*plugin.Runtime, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	runtime, ok := s.runtimes[id]
+	return runtime, ok
+}
+
 func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error) {
 	flags := req.Flags
 	if flags == nil {
@@ -120,8 +138,7 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 
 	switch conf.Type {
 	default:
-		s.lastConnectionID++
-		conn, err = connection.NewEquinixConnection(s.lastConnectionID, asset, conf)
+		conn, err = connection.NewEquinixConnection(s.nextConnectionID(), asset, conf)
 	}
 
 	if err != nil {
@@ -137,13 +154,16 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	}
 
 	asset.Connections[0].Id = conn.ID()
-	s.runtimes[conn.ID()] = &plugin.Runtime{
+	runtime := &plugin.Runtime{
 		Connection:     conn,
 		Callback:       callback,
 		HasRecording:   req.HasRecording,
 		CreateResource: resources.CreateResource,
 		Upstream:       upstream,
 	}
+	s.mutex.Lock()
+	s.runtimes[conn.ID()] = runtime
+	s.mutex.Unlock()
 
 	return conn, err
 }
@@ -167,7 +187,7 @@ func (s *Service) detect(asset *inventory.Asset, conn *connection.EquinixConnect
 }
 
 func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
@@ -214,7 +234,7 @@ func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
 }
 
 func (s *Service) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
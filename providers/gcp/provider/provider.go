@@ -8,6 +8,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
 
@@ -28,6 +29,9 @@ const (
 type Service struct {
 	runtimes         map[uint32]*plugin.Runtime
 	lastConnectionID uint32
+	// mutex guards runtimes and lastConnectionID, since assets are
+	// connected while others are still being queried
+	mutex sync.Mutex
 }
 
 func Init() *Service {
@@ -37,6 +41,20 @@ func Init() *Service {
 	}
 }
 
+func (s *Service) nextConnectionID() uint32 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lastConnectionID++
+	return s.lastConnectionID
+}
+
+func (s *Service) runtime(id uint32) (*plugin.Runtime, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	runtime, ok := s.runtimes[id]
+	return runtime, ok
+}
+
 // returns only the env vars that have a set value
 func readEnvs(envs ...string) []string {
 	vals := []string{}
@@ -211,6 +229,8 @@ func (s *Service) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 // It is not necessary to implement this method.
 // If you want to do some cleanup, you can do it here.
 func (s *Service) Shutdown(req *plugin.ShutdownReq) (*plugin.ShutdownRes, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 	for i := range s.runtimes {
 		runtime := s.runtimes[i]
 		// FIXME: I think, we might need the asset here to cleanup the correct connection
@@ -238,11 +258,9 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 		// A GcpSnapshotConnection is a wrapper around a FilesystemConnection
 		// To make sure the connection is later handled by the os provider, override the type
 		conf.Type = "filesystem"
-		s.lastConnectionID++
-		conn, err = gcpinstancesnapshot.NewGcpSnapshotConnection(s.lastConnectionID, conf, asset)
+		conn, err = gcpinstancesnapshot.NewGcpSnapshotConnection(s.nextConnectionID(), conf, asset)
 	default:
-		s.lastConnectionID++
-		conn, err = connection.NewGcpConnection(s.lastConnectionID, asset, conf)
+		conn, err = connection.NewGcpConnection(s.nextConnectionID(), asset, conf)
 	}
 
 	if err != nil {
@@ -258,13 +276,16 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	}
 
 	asset.Connections[0].Id = conn.ID()
-	s.runtimes[conn.ID()] = &plugin.Runtime{
+	runtime := &plugin.Runtime{
 		Connection:     conn,
 		Callback:       callback,
 		HasRecording:   req.HasRecording,
 		CreateResource: resources.CreateResource,
 		Upstream:       upstream,
 	}
+	s.mutex.Lock()
+	s.runtimes[conn.ID()] = runtime
+	s.mutex.Unlock()
 
 	return conn, err
 }
@@ -294,7 +315,7 @@ func (s *Service) discover(conn shared.GcpConnection) (*inventory.Inventory, err
 		return nil, nil
 	}
 
-	runtime, ok := s.runtimes[conn.ID()]
+	runtime, ok := s.runtime(conn.ID())
 	if !ok {
 		// no connection found, this should never happen
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(conn.ID()), 10) + " not found")
@@ -304,7 +325,7 @@ func (s *Service) discover(conn shared.GcpConnection) (*inventory.Inventory, err
 }
 
 func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
@@ -351,7 +372,7 @@ func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
 }
 
 func (s *Service) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"sync"
 
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
@@ -24,6 +25,9 @@ const ConnectionType = "k8s"
 type Service struct {
 	runtimes         map[uint32]*plugin.Runtime
 	lastConnectionID uint32
+	// mutex guards runtimes and lastConnectionID, since assets are
+	// connected while others are still being queried
+	mutex sync.Mutex
 }
 
 func Init() *Service {
@@ -33,6 +37,20 @@ func Init() *Service {
 	}
 }
 
+func (s *Service) nextConnectionID() uint32 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lastConnectionID++
+	return s.lastConnectionID
+}
+
+func (s *Service) runtime(id uint32) (*plugin.Runtime, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	runtime, ok := s.runtimes[id]
+	return runtime, ok
+}
+
 func parseDiscover(flags map[string]*llx.Primitive) *inventory.Discovery {
 	var targets []string
 	if x, ok := flags["discover"]; ok && len(x.Array) != 0 {
@@ -142,26 +160,22 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	var err error
 
 	if manifestContent, ok := conf.Options[shared.OPTION_IMMEMORY_CONTENT]; ok {
-		s.lastConnectionID++
-		conn, err = manifest.NewConnection(s.lastConnectionID, asset, manifest.WithManifestContent([]byte(manifestContent)))
+		conn, err = manifest.NewConnection(s.nextConnectionID(), asset, manifest.WithManifestContent([]byte(manifestContent)))
 		if err != nil {
 			return nil, err
 		}
 	} else if manifestFile, ok := conf.Options[shared.OPTION_MANIFEST]; ok {
-		s.lastConnectionID++
-		conn, err = manifest.NewConnection(s.lastConnectionID, asset, manifest.WithManifestFile(manifestFile))
+		conn, err = manifest.NewConnection(s.nextConnectionID(), asset, manifest.WithManifestFile(manifestFile))
 		if err != nil {
 			return nil, err
 		}
 	} else if data, ok := conf.Options[shared.OPTION_ADMISSION]; ok {
-		s.lastConnectionID++
-		conn, err = admission.NewConnection(s.lastConnectionID, asset, data)
+		conn, err = admission.NewConnection(s.nextConnectionID(), asset, data)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		s.lastConnectionID++
-		conn, err = api.NewConnection(s.lastConnectionID, asset)
+		conn, err = api.NewConnection(s.nextConnectionID(), asset)
 		if err != nil {
 			return nil, err
 		}
@@ -176,13 +190,16 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	}
 
 	asset.Connections[0].Id = conn.ID()
-	s.runtimes[conn.ID()] = &plugin.Runtime{
+	runtime := &plugin.Runtime{
 		Connection:     conn,
 		Callback:       callback,
 		HasRecording:   req.HasRecording,
 		CreateResource: resources.CreateResource,
 		Upstream:       upstream,
 	}
+	s.mutex.Lock()
+	s.runtimes[conn.ID()] = runtime
+	s.mutex.Unlock()
 
 	return conn, err
 }
@@ -192,7 +209,7 @@ func (s *Service) discover(conn shared.Connection) (*inventory.Inventory, error)
 		return nil, nil
 	}
 
-	runtime, ok := s.runtimes[conn.ID()]
+	runtime, ok := s.runtime(conn.ID())
 	if !ok {
 		// no connection found, this should never happen
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(conn.ID()), 10) + " not found")
@@ -202,7 +219,7 @@ func (s *Service) discover(conn shared.Connection) (*inventory.Inventory, error)
 }
 
 func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
@@ -249,7 +266,7 @@ func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
 }
 
 func (s *Service) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
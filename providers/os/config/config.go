@@ -15,6 +15,7 @@ var Config = plugin.Provider{
 	ConnectionTypes: []string{
 		provider.LocalConnectionType,
 		provider.SshConnectionType,
+		provider.WinrmConnectionType,
 		provider.MockConnectionType,
 		provider.TarConnectionType,
 		provider.DockerSnapshotConnectionType,
@@ -128,11 +129,17 @@ var Config = plugin.Provider{
 					Long:        "password",
 					Short:       "p",
 					Default:     "false",
-					Desc:        "Set the connection password for SSH.",
+					Desc:        "Set the connection password for WinRM.",
 					Type:        plugin.FlagType_String,
 					Option:      plugin.FlagOption_Password,
 					ConfigEntry: "-",
 				},
+				{
+					Long:    "auth",
+					Default: "ntlm",
+					Desc:    "Authentication mechanism for WinRM, either ntlm or basic.",
+					Type:    plugin.FlagType_String,
+				},
 				{
 					Long:    "id-detector",
 					Type:    plugin.FlagType_String,
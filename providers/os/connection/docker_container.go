@@ -126,6 +126,11 @@ func (c *DockerContainerConnection) Capabilities() shared.Capabilities {
 	return shared.Capability_File | shared.Capability_RunCommand
 }
 
+// RunsFileCommands is true since the filesystem has no raw stat data
+func (c *DockerContainerConnection) RunsFileCommands() bool {
+	return true
+}
+
 func (c *DockerContainerConnection) FileInfo(path string) (shared.FileInfoDetails, error) {
 	fs := c.FileSystem()
 	afs := &afero.Afero{Fs: fs}
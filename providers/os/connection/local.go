@@ -5,6 +5,7 @@ package connection
 
 import (
 	"bytes"
+	"context"
 	"os/exec"
 	"runtime"
 	"strings"
@@ -29,6 +30,8 @@ type LocalConnection struct {
 	runtime string
 	id      uint32
 	asset   *inventory.Asset
+
+	commandContext shared.CommandContext
 }
 
 func NewLocalConnection(id uint32, conf *inventory.Config, asset *inventory.Asset) *LocalConnection {
@@ -80,10 +83,21 @@ func (p *LocalConnection) RunCommand(command string) (*shared.Command, error) {
 	c := &CommandRunner{Shell: p.shell}
 	args := []string{}
 
+	// sudo may prompt on the terminal and its children can't be killed by us
+	if p.commandContext != nil && (p.Sudo == nil || !p.Sudo.Active) {
+		ctx, cancel := p.commandContext()
+		defer cancel()
+		c.Context = ctx
+	}
+
 	res, err := c.Exec(command, args)
 	return res, err
 }
 
+func (p *LocalConnection) SetCommandContext(fn shared.CommandContext) {
+	p.commandContext = fn
+}
+
 func (p *LocalConnection) FileSystem() afero.Fs {
 	if p.fs != nil {
 		return p.fs
@@ -125,6 +139,8 @@ type CommandRunner struct {
 	shared.Command
 	cmdExecutor *exec.Cmd
 	Shell       []string
+	// Context kills the command once it is done, if set
+	Context context.Context
 }
 
 func (c *CommandRunner) Exec(usercmd string, args []string) (*shared.Command, error) {
@@ -145,7 +161,12 @@ func (c *CommandRunner) Exec(usercmd string, args []string) (*shared.Command, er
 
 	// this only stores the user command, not the shell
 	c.Command.Command = usercmd + " " + strings.Join(args, " ")
-	c.cmdExecutor = exec.Command(cmd, cmdArgs...)
+	if c.Context != nil {
+		c.cmdExecutor = exec.CommandContext(c.Context, cmd, cmdArgs...)
+		killProcessGroup(c.cmdExecutor)
+	} else {
+		c.cmdExecutor = exec.Command(cmd, cmdArgs...)
+	}
 
 	var stdoutBuffer bytes.Buffer
 	var stderrBuffer bytes.Buffer
@@ -165,6 +186,11 @@ func (c *CommandRunner) Exec(usercmd string, args []string) (*shared.Command, er
 		return &c.Command, nil
 	}
 
+	// the command was killed, its exit code is meaningless
+	if c.Context != nil && c.Context.Err() != nil {
+		return &c.Command, c.Context.Err()
+	}
+
 	// if the program failed, we do not return err but its exit code
 	if exiterr, ok := err.(*exec.ExitError); ok {
 		if status, ok := exiterr.Sys().(syscall.WaitStatus); ok {
@@ -8,6 +8,7 @@ package connection
 
 import (
 	"os"
+	"os/exec"
 	"syscall"
 
 	"go.mondoo.com/cnquery/providers/os/connection/shared"
@@ -26,3 +27,12 @@ func (c *LocalConnection) fileowner(stat os.FileInfo) (int64, int64) {
 	}
 	return uid, gid
 }
+
+// killProcessGroup makes sure canceled commands don't leave children behind,
+// which would otherwise keep the output open until they are done
+func killProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
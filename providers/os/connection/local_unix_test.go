@@ -0,0 +1,39 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux || darwin || netbsd || openbsd || freebsd
+
+package connection_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/connection"
+)
+
+func TestLocalCommandCanceled(t *testing.T) {
+	conn := connection.NewLocalConnection(0, &inventory.Config{}, &inventory.Asset{})
+	runtime := &plugin.Runtime{Connection: conn}
+	conn.SetCommandContext(runtime.CallContext)
+
+	// commands outside of calls are never canceled
+	cmd, err := conn.RunCommand("echo hi")
+	require.NoError(t, err)
+	assert.Equal(t, 0, cmd.ExitStatus)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	done := runtime.StartCall(ctx)
+	defer done()
+
+	start := time.Now()
+	_, err = conn.RunCommand("sleep 10")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
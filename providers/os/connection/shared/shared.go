@@ -4,6 +4,7 @@
 package shared
 
 import (
+	"context"
 	"io"
 	"io/fs"
 	"os"
@@ -71,6 +72,23 @@ func (c Capabilities) String() []string {
 	return res
 }
 
+// CommandContext returns the context for running a command, which is
+// canceled once nobody is waiting for the command anymore
+type CommandContext func() (context.Context, context.CancelFunc)
+
+// CommandCanceler is implemented by connections that can stop running
+// commands, e.g. when the query that needs them timed out
+type CommandCanceler interface {
+	SetCommandContext(fn CommandContext)
+}
+
+// FileCommandRunner is implemented by connections that don't have access to
+// the raw stat data of files, so file details are read by running commands
+// on the target instead
+type FileCommandRunner interface {
+	RunsFileCommands() bool
+}
+
 type FileSearch interface {
 	Find(from string, r *regexp.Regexp, typ string) ([]string, error)
 }
@@ -52,6 +52,8 @@ type SshConnection struct {
 	UseScpFilesystem bool
 	HostKey          ssh.PublicKey
 	SSHClient        *ssh.Client
+
+	commandContext shared.CommandContext
 }
 
 func NewSshConnection(id uint32, conf *inventory.Config, asset *inventory.Asset) (*SshConnection, error) {
@@ -134,6 +136,11 @@ func (p *SshConnection) Capabilities() shared.Capabilities {
 	return shared.Capability_File | shared.Capability_RunCommand
 }
 
+// RunsFileCommands is true since the filesystem has no raw stat data
+func (p *SshConnection) RunsFileCommands() bool {
+	return true
+}
+
 func (c *SshConnection) RunCommand(command string) (*shared.Command, error) {
 	if c.Sudo != nil && c.Sudo.Active {
 		command = shared.BuildSudoCommand(c.Sudo, command)
@@ -179,7 +186,7 @@ func (c *SshConnection) runRawCommand(command string) (*shared.Command, error) {
 	// start ssh call
 	session.Stdout = res.Stdout
 	session.Stderr = res.Stderr
-	err = session.Run(res.Command)
+	err = c.runSession(session, res.Command)
 	if err == nil {
 		return &res, nil
 	}
@@ -196,6 +203,41 @@ func (c *SshConnection) runRawCommand(command string) (*shared.Command, error) {
 	return &res, err
 }
 
+func (c *SshConnection) SetCommandContext(fn shared.CommandContext) {
+	c.commandContext = fn
+}
+
+// runSession runs the command and kills it on the remote side once its
+// context is canceled
+func (c *SshConnection) runSession(session *ssh.Session, command string) error {
+	if c.commandContext == nil {
+		return session.Run(command)
+	}
+
+	ctx, cancel := c.commandContext()
+	defer cancel()
+
+	if err := session.Start(command); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		// not all servers support signals, closing the session ends it anyway
+		session.Signal(ssh.SIGKILL)
+		session.Close()
+		<-done
+		return ctx.Err()
+	}
+}
+
 func (c *SshConnection) FileSystem() afero.Fs {
 	if c.fs != nil {
 		return c.fs
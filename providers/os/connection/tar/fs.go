@@ -99,6 +99,27 @@ func (fs *FS) Stat(name string) (os.FileInfo, error) {
 	return fs.stat(h)
 }
 
+// LstatIfPossible returns the file info without resolving symlinks
+func (fs *FS) LstatIfPossible(name string) (os.FileInfo, bool, error) {
+	h, ok := fs.FileMap[name]
+	if !ok {
+		return nil, true, os.ErrNotExist
+	}
+	return h.FileInfo(), true, nil
+}
+
+// ReadlinkIfPossible returns the target of a symlink as stored in the tar
+func (fs *FS) ReadlinkIfPossible(name string) (string, error) {
+	h, ok := fs.FileMap[name]
+	if !ok {
+		return "", os.ErrNotExist
+	}
+	if h.Typeflag != tar.TypeSymlink {
+		return "", errors.New("not a symlink: " + name)
+	}
+	return h.Linkname, nil
+}
+
 func (fs *FS) Chmod(name string, mode os.FileMode) error {
 	return errors.New("chmod not implemented")
 }
@@ -0,0 +1,188 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/masterzen/winrm"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/os/connection/winrm/cat"
+)
+
+const (
+	WinRM shared.ConnectionType = "winrm"
+
+	// WinrmAuthOption selects the authentication mechanism, either ntlm (default) or basic
+	WinrmAuthOption = "winrm_auth"
+)
+
+type WinrmConnection struct {
+	id    uint32
+	conf  *inventory.Config
+	asset *inventory.Asset
+	fs    afero.Fs
+
+	Endpoint *winrm.Endpoint
+	Client   *winrm.Client
+}
+
+func NewWinrmConnection(id uint32, conf *inventory.Config, asset *inventory.Asset) (*WinrmConnection, error) {
+	endpoint := winrmEndpoint(conf)
+
+	// copy the default parameters, they are shared across all clients
+	params := *winrm.DefaultParameters
+	switch conf.Options[WinrmAuthOption] {
+	case "", "ntlm":
+		params.TransportDecorator = func() winrm.Transporter { return &winrm.ClientNTLM{} }
+	case "basic":
+		log.Debug().Msg("winrm> use basic authentication")
+	default:
+		return nil, errors.New("unsupported winrm authentication: " + conf.Options[WinrmAuthOption])
+	}
+
+	// search for password secret
+	c, err := vault.GetPassword(conf.Credentials)
+	if err != nil {
+		return nil, errors.New("missing password for winrm transport")
+	}
+
+	client, err := winrm.NewClientWithParameters(endpoint, c.User, string(c.Secret), &params)
+	if err != nil {
+		return nil, err
+	}
+
+	// test connection
+	log.Debug().Str("user", c.User).Str("host", conf.Host).Msg("winrm> connecting to remote shell via WinRM")
+	shell, err := client.CreateShell()
+	if err != nil {
+		return nil, err
+	}
+
+	err = shell.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug().Msg("winrm> connection established")
+	return &WinrmConnection{
+		id:       id,
+		conf:     conf,
+		asset:    asset,
+		Endpoint: endpoint,
+		Client:   client,
+	}, nil
+}
+
+func winrmEndpoint(conf *inventory.Config) *winrm.Endpoint {
+	endpoint := &winrm.Endpoint{
+		Host:     conf.Host,
+		Port:     int(conf.Port),
+		Insecure: conf.Insecure,
+		HTTPS:    true,
+		Timeout:  time.Duration(0),
+	}
+
+	// use default port if port is 0
+	if endpoint.Port <= 0 {
+		endpoint.Port = 5986
+	}
+
+	if endpoint.Port == 5985 {
+		log.Warn().Msg("winrm port 5985 is using http communication instead of https, passwords are not encrypted")
+		endpoint.HTTPS = false
+	}
+
+	if os.Getenv("WINRM_DISABLE_HTTPS") == "true" {
+		log.Warn().Msg("WINRM_DISABLE_HTTPS is set, winrm is using http communication instead of https, passwords are not encrypted")
+		endpoint.HTTPS = false
+	}
+
+	return endpoint
+}
+
+func (c *WinrmConnection) ID() uint32 {
+	return c.id
+}
+
+func (c *WinrmConnection) Name() string {
+	return "winrm"
+}
+
+func (c *WinrmConnection) Type() shared.ConnectionType {
+	return WinRM
+}
+
+func (c *WinrmConnection) Asset() *inventory.Asset {
+	return c.asset
+}
+
+func (c *WinrmConnection) Capabilities() shared.Capabilities {
+	return shared.Capability_File | shared.Capability_RunCommand
+}
+
+func (c *WinrmConnection) RunCommand(command string) (*shared.Command, error) {
+	log.Debug().Str("command", command).Str("provider", "winrm").Msg("winrm> run command")
+
+	res := &shared.Command{
+		Command: command,
+		Stats: shared.PerfStats{
+			Start: time.Now(),
+		},
+		Stdout: &bytes.Buffer{},
+		Stderr: &bytes.Buffer{},
+	}
+	defer func() {
+		res.Stats.Duration = time.Since(res.Stats.Start)
+	}()
+
+	// Note: winrm does not return err of the command was executed with a non-zero exit code
+	exitCode, err := c.Client.RunWithContext(context.Background(), command, res.Stdout, res.Stderr)
+	if err != nil {
+		log.Error().Err(err).Str("command", command).Msg("could not execute winrm command")
+		return res, err
+	}
+
+	res.ExitStatus = exitCode
+	return res, nil
+}
+
+func (c *WinrmConnection) FileInfo(path string) (shared.FileInfoDetails, error) {
+	fs := c.FileSystem()
+	afs := &afero.Afero{Fs: fs}
+	stat, err := afs.Stat(path)
+	if err != nil {
+		return shared.FileInfoDetails{}, err
+	}
+
+	uid := int64(-1)
+	gid := int64(-1)
+	mode := stat.Mode()
+
+	return shared.FileInfoDetails{
+		Mode: shared.FileModeDetails{FileMode: mode},
+		Size: stat.Size(),
+		Uid:  uid,
+		Gid:  gid,
+	}, nil
+}
+
+func (c *WinrmConnection) FileSystem() afero.Fs {
+	if c.fs == nil {
+		c.fs = cat.New(c)
+	}
+	return c.fs
+}
+
+func (c *WinrmConnection) Close() {
+	// nothing to do yet
+}
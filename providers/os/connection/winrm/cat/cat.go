@@ -0,0 +1,127 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cat
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/os/resources/powershell"
+)
+
+type CommandRunner interface {
+	RunCommand(command string) (*shared.Command, error)
+}
+
+func New(cmdRunner CommandRunner) *Fs {
+	return &Fs{
+		commandRunner: cmdRunner,
+	}
+}
+
+// Fs is a read-only file system that retrieves files and their
+// metadata via PowerShell commands
+type Fs struct {
+	commandRunner CommandRunner
+}
+
+func (cat *Fs) Name() string {
+	return "Winrm Cat FS"
+}
+
+// escapePath escapes single quotes for use in a single-quoted PowerShell string
+func escapePath(name string) string {
+	return strings.ReplaceAll(name, "'", "''")
+}
+
+func (cat *Fs) Open(name string) (afero.File, error) {
+	// NOTE: do not use type here since it does not work well with file names like 'C:\Program Files\New Text Document.txt'
+	cmd, err := cat.commandRunner.RunCommand(powershell.Wrap(fmt.Sprintf("Get-Content '%s'", escapePath(name))))
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.ExitStatus != 0 {
+		return nil, os.ErrNotExist
+	}
+
+	data, err := io.ReadAll(cmd.Stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFile(name, bytes.NewBuffer(data)), nil
+}
+
+func (cat *Fs) Stat(name string) (os.FileInfo, error) {
+	cmd, err := cat.commandRunner.RunCommand(powershell.Wrap(fmt.Sprintf("Get-Item -LiteralPath '%s' | ConvertTo-JSON", escapePath(name))))
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.ExitStatus != 0 {
+		return nil, os.ErrNotExist
+	}
+
+	item, err := ParseGetItem(cmd.Stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileStat{
+		name:           item.Name,
+		FileSize:       item.Length,
+		FileAttributes: item.Attributes,
+		CreationTime:   powershell.PSJsonTimestamp(item.CreationTime),
+		LastAccessTime: powershell.PSJsonTimestamp(item.LastAccessTime),
+		LastWriteTime:  powershell.PSJsonTimestamp(item.LastWriteTime),
+	}, nil
+}
+
+func (cat *Fs) Create(name string) (afero.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (cat *Fs) Mkdir(name string, perm os.FileMode) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) MkdirAll(path string, perm os.FileMode) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (cat *Fs) Remove(name string) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) RemoveAll(path string) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) Rename(oldname, newname string) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) Chmod(name string, mode os.FileMode) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) Chown(name string, uid, gid int) error {
+	return errors.New("not implemented")
+}
@@ -0,0 +1,196 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connection
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+)
+
+const winrmEnvelope = `<s:Envelope xml:lang="en-US" xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:a="http://schemas.xmlsoap.org/ws/2004/08/addressing" xmlns:x="http://schemas.xmlsoap.org/ws/2004/09/transfer" xmlns:w="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd" xmlns:rsp="http://schemas.microsoft.com/wbem/wsman/1/windows/shell"><s:Header><a:Action>%s</a:Action></s:Header><s:Body>%s</s:Body></s:Envelope>`
+
+var (
+	winrmAction  = regexp.MustCompile(`<\w+:Action[^>]*>([^<]+)</\w+:Action>`)
+	winrmCommand = regexp.MustCompile(`(?s)<!\[CDATA\[(.*?)\]\]>`)
+)
+
+type winrmResult struct {
+	stdout   string
+	exitCode int
+}
+
+// winrmStandIn is a minimal WS-Management endpoint that answers shell
+// requests with canned command results
+type winrmStandIn struct {
+	user     string
+	password string
+	commands map[string]winrmResult
+
+	mutex   sync.Mutex
+	current string
+}
+
+func (s *winrmStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, password, ok := r.BasicAuth()
+	if !ok || user != s.user || password != s.password {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	m := winrmAction.FindSubmatch(body)
+	if m == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	action := string(m[1])
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/soap+xml;charset=UTF-8")
+	switch action {
+	case "http://schemas.xmlsoap.org/ws/2004/09/transfer/Create":
+		fmt.Fprintf(w, winrmEnvelope, "http://schemas.xmlsoap.org/ws/2004/09/transfer/CreateResponse",
+			`<rsp:Shell><rsp:ShellId>SHELL-1</rsp:ShellId></rsp:Shell>`)
+	case "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/Command":
+		cmd := winrmCommand.FindSubmatch(body)
+		if cmd == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.current = string(cmd[1])
+		fmt.Fprintf(w, winrmEnvelope, "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/CommandResponse",
+			`<rsp:CommandResponse><rsp:CommandId>CMD-1</rsp:CommandId></rsp:CommandResponse>`)
+	case "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/Receive":
+		res, ok := s.commands[s.current]
+		if !ok {
+			res = winrmResult{exitCode: 1}
+		}
+		fmt.Fprintf(w, winrmEnvelope, "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/ReceiveResponse",
+			`<rsp:ReceiveResponse><rsp:Stream Name="stdout" CommandId="CMD-1">`+base64.StdEncoding.EncodeToString([]byte(res.stdout))+`</rsp:Stream>`+
+				`<rsp:CommandState CommandId="CMD-1" State="http://schemas.microsoft.com/wbem/wsman/1/windows/shell/CommandState/Done"><rsp:ExitCode>`+strconv.Itoa(res.exitCode)+`</rsp:ExitCode></rsp:CommandState></rsp:ReceiveResponse>`)
+	case "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/Signal":
+		fmt.Fprintf(w, winrmEnvelope, "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/SignalResponse", `<rsp:SignalResponse/>`)
+	case "http://schemas.xmlsoap.org/ws/2004/09/transfer/Delete":
+		fmt.Fprintf(w, winrmEnvelope, "http://schemas.xmlsoap.org/ws/2004/09/transfer/DeleteResponse", "")
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func newWinrmTestConnection(t *testing.T, standIn *winrmStandIn) *WinrmConnection {
+	t.Setenv("WINRM_DISABLE_HTTPS", "true")
+
+	srv := httptest.NewServer(standIn)
+	t.Cleanup(srv.Close)
+
+	host, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	conf := &inventory.Config{
+		Type:        "winrm",
+		Host:        host,
+		Port:        int32(portNum),
+		Options:     map[string]string{WinrmAuthOption: "basic"},
+		Credentials: []*vault.Credential{vault.NewPasswordCredential("administrator", "secret")},
+	}
+	conn, err := NewWinrmConnection(1, conf, &inventory.Asset{Connections: []*inventory.Config{conf}})
+	require.NoError(t, err)
+	return conn
+}
+
+func TestWinrmConnection(t *testing.T) {
+	standIn := &winrmStandIn{
+		user:     "administrator",
+		password: "secret",
+		commands: map[string]winrmResult{
+			"hostname": {stdout: "WIN-SERVER\r\n"},
+			"exit 3":   {exitCode: 3},
+			"powershell -c \"Get-Content 'C:\\test.txt'\"": {stdout: "hi\n"},
+		},
+	}
+	conn := newWinrmTestConnection(t, standIn)
+
+	assert.Equal(t, WinRM, conn.Type())
+	assert.Equal(t, "winrm", conn.Name())
+	assert.False(t, conn.Endpoint.HTTPS)
+
+	t.Run("run command", func(t *testing.T) {
+		cmd, err := conn.RunCommand("hostname")
+		require.NoError(t, err)
+		assert.Equal(t, 0, cmd.ExitStatus)
+		data, err := io.ReadAll(cmd.Stdout)
+		require.NoError(t, err)
+		assert.Equal(t, "WIN-SERVER\r\n", string(data))
+	})
+
+	t.Run("run failing command", func(t *testing.T) {
+		cmd, err := conn.RunCommand("exit 3")
+		require.NoError(t, err)
+		assert.Equal(t, 3, cmd.ExitStatus)
+	})
+
+	t.Run("read file", func(t *testing.T) {
+		f, err := conn.FileSystem().Open("C:\\test.txt")
+		require.NoError(t, err)
+		data, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, "hi\n", string(data))
+
+		_, err = conn.FileSystem().Open("C:\\missing.txt")
+		assert.Error(t, err)
+	})
+}
+
+func TestWinrmConnectionAuthFailure(t *testing.T) {
+	t.Setenv("WINRM_DISABLE_HTTPS", "true")
+
+	srv := httptest.NewServer(&winrmStandIn{user: "administrator", password: "other"})
+	defer srv.Close()
+
+	host, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	conf := &inventory.Config{
+		Type:        "winrm",
+		Host:        host,
+		Port:        int32(portNum),
+		Options:     map[string]string{WinrmAuthOption: "basic"},
+		Credentials: []*vault.Credential{vault.NewPasswordCredential("administrator", "secret")},
+	}
+	_, err = NewWinrmConnection(1, conf, &inventory.Asset{})
+	assert.Error(t, err)
+}
+
+func TestWinrmEndpoint(t *testing.T) {
+	ep := winrmEndpoint(&inventory.Config{Host: "10.0.0.1"})
+	assert.Equal(t, 5986, ep.Port)
+	assert.True(t, ep.HTTPS)
+
+	ep = winrmEndpoint(&inventory.Config{Host: "10.0.0.1", Port: 5985})
+	assert.False(t, ep.HTTPS)
+}
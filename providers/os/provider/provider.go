@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
@@ -25,6 +26,7 @@ import (
 const (
 	LocalConnectionType             = "local"
 	SshConnectionType               = "ssh"
+	WinrmConnectionType             = "winrm"
 	MockConnectionType              = "mock"
 	TarConnectionType               = "tar"
 	DockerSnapshotConnectionType    = "docker-snapshot"
@@ -40,6 +42,9 @@ const (
 type Service struct {
 	runtimes         map[uint32]*plugin.Runtime
 	lastConnectionID uint32
+	// mutex guards runtimes and lastConnectionID, since assets are
+	// connected while others are still being queried
+	mutex sync.Mutex
 }
 
 func Init() *Service {
@@ -49,6 +54,20 @@ func Init() *Service {
 	}
 }
 
+func (s *Service) nextConnectionID() uint32 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lastConnectionID++
+	return s.lastConnectionID
+}
+
+func (s *Service) runtime(id uint32) (*plugin.Runtime, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	runtime, ok := s.runtimes[id]
+	return runtime, ok
+}
+
 func parseDiscover(flags map[string]*llx.Primitive) *inventory.Discovery {
 	// TODO: parse me...
 	return &inventory.Discovery{Targets: []string{"auto"}}
@@ -76,7 +95,8 @@ func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error)
 		port = 22
 	case "winrm":
 		conf.Type = "winrm"
-		port = 5985
+		// winrm uses HTTPS by default, users opt out of it with port 5985
+		port = 5986
 	case "vagrant":
 		conf.Type = "vagrant"
 	case "container", "docker":
@@ -128,6 +148,19 @@ func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error)
 		conf.Port = int32(port)
 	}
 
+	if x, ok := flags["insecure"]; ok {
+		if insecure, ok := x.RawData().Value.(bool); ok {
+			conf.Insecure = insecure
+		}
+	}
+
+	if x, ok := flags["auth"]; ok && len(x.Value) != 0 && conf.Type == WinrmConnectionType {
+		if conf.Options == nil {
+			conf.Options = map[string]string{}
+		}
+		conf.Options[connection.WinrmAuthOption] = string(x.Value)
+	}
+
 	if x, ok := flags["password"]; ok && len(x.Value) != 0 {
 		conf.Credentials = append(conf.Credentials, vault.NewPasswordCredential(user, string(x.Value)))
 	}
@@ -219,6 +252,8 @@ func (s *Service) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 // It is not necessary to implement this method.
 // If you want to do some cleanup, you can do it here.
 func (s *Service) Shutdown(req *plugin.ShutdownReq) (*plugin.ShutdownRes, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 	for i := range s.runtimes {
 		runtime := s.runtimes[i]
 		if x, ok := runtime.Connection.(*connection.TarConnection); ok {
@@ -240,28 +275,25 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 
 	switch conf.Type {
 	case LocalConnectionType:
-		s.lastConnectionID++
-		conn = connection.NewLocalConnection(s.lastConnectionID, conf, asset)
+		conn = connection.NewLocalConnection(s.nextConnectionID(), conf, asset)
 
 	case SshConnectionType:
-		s.lastConnectionID++
-		conn, err = connection.NewSshConnection(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewSshConnection(s.nextConnectionID(), conf, asset)
+
+	case WinrmConnectionType:
+		conn, err = connection.NewWinrmConnection(s.nextConnectionID(), conf, asset)
 
 	case MockConnectionType:
-		s.lastConnectionID++
 		conn, err = mock.New("", asset)
 
 	case TarConnectionType:
-		s.lastConnectionID++
-		conn, err = connection.NewTarConnection(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewTarConnection(s.nextConnectionID(), conf, asset)
 
 	case DockerSnapshotConnectionType:
-		s.lastConnectionID++
-		conn, err = connection.NewDockerSnapshotConnection(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewDockerSnapshotConnection(s.nextConnectionID(), conf, asset)
 
 	case VagrantConnectionType:
-		s.lastConnectionID++
-		conn, err = connection.NewVagrantConnection(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewVagrantConnection(s.nextConnectionID(), conf, asset)
 		if err != nil {
 			return nil, err
 		}
@@ -273,24 +305,19 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 		}
 
 	case DockerImageConnectionType:
-		s.lastConnectionID++
-		conn, err = connection.NewDockerContainerImageConnection(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewDockerContainerImageConnection(s.nextConnectionID(), conf, asset)
 
 	case DockerContainerConnectionType:
-		s.lastConnectionID++
-		conn, err = connection.NewDockerEngineContainer(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewDockerEngineContainer(s.nextConnectionID(), conf, asset)
 
 	case DockerRegistryConnectionType, ContainerRegistryConnectionType:
-		s.lastConnectionID++
-		conn, err = connection.NewContainerRegistryImage(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewContainerRegistryImage(s.nextConnectionID(), conf, asset)
 
 	case RegistryImageConnectionType:
-		s.lastConnectionID++
-		conn, err = connection.NewContainerRegistryImage(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewContainerRegistryImage(s.nextConnectionID(), conf, asset)
 
 	case FilesystemConnectionType:
-		s.lastConnectionID++
-		conn, err = connection.NewFileSystemConnection(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewFileSystemConnection(s.nextConnectionID(), conf, asset)
 
 	default:
 		return nil, errors.New("cannot find connection type " + conf.Type)
@@ -311,23 +338,38 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	conf.Id = conn.ID()
 	conf.Capabilities = conn.Capabilities().String()
 
-	s.runtimes[conn.ID()] = &plugin.Runtime{
+	runtime := &plugin.Runtime{
 		Connection:     conn,
 		Callback:       callback,
 		HasRecording:   req.HasRecording,
 		CreateResource: resources.CreateResource,
 		Upstream:       upstream,
 	}
+	s.mutex.Lock()
+	s.runtimes[conn.ID()] = runtime
+	s.mutex.Unlock()
+
+	// commands are killed once no query is waiting for them anymore
+	if x, ok := conn.(shared.CommandCanceler); ok {
+		x.SetCommandContext(runtime.CallContext)
+	}
 
 	return conn, err
 }
 
 func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	return s.GetDataWithContext(context.Background(), req)
+}
+
+func (s *Service) GetDataWithContext(ctx context.Context, req *plugin.DataReq) (*plugin.DataRes, error) {
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
 
+	done := runtime.StartCall(ctx)
+	defer done()
+
 	args := plugin.PrimitiveArgsToRawDataArgs(req.Args, runtime)
 
 	if req.ResourceId == "" && req.Field == "" {
@@ -370,7 +412,7 @@ func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
 }
 
 func (s *Service) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
+	runtime, ok := s.runtime(req.Connection)
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
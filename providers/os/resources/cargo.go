@@ -0,0 +1,211 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"bytes"
+	"compress/zlib"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/json"
+	"errors"
+	"io"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+)
+
+func initCargoPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		_, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("Wrong type for 'path' in cargo.packages initialization, it must be a string")
+		}
+	} else {
+		// empty path means search through default locations
+		args["path"] = llx.StringData("")
+	}
+
+	return args, nil, nil
+}
+
+func (x *mqlCargoPackages) id() (string, error) {
+	return "cargo.packages/" + x.Path.Data, nil
+}
+
+func (x *mqlCargoPackage) id() (string, error) {
+	return x.Id.Data, nil
+}
+
+type cargoPackageDetails struct {
+	Name    string `toml:"name" json:"name"`
+	Version string `toml:"version" json:"version"`
+	Source  string `toml:"source" json:"source"`
+	// only set for binaries, where build dependencies are not compiled in
+	Kind string `toml:"-" json:"kind"`
+}
+
+func (x *mqlCargoPackages) list() ([]interface{}, error) {
+	conn, ok := x.MqlRuntime.Connection.(shared.Connection)
+	if !ok {
+		return nil, errors.New("provider is not an operating system provider")
+	}
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	// Cargo.lock files only exist in projects, so we only look for
+	// binaries in the default locations
+	dirs := binaryDirectories
+	if x.Path.Data != "" {
+		dirs = []string{x.Path.Data}
+	}
+
+	res := []interface{}{}
+	seen := map[string]struct{}{}
+	for _, dir := range dirs {
+		files, err := findPackageFiles(conn, dir, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range files {
+			if _, ok := seen[path]; ok {
+				continue
+			}
+			seen[path] = struct{}{}
+
+			var pkgs []cargoPackageDetails
+			if filepath.Base(path) == "Cargo.lock" {
+				pkgs, err = readCargoLock(afs, path)
+			} else {
+				pkgs, err = readCargoAuditableBinary(afs, path)
+			}
+			if err != nil {
+				log.Debug().Err(err).Str("path", path).Msg("mql[cargo.packages]> could not read packages")
+				continue
+			}
+			if len(pkgs) == 0 {
+				continue
+			}
+
+			f, err := CreateResource(x.MqlRuntime, "file", map[string]*llx.RawData{
+				"path": llx.StringData(path),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, pkg := range pkgs {
+				r, err := CreateResource(x.MqlRuntime, "cargo.package", map[string]*llx.RawData{
+					"id":      llx.StringData(path + "/" + pkg.Name + "@" + pkg.Version),
+					"name":    llx.StringData(pkg.Name),
+					"version": llx.StringData(pkg.Version),
+					"source":  llx.StringData(pkg.Source),
+					"file":    llx.ResourceData(f, f.MqlName()),
+				})
+				if err != nil {
+					return nil, err
+				}
+				res = append(res, r)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func readCargoLock(afs *afero.Afero, path string) ([]cargoPackageDetails, error) {
+	f, err := afs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseCargoLock(f)
+}
+
+// parseCargoLock parses the packages of a Cargo.lock file
+func parseCargoLock(r io.Reader) ([]cargoPackageDetails, error) {
+	var lock struct {
+		Packages []cargoPackageDetails `toml:"package"`
+	}
+	if _, err := toml.NewDecoder(r).Decode(&lock); err != nil {
+		return nil, err
+	}
+	return lock.Packages, nil
+}
+
+// readCargoAuditableBinary reads the dependencies cargo auditable embeds
+// into Rust binaries, see https://github.com/rust-secure-code/cargo-auditable
+func readCargoAuditableBinary(afs *afero.Afero, path string) ([]cargoPackageDetails, error) {
+	data, err := readExecutable(afs, path)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	section := cargoAuditableSection(bytes.NewReader(data))
+	if section == nil {
+		return nil, nil
+	}
+	return parseCargoAuditData(section)
+}
+
+// cargoAuditableSection returns the compressed dependency data of a
+// binary, or nil if it has none
+func cargoAuditableSection(r io.ReaderAt) []byte {
+	if f, err := elf.NewFile(r); err == nil {
+		defer f.Close()
+		if s := f.Section(".dep-v0"); s != nil {
+			data, _ := s.Data()
+			return data
+		}
+		return nil
+	}
+	if f, err := pe.NewFile(r); err == nil {
+		defer f.Close()
+		if s := f.Section(".dep-v0"); s != nil {
+			data, _ := s.Data()
+			return data
+		}
+		return nil
+	}
+	if f, err := macho.NewFile(r); err == nil {
+		defer f.Close()
+		if s := f.Section("__dep_v0"); s != nil {
+			data, _ := s.Data()
+			return data
+		}
+	}
+	return nil
+}
+
+// parseCargoAuditData decompresses and parses the dependency data of a
+// cargo auditable binary
+func parseCargoAuditData(data []byte) ([]cargoPackageDetails, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var audit struct {
+		Packages []cargoPackageDetails `json:"packages"`
+	}
+	if err := json.NewDecoder(io.LimitReader(r, maxPackageFileSize)).Decode(&audit); err != nil {
+		return nil, err
+	}
+
+	res := make([]cargoPackageDetails, 0, len(audit.Packages))
+	for _, pkg := range audit.Packages {
+		if pkg.Kind == "build" {
+			continue
+		}
+		res = append(res, pkg)
+	}
+	return res, nil
+}
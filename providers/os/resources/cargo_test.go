@@ -0,0 +1,58 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"bytes"
+	"compress/zlib"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCargoLock(t *testing.T) {
+	lock := `# This file is automatically @generated by Cargo.
+version = 3
+
+[[package]]
+name = "demo"
+version = "0.1.0"
+dependencies = [
+ "serde",
+]
+
+[[package]]
+name = "serde"
+version = "1.0.188"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "cf9e0fcba69a370eed61bcf2b728575f726b50b55cba78064753d708ddc7549e"
+`
+	res, err := parseCargoLock(strings.NewReader(lock))
+	require.NoError(t, err)
+	assert.Equal(t, []cargoPackageDetails{
+		{Name: "demo", Version: "0.1.0"},
+		{Name: "serde", Version: "1.0.188", Source: "registry+https://github.com/rust-lang/crates.io-index"},
+	}, res)
+}
+
+func TestParseCargoAuditData(t *testing.T) {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	_, err := w.Write([]byte(`{"packages":[
+		{"name":"demo","version":"0.1.0","source":"local","dependencies":[1],"root":true},
+		{"name":"serde","version":"1.0.188","source":"crates.io"},
+		{"name":"cc","version":"1.0.83","source":"crates.io","kind":"build"}
+	]}`))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	res, err := parseCargoAuditData(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, []cargoPackageDetails{
+		{Name: "demo", Version: "0.1.0", Source: "local"},
+		{Name: "serde", Version: "1.0.188", Source: "crates.io"},
+	}, res)
+}
@@ -0,0 +1,487 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"archive/tar"
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
+	"go.mondoo.com/cnquery/providers/os/connection"
+	"go.mondoo.com/cnquery/providers/os/connection/local/statutil"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/os/fsutil"
+)
+
+// fileDetails holds the metadata of a file that isn't part of its
+// FileInfo. Not all connections have all of it, e.g. tar has no inodes.
+type fileDetails struct {
+	mtime      time.Time
+	ctime      time.Time
+	inode      int64
+	isSymlink  bool
+	linkTarget string
+}
+
+type mqlFileInternal struct {
+	lock    sync.Mutex
+	details *fileDetails
+}
+
+// runsFileCommands returns true for connections where we don't have access
+// to the raw stat data of files, so we run commands on the target instead
+func runsFileCommands(conn shared.Connection) bool {
+	x, ok := conn.(shared.FileCommandRunner)
+	return ok && x.RunsFileCommands()
+}
+
+func (s *mqlFile) fileDetails() (*fileDetails, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.details != nil {
+		return s.details, nil
+	}
+
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	var res *fileDetails
+	var err error
+	if runsFileCommands(conn) {
+		res, err = statFileCommand(conn, s.Path.Data)
+	} else {
+		res, err = statFileSystem(conn.FileSystem(), s.Path.Data)
+	}
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, resources.NotFoundError{Resource: "file", ID: s.Path.Data}
+		}
+		return nil, err
+	}
+
+	s.details = res
+	return res, nil
+}
+
+// statFileSystem reads the details from the connection's filesystem,
+// without following symlinks
+func statFileSystem(fs afero.Fs, path string) (*fileDetails, error) {
+	var fi os.FileInfo
+	var err error
+	if lstater, ok := fs.(afero.Lstater); ok {
+		fi, _, err = lstater.LstatIfPossible(path)
+	} else {
+		fi, err = fs.Stat(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &fileDetails{
+		mtime:     fi.ModTime(),
+		isSymlink: fi.Mode()&os.ModeSymlink != 0,
+	}
+	if header, ok := fi.Sys().(*tar.Header); ok {
+		res.ctime = header.ChangeTime
+	} else {
+		sysFileDetails(fi, res)
+	}
+
+	if res.isSymlink {
+		if linkReader, ok := fs.(afero.LinkReader); ok {
+			res.linkTarget, err = linkReader.ReadlinkIfPossible(path)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+// statFileCommand runs stat on the target, GNU stat first and BSD stat if
+// that fails
+func statFileCommand(conn shared.Connection, path string) (*fileDetails, error) {
+	escaped := statutil.ShellEscape(path)
+	commands := []string{
+		"stat -c '%i:%Y:%Z:%F' " + escaped,
+		"stat -f '%i:%m:%c:%HT' " + escaped,
+	}
+
+	var res *fileDetails
+	for _, command := range commands {
+		cmd, err := conn.RunCommand(command)
+		if err != nil {
+			return nil, err
+		}
+		if cmd.ExitStatus != 0 {
+			continue
+		}
+		out, err := io.ReadAll(cmd.Stdout)
+		if err != nil {
+			return nil, err
+		}
+		res, err = parseStatDetails(string(out))
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+	if res == nil {
+		return nil, os.ErrNotExist
+	}
+
+	if res.isSymlink {
+		cmd, err := conn.RunCommand("readlink " + escaped)
+		if err != nil {
+			return nil, err
+		}
+		out, err := io.ReadAll(cmd.Stdout)
+		if err != nil {
+			return nil, err
+		}
+		res.linkTarget = strings.TrimSpace(string(out))
+	}
+	return res, nil
+}
+
+// parseStatDetails parses the inode, mtime, ctime and file type, e.g.
+// 1234:1690000000:1690000000:symbolic link
+func parseStatDetails(out string) (*fileDetails, error) {
+	fields := strings.SplitN(strings.TrimSpace(out), ":", 4)
+	if len(fields) != 4 {
+		return nil, errors.New("could not parse file stat: " + out)
+	}
+
+	inode, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, errors.New("could not parse file inode: " + fields[0])
+	}
+	mtime, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, errors.New("could not parse file mtime: " + fields[1])
+	}
+	ctime, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return nil, errors.New("could not parse file ctime: " + fields[2])
+	}
+
+	return &fileDetails{
+		inode:     inode,
+		mtime:     time.Unix(mtime, 0),
+		ctime:     time.Unix(ctime, 0),
+		isSymlink: strings.EqualFold(fields[3], "symbolic link"),
+	}, nil
+}
+
+func (s *mqlFile) mtime(path string) (*time.Time, error) {
+	details, err := s.fileDetails()
+	if err != nil {
+		return nil, err
+	}
+	if details.mtime.IsZero() {
+		return nil, nil
+	}
+	return MqlTime(details.mtime), nil
+}
+
+func (s *mqlFile) ctime(path string) (*time.Time, error) {
+	details, err := s.fileDetails()
+	if err != nil {
+		return nil, err
+	}
+	if details.ctime.IsZero() {
+		return nil, nil
+	}
+	return MqlTime(details.ctime), nil
+}
+
+func (s *mqlFile) inode(path string) (int64, error) {
+	details, err := s.fileDetails()
+	if err != nil {
+		return 0, err
+	}
+	return details.inode, nil
+}
+
+func (s *mqlFile) isSymlink(path string) (bool, error) {
+	details, err := s.fileDetails()
+	if err != nil {
+		return false, err
+	}
+	return details.isSymlink, nil
+}
+
+func (s *mqlFile) linkTarget(path string) (string, error) {
+	details, err := s.fileDetails()
+	if err != nil {
+		return "", err
+	}
+	return details.linkTarget, nil
+}
+
+func (s *mqlFile) sha256(path string, exists bool) (string, error) {
+	return s.hash(path, exists, "sha256sum", fsutil.Sha256)
+}
+
+func (s *mqlFile) md5(path string, exists bool) (string, error) {
+	return s.hash(path, exists, "md5sum", fsutil.Md5)
+}
+
+// hash computes the hash of a file on the target if we can, so we don't
+// have to transfer its content, and reads it from the filesystem otherwise
+func (s *mqlFile) hash(path string, exists bool, command string, hash func(afero.File) (string, error)) (string, error) {
+	if !exists {
+		return "", resources.NotFoundError{Resource: "file", ID: path}
+	}
+
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	if runsFileCommands(conn) {
+		cmd, err := conn.RunCommand(command + " " + statutil.ShellEscape(path))
+		if err == nil && cmd.ExitStatus == 0 {
+			out, err := io.ReadAll(cmd.Stdout)
+			if err == nil {
+				if sum, _, ok := strings.Cut(strings.TrimSpace(string(out)), " "); ok && sum != "" {
+					return sum, nil
+				}
+			}
+		}
+		log.Debug().Str("command", command).Str("path", path).Msg("mql[file]> could not hash file on target, reading it instead")
+	}
+
+	f, err := conn.FileSystem().Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return hash(f)
+}
+
+func (s *mqlFile) xattrs(path string) (map[string]interface{}, error) {
+	attrs, err := s.readXattrs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]interface{}, len(attrs))
+	for k, v := range attrs {
+		res[k] = xattrString(v)
+	}
+	return res, nil
+}
+
+func (s *mqlFile) capabilities(path string) ([]interface{}, error) {
+	attrs, err := s.readXattrs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	caps, err := parseFileCapabilities(attrs["security.capability"])
+	if err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(caps), nil
+}
+
+// readXattrs returns the raw extended attributes of a file
+func (s *mqlFile) readXattrs(path string) (map[string][]byte, error) {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	if runsFileCommands(conn) {
+		cmd, err := conn.RunCommand("getfattr -h -d -m - -e hex " + statutil.ShellEscape(path))
+		if err != nil {
+			return nil, err
+		}
+		if cmd.ExitStatus != 0 {
+			stderr, _ := io.ReadAll(cmd.Stderr)
+			return nil, errors.New("could not read extended attributes: " + strings.TrimSpace(string(stderr)))
+		}
+		return parseGetfattr(cmd.Stdout)
+	}
+
+	switch c := conn.(type) {
+	case *connection.LocalConnection:
+		return localXattrs(path)
+	case *connection.FileSystemConnection:
+		return localXattrs(filepath.Join(c.MountedDir, path))
+	}
+
+	// tar files keep extended attributes as PAX records
+	fs := conn.FileSystem()
+	lstater, ok := fs.(afero.Lstater)
+	if !ok {
+		return nil, errors.New("extended attributes are not supported on this connection")
+	}
+	fi, _, err := lstater.LstatIfPossible(path)
+	if err != nil {
+		return nil, err
+	}
+	header, ok := fi.Sys().(*tar.Header)
+	if !ok {
+		return nil, errors.New("extended attributes are not supported on this connection")
+	}
+
+	res := map[string][]byte{}
+	for k, v := range header.PAXRecords {
+		if name, ok := strings.CutPrefix(k, "SCHILY.xattr."); ok {
+			res[name] = []byte(v)
+		}
+	}
+	return res, nil
+}
+
+// parseGetfattr parses the hex-encoded output of getfattr -d -e hex
+func parseGetfattr(r io.Reader) (map[string][]byte, error) {
+	res := map[string][]byte{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, _ := strings.Cut(line, "=")
+		switch {
+		case strings.HasPrefix(value, "0x"):
+			data, err := hex.DecodeString(value[2:])
+			if err != nil {
+				return nil, errors.New("could not parse extended attribute " + name)
+			}
+			res[name] = data
+		default:
+			res[name] = []byte(strings.Trim(value, `"`))
+		}
+	}
+	return res, nil
+}
+
+// xattrString returns text values as they are and hex-encodes binary ones
+func xattrString(value []byte) string {
+	text := strings.TrimRight(string(value), "\x00")
+	if !utf8.ValidString(text) {
+		return "0x" + hex.EncodeToString(value)
+	}
+	for _, r := range text {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return "0x" + hex.EncodeToString(value)
+		}
+	}
+	return text
+}
+
+// capability names by their bit, see linux/capability.h
+var capabilityNames = []string{
+	"cap_chown", "cap_dac_override", "cap_dac_read_search", "cap_fowner",
+	"cap_fsetid", "cap_kill", "cap_setgid", "cap_setuid", "cap_setpcap",
+	"cap_linux_immutable", "cap_net_bind_service", "cap_net_broadcast",
+	"cap_net_admin", "cap_net_raw", "cap_ipc_lock", "cap_ipc_owner",
+	"cap_sys_module", "cap_sys_rawio", "cap_sys_chroot", "cap_sys_ptrace",
+	"cap_sys_pacct", "cap_sys_admin", "cap_sys_boot", "cap_sys_nice",
+	"cap_sys_resource", "cap_sys_time", "cap_sys_tty_config", "cap_mknod",
+	"cap_lease", "cap_audit_write", "cap_audit_control", "cap_setfcap",
+	"cap_mac_override", "cap_mac_admin", "cap_syslog", "cap_wake_alarm",
+	"cap_block_suspend", "cap_audit_read", "cap_perfmon", "cap_bpf",
+	"cap_checkpoint_restore",
+}
+
+const (
+	vfsCapRevisionMask = 0xff000000
+	vfsCapRevision1    = 0x01000000
+	vfsCapRevision2    = 0x02000000
+	vfsCapRevision3    = 0x03000000
+)
+
+// parseFileCapabilities returns the permitted and inheritable capabilities
+// stored in a security.capability attribute (struct vfs_ns_cap_data)
+func parseFileCapabilities(data []byte) ([]string, error) {
+	if len(data) == 0 {
+		return []string{}, nil
+	}
+	if len(data) < 4 {
+		return nil, errors.New("invalid security.capability attribute")
+	}
+
+	// every revision has pairs of permitted and inheritable 32bit masks
+	words := 0
+	magic := binary.LittleEndian.Uint32(data)
+	switch magic & vfsCapRevisionMask {
+	case vfsCapRevision1:
+		words = 1
+	case vfsCapRevision2, vfsCapRevision3:
+		words = 2
+	default:
+		return nil, errors.New("unsupported security.capability revision")
+	}
+	if len(data) < 4+words*8 {
+		return nil, errors.New("invalid security.capability attribute")
+	}
+
+	var mask uint64
+	for i := 0; i < words; i++ {
+		permitted := binary.LittleEndian.Uint32(data[4+i*8:])
+		inheritable := binary.LittleEndian.Uint32(data[8+i*8:])
+		mask |= uint64(permitted|inheritable) << (i * 32)
+	}
+	return capabilityNamesFromMask(mask), nil
+}
+
+// capabilityNamesFromMask returns the names of all capabilities in a mask,
+// e.g. the CapEff of a process
+func capabilityNamesFromMask(mask uint64) []string {
+	res := []string{}
+	for bit := 0; bit < 64; bit++ {
+		if mask&(1<<bit) == 0 {
+			continue
+		}
+		if bit < len(capabilityNames) {
+			res = append(res, capabilityNames[bit])
+		} else {
+			res = append(res, "cap_"+strconv.Itoa(bit))
+		}
+	}
+	return res
+}
+
+// mountPoint returns the mount point with the longest path that contains
+// the file
+func (s *mqlFile) mountPoint(path string) (*mqlMountPoint, error) {
+	raw, err := CreateResource(s.MqlRuntime, "mount", nil)
+	if err != nil {
+		return nil, err
+	}
+	mounts := raw.(*mqlMount).GetList()
+	if mounts.Error != nil {
+		return nil, mounts.Error
+	}
+
+	var res *mqlMountPoint
+	for i := range mounts.Data {
+		mount := mounts.Data[i].(*mqlMountPoint)
+		mountPath := mount.Path.Data
+		if mountPath != "/" && path != mountPath && !strings.HasPrefix(path, strings.TrimSuffix(mountPath, "/")+"/") {
+			continue
+		}
+		if res == nil || len(mountPath) > len(res.Path.Data) {
+			res = mount
+		}
+	}
+
+	if res == nil {
+		s.MountPoint.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+	return res, nil
+}
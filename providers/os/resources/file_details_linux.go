@@ -0,0 +1,61 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package resources
+
+import (
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// sysFileDetails reads the inode and change time from the raw stat data
+func sysFileDetails(fi os.FileInfo, details *fileDetails) {
+	stat, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return
+	}
+	details.inode = int64(stat.Ino)
+	details.ctime = time.Unix(stat.Ctim.Unix())
+}
+
+// localXattrs reads the extended attributes of a local file, without
+// following symlinks
+func localXattrs(path string) (map[string][]byte, error) {
+	size, err := unix.Llistxattr(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := map[string][]byte{}
+	if size == 0 {
+		return res, nil
+	}
+
+	buf := make([]byte, size)
+	size, err = unix.Llistxattr(path, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range strings.Split(string(buf[:size]), "\x00") {
+		if name == "" {
+			continue
+		}
+		n, err := unix.Lgetxattr(path, name, nil)
+		if err != nil {
+			return nil, err
+		}
+		value := make([]byte, n)
+		n, err = unix.Lgetxattr(path, name, value)
+		if err != nil {
+			return nil, err
+		}
+		res[name] = value[:n]
+	}
+	return res, nil
+}
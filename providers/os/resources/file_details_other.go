@@ -0,0 +1,18 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package resources
+
+import (
+	"errors"
+	"os"
+)
+
+// sysFileDetails only reads raw stat data on linux
+func sysFileDetails(fi os.FileInfo, details *fileDetails) {}
+
+func localXattrs(path string) (map[string][]byte, error) {
+	return nil, errors.New("extended attributes are only supported on linux")
+}
@@ -0,0 +1,92 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers/os/connection"
+)
+
+func TestRunsFileCommands(t *testing.T) {
+	assert.True(t, runsFileCommands(&connection.SshConnection{}))
+	assert.True(t, runsFileCommands(&connection.VagrantConnection{}))
+	assert.True(t, runsFileCommands(&connection.DockerContainerConnection{}))
+	assert.False(t, runsFileCommands(&connection.LocalConnection{}))
+}
+
+func TestParseStatDetails(t *testing.T) {
+	details, err := parseStatDetails("1835263:1690000000:1690000100:symbolic link\n")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1835263), details.inode)
+	assert.Equal(t, time.Unix(1690000000, 0), details.mtime)
+	assert.Equal(t, time.Unix(1690000100, 0), details.ctime)
+	assert.True(t, details.isSymlink)
+
+	// BSD stat
+	details, err = parseStatDetails("12:1690000000:1690000000:Regular File")
+	require.NoError(t, err)
+	assert.False(t, details.isSymlink)
+
+	_, err = parseStatDetails("stat: cannot stat")
+	assert.Error(t, err)
+}
+
+func TestParseGetfattr(t *testing.T) {
+	out := `# file: usr/bin/ping
+security.capability=0x0100000200200000000000000000000000000000
+security.selinux=0x73797374656d5f753a6f626a6563745f723a70696e675f657865635f743a733000
+`
+	attrs, err := parseGetfattr(strings.NewReader(out))
+	require.NoError(t, err)
+	assert.Len(t, attrs, 2)
+	assert.Equal(t, "system_u:object_r:ping_exec_t:s0", xattrString(attrs["security.selinux"]))
+	assert.Equal(t, "0x0100000200200000000000000000000000000000", xattrString(attrs["security.capability"]))
+}
+
+func TestParseFileCapabilities(t *testing.T) {
+	t.Run("revision 2", func(t *testing.T) {
+		// cap_net_raw+ep
+		data, _ := hex.DecodeString("0100000200200000000000000000000000000000")
+		caps, err := parseFileCapabilities(data)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cap_net_raw"}, caps)
+	})
+
+	t.Run("revision 3", func(t *testing.T) {
+		// cap_net_bind_service,cap_perfmon+ep with a root uid
+		data, _ := hex.DecodeString("01000003000400000000000040000000000000000000000000")
+		caps, err := parseFileCapabilities(data)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cap_net_bind_service", "cap_perfmon"}, caps)
+	})
+
+	t.Run("no capabilities", func(t *testing.T) {
+		caps, err := parseFileCapabilities(nil)
+		require.NoError(t, err)
+		assert.Empty(t, caps)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parseFileCapabilities([]byte{0x01, 0x00, 0x00, 0x09})
+		assert.Error(t, err)
+	})
+}
+
+func TestCapabilityNamesFromMask(t *testing.T) {
+	// default capabilities of docker containers
+	assert.Equal(t, []string{
+		"cap_chown", "cap_dac_override", "cap_fowner", "cap_fsetid", "cap_kill",
+		"cap_setgid", "cap_setuid", "cap_setpcap", "cap_net_bind_service",
+		"cap_net_raw", "cap_sys_chroot", "cap_mknod", "cap_audit_write", "cap_setfcap",
+	}, capabilityNamesFromMask(0xa80425fb))
+
+	assert.Equal(t, []string{"cap_sys_admin", "cap_63"}, capabilityNamesFromMask(1<<21|1<<63))
+	assert.Empty(t, capabilityNamesFromMask(0))
+}
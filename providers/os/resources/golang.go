@@ -0,0 +1,148 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"bytes"
+	"debug/buildinfo"
+	"errors"
+	"runtime/debug"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/types"
+)
+
+func initGoBinaries(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		_, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("Wrong type for 'path' in go.binaries initialization, it must be a string")
+		}
+	} else {
+		// empty path means search through default locations
+		args["path"] = llx.StringData("")
+	}
+
+	return args, nil, nil
+}
+
+func (x *mqlGoBinaries) id() (string, error) {
+	return "go.binaries/" + x.Path.Data, nil
+}
+
+func (x *mqlGoBinary) id() (string, error) {
+	return x.Path.Data, nil
+}
+
+func (x *mqlGoModule) id() (string, error) {
+	return x.Id.Data, nil
+}
+
+func (x *mqlGoBinaries) list() ([]interface{}, error) {
+	conn, ok := x.MqlRuntime.Connection.(shared.Connection)
+	if !ok {
+		return nil, errors.New("provider is not an operating system provider")
+	}
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	dirs := binaryDirectories
+	if x.Path.Data != "" {
+		dirs = []string{x.Path.Data}
+	}
+
+	res := []interface{}{}
+	seen := map[string]struct{}{}
+	for _, dir := range dirs {
+		files, err := findPackageFiles(conn, dir, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range files {
+			if _, ok := seen[path]; ok {
+				continue
+			}
+			seen[path] = struct{}{}
+
+			info, err := readGoBuildInfo(afs, path)
+			if err != nil {
+				log.Debug().Err(err).Str("path", path).Msg("mql[go.binaries]> could not read build info")
+				continue
+			}
+			if info == nil {
+				continue
+			}
+
+			binary, err := newMqlGoBinary(x.MqlRuntime, path, info)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, binary)
+		}
+	}
+
+	return res, nil
+}
+
+// readGoBuildInfo returns the build information embedded into a Go binary,
+// or nil if the file is not a Go binary
+func readGoBuildInfo(afs *afero.Afero, path string) (*debug.BuildInfo, error) {
+	data, err := readExecutable(afs, path)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	info, err := buildinfo.Read(bytes.NewReader(data))
+	if err != nil {
+		// executables that were not built with Go have no build info
+		return nil, nil
+	}
+	return info, nil
+}
+
+func newMqlGoBinary(runtime *plugin.Runtime, path string, info *debug.BuildInfo) (plugin.Resource, error) {
+	f, err := CreateResource(runtime, "file", map[string]*llx.RawData{
+		"path": llx.StringData(path),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	settings := map[string]interface{}{}
+	for _, s := range info.Settings {
+		settings[s.Key] = s.Value
+	}
+
+	deps := make([]interface{}, 0, len(info.Deps))
+	for _, dep := range info.Deps {
+		// replaced modules are what actually got compiled into the binary
+		if dep.Replace != nil {
+			dep = dep.Replace
+		}
+		mod, err := CreateResource(runtime, "go.module", map[string]*llx.RawData{
+			"id":      llx.StringData(path + "/" + dep.Path + "@" + dep.Version),
+			"path":    llx.StringData(dep.Path),
+			"version": llx.StringData(dep.Version),
+			"sum":     llx.StringData(dep.Sum),
+		})
+		if err != nil {
+			return nil, err
+		}
+		deps = append(deps, mod)
+	}
+
+	return CreateResource(runtime, "go.binary", map[string]*llx.RawData{
+		"path":         llx.StringData(path),
+		"goVersion":    llx.StringData(info.GoVersion),
+		"module":       llx.StringData(info.Main.Path),
+		"version":      llx.StringData(info.Main.Version),
+		"settings":     llx.MapData(settings, types.String),
+		"dependencies": llx.ArrayData(deps, types.Resource("go.module")),
+		"file":         llx.ResourceData(f, f.MqlName()),
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadGoBuildInfo(t *testing.T) {
+	// the test binary itself is a go binary with build info
+	afs := &afero.Afero{Fs: afero.NewOsFs()}
+	info, err := readGoBuildInfo(afs, os.Args[0])
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.NotEmpty(t, info.GoVersion)
+	assert.NotEmpty(t, info.Path)
+
+	mem := &afero.Afero{Fs: afero.NewMemMapFs()}
+	require.NoError(t, mem.WriteFile("/usr/bin/script", []byte("#!/bin/sh\necho hi\n"), 0o755))
+	require.NoError(t, mem.WriteFile("/usr/bin/elf", []byte("\x7fELF not really"), 0o755))
+
+	info, err = readGoBuildInfo(mem, "/usr/bin/script")
+	require.NoError(t, err)
+	assert.Nil(t, info)
+
+	info, err = readGoBuildInfo(mem, "/usr/bin/elf")
+	require.NoError(t, err)
+	assert.Nil(t, info)
+}
@@ -0,0 +1,299 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/types"
+)
+
+// directories that are scanned for java archives by default
+var javaDirectories = []string{
+	"/usr/share/java",
+	"/usr/local",
+	"/opt",
+	"/app",
+	"/srv",
+}
+
+var (
+	javaArchiveRegex       = regexp.MustCompile(`^.*\.(jar|war|ear)$`)
+	javaPomPropertiesRegex = regexp.MustCompile(`^META-INF/maven/[^/]+/[^/]+/pom\.properties$`)
+)
+
+// nested archives are only unpacked up to this depth, e.g. spring boot
+// applications have their dependencies in BOOT-INF/lib
+const javaMaxArchiveDepth = 3
+
+func initJavaArchives(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		_, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("Wrong type for 'path' in java.archives initialization, it must be a string")
+		}
+	} else {
+		// empty path means search through default locations
+		args["path"] = llx.StringData("")
+	}
+
+	return args, nil, nil
+}
+
+func (x *mqlJavaArchives) id() (string, error) {
+	return "java.archives/" + x.Path.Data, nil
+}
+
+func (x *mqlJavaArchive) id() (string, error) {
+	return x.Path.Data, nil
+}
+
+type javaArchiveDetails struct {
+	path       string
+	name       string
+	version    string
+	groupId    string
+	artifactId string
+	manifest   map[string]string
+	file       string
+}
+
+func (x *mqlJavaArchives) list() ([]interface{}, error) {
+	conn, ok := x.MqlRuntime.Connection.(shared.Connection)
+	if !ok {
+		return nil, errors.New("provider is not an operating system provider")
+	}
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	dirs := javaDirectories
+	if x.Path.Data != "" {
+		dirs = []string{x.Path.Data}
+	}
+
+	res := []interface{}{}
+	seen := map[string]struct{}{}
+	for _, dir := range dirs {
+		files, err := findPackageFiles(conn, dir, javaArchiveRegex)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range files {
+			if _, ok := seen[path]; ok {
+				continue
+			}
+			seen[path] = struct{}{}
+
+			data, err := readPackageFile(afs, path)
+			if err != nil {
+				log.Debug().Err(err).Str("path", path).Msg("mql[java.archives]> could not read archive")
+				continue
+			}
+			archives, err := parseJavaArchive(data, path, path, 0)
+			if err != nil {
+				log.Debug().Err(err).Str("path", path).Msg("mql[java.archives]> could not parse archive")
+				continue
+			}
+
+			for i := range archives {
+				archive, err := newMqlJavaArchive(x.MqlRuntime, archives[i])
+				if err != nil {
+					return nil, err
+				}
+				res = append(res, archive)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func newMqlJavaArchive(runtime *plugin.Runtime, details javaArchiveDetails) (plugin.Resource, error) {
+	f, err := CreateResource(runtime, "file", map[string]*llx.RawData{
+		"path": llx.StringData(details.file),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := make(map[string]interface{}, len(details.manifest))
+	for k, v := range details.manifest {
+		manifest[k] = v
+	}
+
+	return CreateResource(runtime, "java.archive", map[string]*llx.RawData{
+		"path":       llx.StringData(details.path),
+		"name":       llx.StringData(details.name),
+		"version":    llx.StringData(details.version),
+		"groupId":    llx.StringData(details.groupId),
+		"artifactId": llx.StringData(details.artifactId),
+		"manifest":   llx.MapData(manifest, types.String),
+		"file":       llx.ResourceData(f, f.MqlName()),
+	})
+}
+
+// parseJavaArchive returns the archive and all archives nested in it. Their
+// paths are separated with !/ the way the JVM addresses nested archives.
+func parseJavaArchive(data []byte, path string, file string, depth int) ([]javaArchiveDetails, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	archive := javaArchiveDetails{
+		path: path,
+		file: file,
+	}
+	var poms []map[string]string
+	var nested []javaArchiveDetails
+
+	for _, entry := range r.File {
+		switch {
+		case entry.Name == "META-INF/MANIFEST.MF":
+			content, err := readZipEntry(entry)
+			if err != nil {
+				return nil, err
+			}
+			archive.manifest = parseJavaManifest(bytes.NewReader(content))
+
+		case javaPomPropertiesRegex.MatchString(entry.Name):
+			content, err := readZipEntry(entry)
+			if err != nil {
+				return nil, err
+			}
+			poms = append(poms, parseJavaProperties(bytes.NewReader(content)))
+
+		case depth < javaMaxArchiveDepth && javaArchiveRegex.FindString(entry.Name) == entry.Name:
+			content, err := readZipEntry(entry)
+			if err != nil {
+				return nil, err
+			}
+			res, err := parseJavaArchive(content, path+"!/"+entry.Name, file, depth+1)
+			if err != nil {
+				log.Debug().Err(err).Str("path", path+"!/"+entry.Name).Msg("mql[java.archives]> could not parse nested archive")
+				continue
+			}
+			nested = append(nested, res...)
+		}
+	}
+
+	archive.setMetadata(poms)
+	return append([]javaArchiveDetails{archive}, nested...), nil
+}
+
+// setMetadata picks the archive's name and version from its maven metadata
+// and falls back to its manifest. Shaded archives include the metadata of
+// all archives they bundle, so we only use the one matching the file name.
+func (a *javaArchiveDetails) setMetadata(poms []map[string]string) {
+	base := strings.TrimSuffix(filepath.Base(a.path), filepath.Ext(a.path))
+
+	var pom map[string]string
+	if len(poms) == 1 {
+		pom = poms[0]
+	} else {
+		for i := range poms {
+			if id := poms[i]["artifactId"]; id != "" && strings.HasPrefix(base, id) {
+				pom = poms[i]
+				break
+			}
+		}
+	}
+	if pom != nil {
+		a.groupId = pom["groupId"]
+		a.artifactId = pom["artifactId"]
+		a.name = pom["artifactId"]
+		a.version = pom["version"]
+	}
+
+	for _, key := range []string{"Implementation-Title", "Bundle-SymbolicName", "Bundle-Name"} {
+		if a.name != "" {
+			break
+		}
+		// bundle names may carry directives, e.g. org.foo;singleton:=true
+		a.name, _, _ = strings.Cut(a.manifest[key], ";")
+	}
+	if a.name == "" {
+		a.name = base
+	}
+
+	for _, key := range []string{"Implementation-Version", "Bundle-Version", "Specification-Version"} {
+		if a.version != "" {
+			break
+		}
+		a.version = a.manifest[key]
+	}
+}
+
+func readZipEntry(entry *zip.File) ([]byte, error) {
+	if entry.UncompressedSize64 > maxPackageFileSize {
+		return nil, errors.New("archive entry is too large: " + entry.Name)
+	}
+	f, err := entry.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// parseJavaManifest parses the main section of a MANIFEST.MF file, see
+// https://docs.oracle.com/en/java/javase/17/docs/specs/jar/jar.html#jar-manifest
+func parseJavaManifest(r io.Reader) map[string]string {
+	// long lines continue on lines starting with a space, which may split
+	// them anywhere, so we join them before we parse them
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		// the main section ends with the first empty line
+		if line == "" {
+			break
+		}
+		if strings.HasPrefix(line, " ") && len(lines) != 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	res := map[string]string{}
+	for _, line := range lines {
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		res[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return res
+}
+
+// parseJavaProperties parses the simple key=value properties maven writes
+func parseJavaProperties(r io.Reader) map[string]string {
+	res := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		res[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return res
+}
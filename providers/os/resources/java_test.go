@@ -0,0 +1,130 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/connection"
+)
+
+func testZip(t *testing.T, files map[string][]byte) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestParseJavaArchive(t *testing.T) {
+	lib := testZip(t, map[string][]byte{
+		"META-INF/MANIFEST.MF": []byte("Manifest-Version: 1.0\r\nBundle-SymbolicName: org.yaml.snakeyaml;singleton:=true\r\nBundle-Version: 1.33.0\r\n"),
+	})
+	app := testZip(t, map[string][]byte{
+		"META-INF/MANIFEST.MF":                           []byte("Manifest-Version: 1.0\nMain-Class: org.springframework.boot.loader.JarLauncher\nImplementation-Title: demo\nImplementation-Ver\n sion: 0.0.1\n"),
+		"META-INF/maven/com.example/demo/pom.properties": []byte("#Generated by Maven\ngroupId=com.example\nartifactId=demo\nversion=0.0.1-SNAPSHOT\n"),
+		"BOOT-INF/lib/snakeyaml-1.33.jar":                lib,
+	})
+
+	res, err := parseJavaArchive(app, "/app/demo.jar", "/app/demo.jar", 0)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	assert.Equal(t, "/app/demo.jar", res[0].path)
+	assert.Equal(t, "demo", res[0].name)
+	assert.Equal(t, "0.0.1-SNAPSHOT", res[0].version)
+	assert.Equal(t, "com.example", res[0].groupId)
+	assert.Equal(t, "demo", res[0].artifactId)
+	assert.Equal(t, "org.springframework.boot.loader.JarLauncher", res[0].manifest["Main-Class"])
+	assert.Equal(t, "0.0.1", res[0].manifest["Implementation-Version"])
+
+	assert.Equal(t, "/app/demo.jar!/BOOT-INF/lib/snakeyaml-1.33.jar", res[1].path)
+	assert.Equal(t, "/app/demo.jar", res[1].file)
+	assert.Equal(t, "org.yaml.snakeyaml", res[1].name)
+	assert.Equal(t, "1.33.0", res[1].version)
+	assert.Empty(t, res[1].groupId)
+
+	_, err = parseJavaArchive([]byte("not a zip"), "/app/broken.jar", "/app/broken.jar", 0)
+	assert.Error(t, err)
+}
+
+func TestParseJavaProperties(t *testing.T) {
+	props := parseJavaProperties(strings.NewReader("# comment\n! comment\ngroupId = org.apache\nartifactId=commons-text\n\nversion=1.10.0\n"))
+	assert.Equal(t, map[string]string{
+		"groupId":    "org.apache",
+		"artifactId": "commons-text",
+		"version":    "1.10.0",
+	}, props)
+}
+
+func testTar(t *testing.T, files map[string][]byte) string {
+	path := filepath.Join(t.TempDir(), "image.tar")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	w := tar.NewWriter(f)
+	for name, content := range files {
+		require.NoError(t, w.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return path
+}
+
+func TestJavaArchivesTar(t *testing.T) {
+	app := testZip(t, map[string][]byte{
+		"META-INF/maven/com.example/demo/pom.properties": []byte("groupId=com.example\nartifactId=demo\nversion=0.0.1\n"),
+	})
+	path := testTar(t, map[string][]byte{
+		"app/demo.jar":      app,
+		"app/demo.jar.sha1": []byte("not an archive"),
+		"app/foo.warning":   []byte("not an archive"),
+	})
+
+	conn, err := connection.NewTarConnection(1, &inventory.Config{
+		Type:    "tar",
+		Options: map[string]string{connection.OPTION_FILE: path},
+	}, &inventory.Asset{})
+	require.NoError(t, err)
+
+	runtime := &plugin.Runtime{Connection: conn}
+	archives, err := CreateResource(runtime, "java.archives", map[string]*llx.RawData{
+		"path": llx.StringData("/app"),
+	})
+	require.NoError(t, err)
+
+	list, err := archives.(*mqlJavaArchives).list()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	archive := list[0].(*mqlJavaArchive)
+	assert.Equal(t, "/app/demo.jar", archive.Path.Data)
+	assert.Equal(t, "0.0.1", archive.Version.Data)
+
+	files, err := findPackageFiles(conn, "/app", javaArchiveRegex)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/app/demo.jar"}, files)
+}
@@ -336,6 +336,44 @@ func TestDict_Methods_Contains(t *testing.T) {
 	})
 }
 
+func TestDict_Methods_String(t *testing.T) {
+	p := "parse.json('/dummy.json')."
+
+	x := testutils.InitTester(testutils.LinuxMock())
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        p + "params['hello'].startsWith('he')",
+			ResultIndex: 1,
+			Expectation: true,
+		},
+		{
+			Code:        p + "params['hello'].endsWith(['xx', 'lo'])",
+			ResultIndex: 1,
+			Expectation: true,
+		},
+		{
+			Code:        p + "params['hello'].replace(/l+/, 'L')",
+			ResultIndex: 0,
+			Expectation: "heLo",
+		},
+		{
+			Code:        p + "params['hello'].in(['hi', 'hello'])",
+			ResultIndex: 1,
+			Expectation: true,
+		},
+		{
+			Code:        p + "params['hello'].padLeft(7, '.')",
+			ResultIndex: 0,
+			Expectation: "..hello",
+		},
+		{
+			Code:        p + "params.format",
+			ResultIndex: 0,
+			Expectation: nil,
+		},
+	})
+}
+
 func TestDict_Methods_Map(t *testing.T) {
 	p := "parse.json('/dummy.json')."
 
@@ -0,0 +1,219 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers-sdk/v1/upstream/mvd"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/os/resources/npm"
+)
+
+// global locations where npm installs packages
+var npmGlobalDirectories = []string{
+	"/usr/lib/node_modules",
+	"/usr/local/lib/node_modules",
+	"/opt/homebrew/lib/node_modules",
+	"C:/Program Files/nodejs/node_modules",
+}
+
+// npm lock files, in the order we prefer them
+var npmLockFiles = []string{
+	"package-lock.json",
+	"yarn.lock",
+}
+
+func initNpmPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		_, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("Wrong type for 'path' in npm.packages initialization, it must be a string")
+		}
+	} else {
+		// empty path means search through global locations
+		args["path"] = llx.StringData("")
+	}
+
+	return args, nil, nil
+}
+
+func (x *mqlNpmPackages) id() (string, error) {
+	return "npm.packages/" + x.Path.Data, nil
+}
+
+func (x *mqlNpmPackage) id() (string, error) {
+	return x.Id.Data, nil
+}
+
+type npmPackageDetails struct {
+	name    string
+	version string
+	file    string
+}
+
+func (x *mqlNpmPackages) list() ([]interface{}, error) {
+	conn, ok := x.MqlRuntime.Connection.(shared.Connection)
+	if !ok {
+		return nil, errors.New("provider is not an operating system provider")
+	}
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	var all []npmPackageDetails
+	if x.Path.Data == "" {
+		for _, dir := range npmGlobalDirectories {
+			all = append(all, gatherNpmModules(afs, dir)...)
+		}
+	} else {
+		res, err := gatherNpmPath(afs, x.Path.Data)
+		if err != nil {
+			return nil, err
+		}
+		all = res
+	}
+
+	res := make([]interface{}, 0, len(all))
+	seen := map[string]struct{}{}
+	for _, details := range all {
+		id := details.file + "/" + details.name + "@" + details.version
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		f, err := CreateResource(x.MqlRuntime, "file", map[string]*llx.RawData{
+			"path": llx.StringData(details.file),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		pkg, err := CreateResource(x.MqlRuntime, "npm.package", map[string]*llx.RawData{
+			"id":      llx.StringData(id),
+			"name":    llx.StringData(details.name),
+			"version": llx.StringData(details.version),
+			"file":    llx.ResourceData(f, f.MqlName()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, pkg)
+	}
+
+	return res, nil
+}
+
+// gatherNpmPath collects packages from a lock file, a package.json file,
+// a node_modules directory, or a project directory
+func gatherNpmPath(afs *afero.Afero, path string) ([]npmPackageDetails, error) {
+	fi, err := afs.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fi.IsDir() {
+		return parseNpmFile(afs, path)
+	}
+
+	if filepath.Base(path) == "node_modules" {
+		return gatherNpmModules(afs, path), nil
+	}
+
+	// projects are described best by their lock files, since they include
+	// all transitive dependencies with their exact versions
+	for _, name := range npmLockFiles {
+		lockfile := filepath.Join(path, name)
+		if ok, _ := afs.Exists(lockfile); ok {
+			return parseNpmFile(afs, lockfile)
+		}
+	}
+
+	return gatherNpmModules(afs, filepath.Join(path, "node_modules")), nil
+}
+
+func parseNpmFile(afs *afero.Afero, path string) ([]npmPackageDetails, error) {
+	f, err := afs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var parse func(r io.Reader) ([]*mvd.Package, error)
+	switch filepath.Base(path) {
+	case "package-lock.json":
+		parse = npm.ParsePackageJsonLock
+	case "yarn.lock":
+		parse = npm.ParseYarnLock
+	case "package.json":
+		parse = npm.ParsePackageJson
+	default:
+		return nil, errors.New("unsupported npm file: " + path)
+	}
+
+	pkgs, err := parse(f)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]npmPackageDetails, 0, len(pkgs))
+	for i := range pkgs {
+		pkg := pkgs[i]
+		if pkg.Name == "" {
+			continue
+		}
+		res = append(res, npmPackageDetails{
+			name:    pkg.Name,
+			version: pkg.Version,
+			file:    path,
+		})
+		// package.json files only list version constraints for dependencies
+		if filepath.Base(path) == "package.json" {
+			break
+		}
+	}
+	return res, nil
+}
+
+// gatherNpmModules collects all packages installed in a node_modules
+// directory, including scoped and nested packages
+func gatherNpmModules(afs *afero.Afero, dir string) []npmPackageDetails {
+	entries, err := afs.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn().Err(err).Str("dir", dir).Msg("unable to open directory")
+		}
+		return nil
+	}
+
+	var res []npmPackageDetails
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		if strings.HasPrefix(name, "@") {
+			res = append(res, gatherNpmModules(afs, filepath.Join(dir, name))...)
+			continue
+		}
+
+		pkgDir := filepath.Join(dir, name)
+		pkgs, err := parseNpmFile(afs, filepath.Join(pkgDir, "package.json"))
+		if err != nil {
+			log.Debug().Err(err).Str("dir", pkgDir).Msg("mql[npm.packages]> could not parse package")
+			continue
+		}
+		res = append(res, pkgs...)
+		res = append(res, gatherNpmModules(afs, filepath.Join(pkgDir, "node_modules"))...)
+	}
+	return res
+}
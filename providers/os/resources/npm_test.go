@@ -0,0 +1,44 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatherNpmPackages(t *testing.T) {
+	afs := &afero.Afero{Fs: afero.NewMemMapFs()}
+	require.NoError(t, afs.WriteFile("/usr/lib/node_modules/npm/package.json", []byte(`{"name":"npm","version":"9.6.7","dependencies":{"abbrev":"^2.0.0"}}`), 0o644))
+	require.NoError(t, afs.WriteFile("/usr/lib/node_modules/npm/node_modules/abbrev/package.json", []byte(`{"name":"abbrev","version":"2.0.0"}`), 0o644))
+	require.NoError(t, afs.WriteFile("/usr/lib/node_modules/@angular/cli/package.json", []byte(`{"name":"@angular/cli","version":"16.1.0"}`), 0o644))
+	require.NoError(t, afs.WriteFile("/app/package.json", []byte(`{"name":"app","version":"1.0.0"}`), 0o644))
+	require.NoError(t, afs.WriteFile("/app/package-lock.json", []byte(`{"name":"app","version":"1.0.0","dependencies":{"express":{"version":"4.18.2"}}}`), 0o644))
+
+	t.Run("node_modules", func(t *testing.T) {
+		res := gatherNpmModules(afs, "/usr/lib/node_modules")
+		assert.ElementsMatch(t, []npmPackageDetails{
+			{name: "@angular/cli", version: "16.1.0", file: "/usr/lib/node_modules/@angular/cli/package.json"},
+			{name: "npm", version: "9.6.7", file: "/usr/lib/node_modules/npm/package.json"},
+			{name: "abbrev", version: "2.0.0", file: "/usr/lib/node_modules/npm/node_modules/abbrev/package.json"},
+		}, res)
+	})
+
+	t.Run("project with lock file", func(t *testing.T) {
+		res, err := gatherNpmPath(afs, "/app")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []npmPackageDetails{
+			{name: "app", version: "1.0.0", file: "/app/package-lock.json"},
+			{name: "express", version: "4.18.2", file: "/app/package-lock.json"},
+		}, res)
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		_, err := gatherNpmPath(afs, "/missing")
+		assert.Error(t, err)
+	})
+}
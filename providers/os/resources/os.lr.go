@@ -338,6 +338,50 @@ func init() {
 			Init: initPythonPackage,
 			Create: createPythonPackage,
 		},
+		"npm.packages": {
+			Init: initNpmPackages,
+			Create: createNpmPackages,
+		},
+		"npm.package": {
+			// to override args, implement: initNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createNpmPackage,
+		},
+		"go.binaries": {
+			Init: initGoBinaries,
+			Create: createGoBinaries,
+		},
+		"go.binary": {
+			// to override args, implement: initGoBinary(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createGoBinary,
+		},
+		"go.module": {
+			// to override args, implement: initGoModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createGoModule,
+		},
+		"java.archives": {
+			Init: initJavaArchives,
+			Create: createJavaArchives,
+		},
+		"java.archive": {
+			// to override args, implement: initJavaArchive(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createJavaArchive,
+		},
+		"ruby.gems": {
+			Init: initRubyGems,
+			Create: createRubyGems,
+		},
+		"ruby.gem": {
+			// to override args, implement: initRubyGem(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createRubyGem,
+		},
+		"cargo.packages": {
+			Init: initCargoPackages,
+			Create: createCargoPackages,
+		},
+		"cargo.package": {
+			// to override args, implement: initCargoPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createCargoPackage,
+		},
 		"macos": {
 			// to override args, implement: initMacos(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createMacos,
@@ -766,6 +810,36 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"file.empty": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlFile).GetEmpty()).ToDataRes(types.Bool)
 	},
+	"file.sha256": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFile).GetSha256()).ToDataRes(types.String)
+	},
+	"file.md5": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFile).GetMd5()).ToDataRes(types.String)
+	},
+	"file.mtime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFile).GetMtime()).ToDataRes(types.Time)
+	},
+	"file.ctime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFile).GetCtime()).ToDataRes(types.Time)
+	},
+	"file.inode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFile).GetInode()).ToDataRes(types.Int)
+	},
+	"file.isSymlink": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFile).GetIsSymlink()).ToDataRes(types.Bool)
+	},
+	"file.linkTarget": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFile).GetLinkTarget()).ToDataRes(types.String)
+	},
+	"file.xattrs": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFile).GetXattrs()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"file.capabilities": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFile).GetCapabilities()).ToDataRes(types.Array(types.String))
+	},
+	"file.mountPoint": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFile).GetMountPoint()).ToDataRes(types.Resource("mount.point"))
+	},
 	"file.permissions.mode": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlFilePermissions).GetMode()).ToDataRes(types.Int)
 	},
@@ -1018,6 +1092,21 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"package.origin": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlPackage).GetOrigin()).ToDataRes(types.String)
 	},
+	"package.vendor": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPackage).GetVendor()).ToDataRes(types.String)
+	},
+	"package.licenses": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPackage).GetLicenses()).ToDataRes(types.Array(types.String))
+	},
+	"package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPackage).GetFiles()).ToDataRes(types.Array(types.Resource("file")))
+	},
+	"package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPackage).GetCpes()).ToDataRes(types.Array(types.String))
+	},
 	"package.available": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlPackage).GetAvailable()).ToDataRes(types.String)
 	},
@@ -1240,6 +1329,42 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"process.flags": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlProcess).GetFlags()).ToDataRes(types.Map(types.String, types.String))
 	},
+	"process.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetUid()).ToDataRes(types.Int)
+	},
+	"process.user": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetUser()).ToDataRes(types.Resource("user"))
+	},
+	"process.ppid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetPpid()).ToDataRes(types.Int)
+	},
+	"process.parent": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetParent()).ToDataRes(types.Resource("process"))
+	},
+	"process.environment": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetEnvironment()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"process.cwd": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetCwd()).ToDataRes(types.String)
+	},
+	"process.cgroup": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetCgroup()).ToDataRes(types.Array(types.String))
+	},
+	"process.namespaces": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetNamespaces()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"process.capabilities": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetCapabilities()).ToDataRes(types.Array(types.String))
+	},
+	"process.startTime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetStartTime()).ToDataRes(types.Time)
+	},
+	"process.memory": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetMemory()).ToDataRes(types.Int)
+	},
+	"process.openFiles": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetOpenFiles()).ToDataRes(types.Array(types.Resource("file")))
+	},
 	"processes.list": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlProcesses).GetList()).ToDataRes(types.Array(types.Resource("process")))
 	},
@@ -1549,6 +1674,138 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"python.package.dependencies": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlPythonPackage).GetDependencies()).ToDataRes(types.Array(types.Resource("python.package")))
 	},
+	"npm.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackages).GetPath()).ToDataRes(types.String)
+	},
+	"npm.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackages).GetList()).ToDataRes(types.Array(types.Resource("npm.package")))
+	},
+	"npm.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackage).GetId()).ToDataRes(types.String)
+	},
+	"npm.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackage).GetName()).ToDataRes(types.String)
+	},
+	"npm.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"npm.package.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackage).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"go.binaries.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoBinaries).GetPath()).ToDataRes(types.String)
+	},
+	"go.binaries.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoBinaries).GetList()).ToDataRes(types.Array(types.Resource("go.binary")))
+	},
+	"go.binary.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoBinary).GetPath()).ToDataRes(types.String)
+	},
+	"go.binary.goVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoBinary).GetGoVersion()).ToDataRes(types.String)
+	},
+	"go.binary.module": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoBinary).GetModule()).ToDataRes(types.String)
+	},
+	"go.binary.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoBinary).GetVersion()).ToDataRes(types.String)
+	},
+	"go.binary.settings": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoBinary).GetSettings()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"go.binary.dependencies": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoBinary).GetDependencies()).ToDataRes(types.Array(types.Resource("go.module")))
+	},
+	"go.binary.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoBinary).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"go.module.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModule).GetId()).ToDataRes(types.String)
+	},
+	"go.module.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModule).GetPath()).ToDataRes(types.String)
+	},
+	"go.module.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModule).GetVersion()).ToDataRes(types.String)
+	},
+	"go.module.sum": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModule).GetSum()).ToDataRes(types.String)
+	},
+	"java.archives.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaArchives).GetPath()).ToDataRes(types.String)
+	},
+	"java.archives.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaArchives).GetList()).ToDataRes(types.Array(types.Resource("java.archive")))
+	},
+	"java.archive.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaArchive).GetPath()).ToDataRes(types.String)
+	},
+	"java.archive.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaArchive).GetName()).ToDataRes(types.String)
+	},
+	"java.archive.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaArchive).GetVersion()).ToDataRes(types.String)
+	},
+	"java.archive.groupId": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaArchive).GetGroupId()).ToDataRes(types.String)
+	},
+	"java.archive.artifactId": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaArchive).GetArtifactId()).ToDataRes(types.String)
+	},
+	"java.archive.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaArchive).GetManifest()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"java.archive.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaArchive).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"ruby.gems.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyGems).GetPath()).ToDataRes(types.String)
+	},
+	"ruby.gems.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyGems).GetList()).ToDataRes(types.Array(types.Resource("ruby.gem")))
+	},
+	"ruby.gem.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyGem).GetId()).ToDataRes(types.String)
+	},
+	"ruby.gem.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyGem).GetName()).ToDataRes(types.String)
+	},
+	"ruby.gem.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyGem).GetVersion()).ToDataRes(types.String)
+	},
+	"ruby.gem.summary": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyGem).GetSummary()).ToDataRes(types.String)
+	},
+	"ruby.gem.licenses": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyGem).GetLicenses()).ToDataRes(types.Array(types.String))
+	},
+	"ruby.gem.authors": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyGem).GetAuthors()).ToDataRes(types.Array(types.String))
+	},
+	"ruby.gem.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyGem).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"cargo.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCargoPackages).GetPath()).ToDataRes(types.String)
+	},
+	"cargo.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCargoPackages).GetList()).ToDataRes(types.Array(types.Resource("cargo.package")))
+	},
+	"cargo.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCargoPackage).GetId()).ToDataRes(types.String)
+	},
+	"cargo.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCargoPackage).GetName()).ToDataRes(types.String)
+	},
+	"cargo.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCargoPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"cargo.package.source": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCargoPackage).GetSource()).ToDataRes(types.String)
+	},
+	"cargo.package.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCargoPackage).GetFile()).ToDataRes(types.Resource("file"))
+	},
 	"macos.userPreferences": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlMacos).GetUserPreferences()).ToDataRes(types.Map(types.String, types.Dict))
 	},
@@ -2383,6 +2640,46 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlFile).Empty, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
+	"file.sha256": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFile).Sha256, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"file.md5": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFile).Md5, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"file.mtime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFile).Mtime, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"file.ctime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFile).Ctime, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"file.inode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFile).Inode, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"file.isSymlink": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFile).IsSymlink, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"file.linkTarget": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFile).LinkTarget, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"file.xattrs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFile).Xattrs, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"file.capabilities": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFile).Capabilities, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"file.mountPoint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFile).MountPoint, ok = plugin.RawToTValue[*mqlMountPoint](v.Value, v.Error)
+		return
+	},
 	"file.permissions.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlFilePermissions).__id, ok = v.Value.(string)
 			return
@@ -2787,6 +3084,26 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlPackage).Origin, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
+	"package.vendor": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPackage).Vendor, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"package.licenses": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPackage).Licenses, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPackage).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
 	"package.available": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlPackage).Available, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
@@ -3147,6 +3464,54 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlProcess).Flags, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
+	"process.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Uid, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"process.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).User, ok = plugin.RawToTValue[*mqlUser](v.Value, v.Error)
+		return
+	},
+	"process.ppid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Ppid, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"process.parent": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Parent, ok = plugin.RawToTValue[*mqlProcess](v.Value, v.Error)
+		return
+	},
+	"process.environment": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Environment, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"process.cwd": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Cwd, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"process.cgroup": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Cgroup, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"process.namespaces": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Namespaces, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"process.capabilities": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Capabilities, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"process.startTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).StartTime, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"process.memory": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Memory, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"process.openFiles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).OpenFiles, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
 	"processes.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlProcesses).__id, ok = v.Value.(string)
 			return
@@ -3655,6 +4020,226 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlPythonPackage).Dependencies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
+	"npm.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlNpmPackages).__id, ok = v.Value.(string)
+			return
+		},
+	"npm.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"npm.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackages).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"npm.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlNpmPackage).__id, ok = v.Value.(string)
+			return
+		},
+	"npm.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"npm.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"npm.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"npm.package.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"go.binaries.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlGoBinaries).__id, ok = v.Value.(string)
+			return
+		},
+	"go.binaries.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoBinaries).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"go.binaries.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoBinaries).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"go.binary.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlGoBinary).__id, ok = v.Value.(string)
+			return
+		},
+	"go.binary.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoBinary).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"go.binary.goVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoBinary).GoVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"go.binary.module": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoBinary).Module, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"go.binary.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoBinary).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"go.binary.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoBinary).Settings, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"go.binary.dependencies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoBinary).Dependencies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"go.binary.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoBinary).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"go.module.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlGoModule).__id, ok = v.Value.(string)
+			return
+		},
+	"go.module.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"go.module.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"go.module.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"go.module.sum": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).Sum, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.archives.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlJavaArchives).__id, ok = v.Value.(string)
+			return
+		},
+	"java.archives.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaArchives).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.archives.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaArchives).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"java.archive.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlJavaArchive).__id, ok = v.Value.(string)
+			return
+		},
+	"java.archive.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaArchive).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.archive.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaArchive).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.archive.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaArchive).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.archive.groupId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaArchive).GroupId, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.archive.artifactId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaArchive).ArtifactId, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.archive.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaArchive).Manifest, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"java.archive.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaArchive).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"ruby.gems.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRubyGems).__id, ok = v.Value.(string)
+			return
+		},
+	"ruby.gems.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyGems).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ruby.gems.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyGems).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ruby.gem.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRubyGem).__id, ok = v.Value.(string)
+			return
+		},
+	"ruby.gem.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyGem).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ruby.gem.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyGem).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ruby.gem.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyGem).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ruby.gem.summary": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyGem).Summary, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ruby.gem.licenses": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyGem).Licenses, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ruby.gem.authors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyGem).Authors, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ruby.gem.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyGem).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"cargo.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlCargoPackages).__id, ok = v.Value.(string)
+			return
+		},
+	"cargo.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCargoPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"cargo.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCargoPackages).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"cargo.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlCargoPackage).__id, ok = v.Value.(string)
+			return
+		},
+	"cargo.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCargoPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"cargo.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCargoPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"cargo.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCargoPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"cargo.package.source": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCargoPackage).Source, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"cargo.package.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCargoPackage).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
 	"macos.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlMacos).__id, ok = v.Value.(string)
 			return
@@ -5792,7 +6377,7 @@ func (c *mqlPowershell) GetExitcode() *plugin.TValue[int64] {
 type mqlFile struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlFileInternal it will be used here
+	mqlFileInternal
 	Path plugin.TValue[string]
 	Basename plugin.TValue[string]
 	Dirname plugin.TValue[string]
@@ -5803,6 +6388,16 @@ type mqlFile struct {
 	User plugin.TValue[*mqlUser]
 	Group plugin.TValue[*mqlGroup]
 	Empty plugin.TValue[bool]
+	Sha256 plugin.TValue[string]
+	Md5 plugin.TValue[string]
+	Mtime plugin.TValue[*time.Time]
+	Ctime plugin.TValue[*time.Time]
+	Inode plugin.TValue[int64]
+	IsSymlink plugin.TValue[bool]
+	LinkTarget plugin.TValue[string]
+	Xattrs plugin.TValue[map[string]interface{}]
+	Capabilities plugin.TValue[[]interface{}]
+	MountPoint plugin.TValue[*mqlMountPoint]
 }
 
 // createFile creates a new instance of this resource
@@ -5970,15 +6565,145 @@ func (c *mqlFile) GetEmpty() *plugin.TValue[bool] {
 	})
 }
 
-// mqlFilePermissions for the file.permissions resource
-type mqlFilePermissions struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlFilePermissionsInternal it will be used here
-	Mode plugin.TValue[int64]
-	User_readable plugin.TValue[bool]
-	User_writeable plugin.TValue[bool]
-	User_executable plugin.TValue[bool]
+func (c *mqlFile) GetSha256() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Sha256, func() (string, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return "", vargPath.Error
+		}
+
+		vargExists := c.GetExists()
+		if vargExists.Error != nil {
+			return "", vargExists.Error
+		}
+
+		return c.sha256(vargPath.Data, vargExists.Data)
+	})
+}
+
+func (c *mqlFile) GetMd5() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Md5, func() (string, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return "", vargPath.Error
+		}
+
+		vargExists := c.GetExists()
+		if vargExists.Error != nil {
+			return "", vargExists.Error
+		}
+
+		return c.md5(vargPath.Data, vargExists.Data)
+	})
+}
+
+func (c *mqlFile) GetMtime() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Mtime, func() (*time.Time, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
+
+		return c.mtime(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetCtime() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Ctime, func() (*time.Time, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
+
+		return c.ctime(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetInode() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Inode, func() (int64, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return 0, vargPath.Error
+		}
+
+		return c.inode(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetIsSymlink() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.IsSymlink, func() (bool, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return false, vargPath.Error
+		}
+
+		return c.isSymlink(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetLinkTarget() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.LinkTarget, func() (string, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return "", vargPath.Error
+		}
+
+		return c.linkTarget(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetXattrs() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Xattrs, func() (map[string]interface{}, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
+
+		return c.xattrs(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetCapabilities() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Capabilities, func() ([]interface{}, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
+
+		return c.capabilities(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetMountPoint() *plugin.TValue[*mqlMountPoint] {
+	return plugin.GetOrCompute[*mqlMountPoint](&c.MountPoint, func() (*mqlMountPoint, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "mountPoint")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlMountPoint), nil
+			}
+		}
+
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
+
+		return c.mountPoint(vargPath.Data)
+	})
+}
+
+// mqlFilePermissions for the file.permissions resource
+type mqlFilePermissions struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlFilePermissionsInternal it will be used here
+	Mode plugin.TValue[int64]
+	User_readable plugin.TValue[bool]
+	User_writeable plugin.TValue[bool]
+	User_executable plugin.TValue[bool]
 	Group_readable plugin.TValue[bool]
 	Group_writeable plugin.TValue[bool]
 	Group_executable plugin.TValue[bool]
@@ -7301,7 +8026,7 @@ func (c *mqlGroups) GetList() *plugin.TValue[[]interface{}] {
 type mqlPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPackageInternal it will be used here
+	mqlPackageInternal
 	Name plugin.TValue[string]
 	Version plugin.TValue[string]
 	Arch plugin.TValue[string]
@@ -7310,6 +8035,11 @@ type mqlPackage struct {
 	Status plugin.TValue[string]
 	Description plugin.TValue[string]
 	Origin plugin.TValue[string]
+	Vendor plugin.TValue[string]
+	Licenses plugin.TValue[[]interface{}]
+	Files plugin.TValue[[]interface{}]
+	Purl plugin.TValue[string]
+	Cpes plugin.TValue[[]interface{}]
 	Available plugin.TValue[string]
 	Installed plugin.TValue[bool]
 	Outdated plugin.TValue[bool]
@@ -7388,6 +8118,46 @@ func (c *mqlPackage) GetOrigin() *plugin.TValue[string] {
 	})
 }
 
+func (c *mqlPackage) GetVendor() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Vendor, func() (string, error) {
+		return c.vendor()
+	})
+}
+
+func (c *mqlPackage) GetLicenses() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Licenses, func() ([]interface{}, error) {
+		return c.licenses()
+	})
+}
+
+func (c *mqlPackage) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("package", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.files()
+	})
+}
+
+func (c *mqlPackage) GetPurl() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Purl, func() (string, error) {
+		return c.purl()
+	})
+}
+
+func (c *mqlPackage) GetCpes() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Cpes, func() ([]interface{}, error) {
+		return c.cpes()
+	})
+}
+
 func (c *mqlPackage) GetAvailable() *plugin.TValue[string] {
 	return &c.Available
 }
@@ -8607,6 +9377,18 @@ type mqlProcess struct {
 	Executable plugin.TValue[string]
 	Command plugin.TValue[string]
 	Flags plugin.TValue[map[string]interface{}]
+	Uid plugin.TValue[int64]
+	User plugin.TValue[*mqlUser]
+	Ppid plugin.TValue[int64]
+	Parent plugin.TValue[*mqlProcess]
+	Environment plugin.TValue[map[string]interface{}]
+	Cwd plugin.TValue[string]
+	Cgroup plugin.TValue[[]interface{}]
+	Namespaces plugin.TValue[map[string]interface{}]
+	Capabilities plugin.TValue[[]interface{}]
+	StartTime plugin.TValue[*time.Time]
+	Memory plugin.TValue[int64]
+	OpenFiles plugin.TValue[[]interface{}]
 }
 
 // createProcess creates a new instance of this resource
@@ -8674,6 +9456,108 @@ func (c *mqlProcess) GetFlags() *plugin.TValue[map[string]interface{}] {
 	})
 }
 
+func (c *mqlProcess) GetUid() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Uid, func() (int64, error) {
+		return c.uid()
+	})
+}
+
+func (c *mqlProcess) GetUser() *plugin.TValue[*mqlUser] {
+	return plugin.GetOrCompute[*mqlUser](&c.User, func() (*mqlUser, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("process", c.__id, "user")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlUser), nil
+			}
+		}
+
+		return c.user()
+	})
+}
+
+func (c *mqlProcess) GetPpid() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Ppid, func() (int64, error) {
+		return c.ppid()
+	})
+}
+
+func (c *mqlProcess) GetParent() *plugin.TValue[*mqlProcess] {
+	return plugin.GetOrCompute[*mqlProcess](&c.Parent, func() (*mqlProcess, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("process", c.__id, "parent")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlProcess), nil
+			}
+		}
+
+		return c.parent()
+	})
+}
+
+func (c *mqlProcess) GetEnvironment() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Environment, func() (map[string]interface{}, error) {
+		return c.environment()
+	})
+}
+
+func (c *mqlProcess) GetCwd() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Cwd, func() (string, error) {
+		return c.cwd()
+	})
+}
+
+func (c *mqlProcess) GetCgroup() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Cgroup, func() ([]interface{}, error) {
+		return c.cgroup()
+	})
+}
+
+func (c *mqlProcess) GetNamespaces() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Namespaces, func() (map[string]interface{}, error) {
+		return c.namespaces()
+	})
+}
+
+func (c *mqlProcess) GetCapabilities() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Capabilities, func() ([]interface{}, error) {
+		return c.capabilities()
+	})
+}
+
+func (c *mqlProcess) GetStartTime() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.StartTime, func() (*time.Time, error) {
+		return c.startTime()
+	})
+}
+
+func (c *mqlProcess) GetMemory() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Memory, func() (int64, error) {
+		return c.memory()
+	})
+}
+
+func (c *mqlProcess) GetOpenFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.OpenFiles, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("process", c.__id, "openFiles")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.openFiles()
+	})
+}
+
 // mqlProcesses for the processes resource
 type mqlProcesses struct {
 	MqlRuntime *plugin.Runtime
@@ -10539,6 +11423,770 @@ func (c *mqlPythonPackage) GetDependencies() *plugin.TValue[[]interface{}] {
 	})
 }
 
+// mqlNpmPackages for the npm.packages resource
+type mqlNpmPackages struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlNpmPackagesInternal it will be used here
+	Path plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
+// createNpmPackages creates a new instance of this resource
+func createNpmPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNpmPackages{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("npm.packages", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlNpmPackages) MqlName() string {
+	return "npm.packages"
+}
+
+func (c *mqlNpmPackages) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlNpmPackages) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlNpmPackages) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlNpmPackage for the npm.package resource
+type mqlNpmPackage struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlNpmPackageInternal it will be used here
+	Id plugin.TValue[string]
+	Name plugin.TValue[string]
+	Version plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+}
+
+// createNpmPackage creates a new instance of this resource
+func createNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNpmPackage{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("npm.package", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlNpmPackage) MqlName() string {
+	return "npm.package"
+}
+
+func (c *mqlNpmPackage) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlNpmPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlNpmPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlNpmPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlNpmPackage) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+// mqlGoBinaries for the go.binaries resource
+type mqlGoBinaries struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlGoBinariesInternal it will be used here
+	Path plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
+// createGoBinaries creates a new instance of this resource
+func createGoBinaries(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGoBinaries{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("go.binaries", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlGoBinaries) MqlName() string {
+	return "go.binaries"
+}
+
+func (c *mqlGoBinaries) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlGoBinaries) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlGoBinaries) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("go.binaries", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlGoBinary for the go.binary resource
+type mqlGoBinary struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlGoBinaryInternal it will be used here
+	Path plugin.TValue[string]
+	GoVersion plugin.TValue[string]
+	Module plugin.TValue[string]
+	Version plugin.TValue[string]
+	Settings plugin.TValue[map[string]interface{}]
+	Dependencies plugin.TValue[[]interface{}]
+	File plugin.TValue[*mqlFile]
+}
+
+// createGoBinary creates a new instance of this resource
+func createGoBinary(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGoBinary{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("go.binary", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlGoBinary) MqlName() string {
+	return "go.binary"
+}
+
+func (c *mqlGoBinary) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlGoBinary) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlGoBinary) GetGoVersion() *plugin.TValue[string] {
+	return &c.GoVersion
+}
+
+func (c *mqlGoBinary) GetModule() *plugin.TValue[string] {
+	return &c.Module
+}
+
+func (c *mqlGoBinary) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlGoBinary) GetSettings() *plugin.TValue[map[string]interface{}] {
+	return &c.Settings
+}
+
+func (c *mqlGoBinary) GetDependencies() *plugin.TValue[[]interface{}] {
+	return &c.Dependencies
+}
+
+func (c *mqlGoBinary) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+// mqlGoModule for the go.module resource
+type mqlGoModule struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlGoModuleInternal it will be used here
+	Id plugin.TValue[string]
+	Path plugin.TValue[string]
+	Version plugin.TValue[string]
+	Sum plugin.TValue[string]
+}
+
+// createGoModule creates a new instance of this resource
+func createGoModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGoModule{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("go.module", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlGoModule) MqlName() string {
+	return "go.module"
+}
+
+func (c *mqlGoModule) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlGoModule) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlGoModule) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlGoModule) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlGoModule) GetSum() *plugin.TValue[string] {
+	return &c.Sum
+}
+
+// mqlJavaArchives for the java.archives resource
+type mqlJavaArchives struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlJavaArchivesInternal it will be used here
+	Path plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
+// createJavaArchives creates a new instance of this resource
+func createJavaArchives(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJavaArchives{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("java.archives", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlJavaArchives) MqlName() string {
+	return "java.archives"
+}
+
+func (c *mqlJavaArchives) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlJavaArchives) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlJavaArchives) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("java.archives", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlJavaArchive for the java.archive resource
+type mqlJavaArchive struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlJavaArchiveInternal it will be used here
+	Path plugin.TValue[string]
+	Name plugin.TValue[string]
+	Version plugin.TValue[string]
+	GroupId plugin.TValue[string]
+	ArtifactId plugin.TValue[string]
+	Manifest plugin.TValue[map[string]interface{}]
+	File plugin.TValue[*mqlFile]
+}
+
+// createJavaArchive creates a new instance of this resource
+func createJavaArchive(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJavaArchive{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("java.archive", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlJavaArchive) MqlName() string {
+	return "java.archive"
+}
+
+func (c *mqlJavaArchive) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlJavaArchive) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlJavaArchive) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlJavaArchive) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlJavaArchive) GetGroupId() *plugin.TValue[string] {
+	return &c.GroupId
+}
+
+func (c *mqlJavaArchive) GetArtifactId() *plugin.TValue[string] {
+	return &c.ArtifactId
+}
+
+func (c *mqlJavaArchive) GetManifest() *plugin.TValue[map[string]interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlJavaArchive) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+// mqlRubyGems for the ruby.gems resource
+type mqlRubyGems struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlRubyGemsInternal it will be used here
+	Path plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
+// createRubyGems creates a new instance of this resource
+func createRubyGems(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRubyGems{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ruby.gems", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlRubyGems) MqlName() string {
+	return "ruby.gems"
+}
+
+func (c *mqlRubyGems) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlRubyGems) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlRubyGems) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ruby.gems", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlRubyGem for the ruby.gem resource
+type mqlRubyGem struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlRubyGemInternal it will be used here
+	Id plugin.TValue[string]
+	Name plugin.TValue[string]
+	Version plugin.TValue[string]
+	Summary plugin.TValue[string]
+	Licenses plugin.TValue[[]interface{}]
+	Authors plugin.TValue[[]interface{}]
+	File plugin.TValue[*mqlFile]
+}
+
+// createRubyGem creates a new instance of this resource
+func createRubyGem(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRubyGem{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ruby.gem", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlRubyGem) MqlName() string {
+	return "ruby.gem"
+}
+
+func (c *mqlRubyGem) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlRubyGem) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlRubyGem) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlRubyGem) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlRubyGem) GetSummary() *plugin.TValue[string] {
+	return &c.Summary
+}
+
+func (c *mqlRubyGem) GetLicenses() *plugin.TValue[[]interface{}] {
+	return &c.Licenses
+}
+
+func (c *mqlRubyGem) GetAuthors() *plugin.TValue[[]interface{}] {
+	return &c.Authors
+}
+
+func (c *mqlRubyGem) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+// mqlCargoPackages for the cargo.packages resource
+type mqlCargoPackages struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlCargoPackagesInternal it will be used here
+	Path plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
+// createCargoPackages creates a new instance of this resource
+func createCargoPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlCargoPackages{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("cargo.packages", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlCargoPackages) MqlName() string {
+	return "cargo.packages"
+}
+
+func (c *mqlCargoPackages) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlCargoPackages) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlCargoPackages) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("cargo.packages", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlCargoPackage for the cargo.package resource
+type mqlCargoPackage struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlCargoPackageInternal it will be used here
+	Id plugin.TValue[string]
+	Name plugin.TValue[string]
+	Version plugin.TValue[string]
+	Source plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+}
+
+// createCargoPackage creates a new instance of this resource
+func createCargoPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlCargoPackage{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("cargo.package", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlCargoPackage) MqlName() string {
+	return "cargo.package"
+}
+
+func (c *mqlCargoPackage) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlCargoPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlCargoPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlCargoPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlCargoPackage) GetSource() *plugin.TValue[string] {
+	return &c.Source
+}
+
+func (c *mqlCargoPackage) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
 // mqlMacos for the macos resource
 type mqlMacos struct {
 	MqlRuntime *plugin.Runtime
@@ -10,9 +10,12 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/providers/os/connection/shared"
 	"go.mondoo.com/cnquery/providers/os/resources/packages"
+	"go.mondoo.com/cnquery/sbom/cpe"
+	"go.mondoo.com/cnquery/sbom/purl"
 	"go.mondoo.com/cnquery/utils/multierr"
 )
 
@@ -69,6 +72,102 @@ func (p *mqlPackage) origin() (string, error) {
 	return "", nil
 }
 
+type mqlPackageInternal struct {
+	lock          sync.Mutex
+	pm            packages.OperatingSystemPkgManager
+	pkg           packages.Package
+	detailsLoaded bool
+	filesLoaded   bool
+}
+
+// details returns the package with its vendor, licenses and files. They are
+// only looked up once, since some package managers need extra calls for it.
+// Files are only looked up if they are needed and not part of the details.
+func (p *mqlPackage) details(withFiles bool) (*packages.Package, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if dm, ok := p.pm.(packages.OperatingSystemPkgDetails); ok && !p.detailsLoaded {
+		p.detailsLoaded = true
+		if err := dm.Details(&p.pkg); err != nil {
+			log.Debug().Err(err).Str("package", p.pkg.Name).Msg("mql[packages]> could not retrieve package details")
+		}
+	}
+
+	if fm, ok := p.pm.(packages.OperatingSystemPkgFiles); ok && withFiles && !p.filesLoaded {
+		p.filesLoaded = true
+		if err := fm.Files(&p.pkg); err != nil {
+			log.Debug().Err(err).Str("package", p.pkg.Name).Msg("mql[packages]> could not retrieve package files")
+		}
+	}
+	return &p.pkg, nil
+}
+
+func (p *mqlPackage) vendor() (string, error) {
+	pkg, err := p.details(false)
+	if err != nil {
+		return "", err
+	}
+	return pkg.Vendor, nil
+}
+
+func (p *mqlPackage) licenses() ([]interface{}, error) {
+	pkg, err := p.details(false)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]interface{}, len(pkg.Licenses))
+	for i := range pkg.Licenses {
+		res[i] = pkg.Licenses[i]
+	}
+	return res, nil
+}
+
+func (p *mqlPackage) files() ([]interface{}, error) {
+	pkg, err := p.details(true)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(pkg.Files))
+	for i := range pkg.Files {
+		res[i], err = CreateResource(p.MqlRuntime, "file", map[string]*llx.RawData{
+			"path": llx.StringData(pkg.Files[i]),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (p *mqlPackage) purl() (string, error) {
+	origin := p.GetOrigin()
+	if origin.Error != nil {
+		return "", origin.Error
+	}
+
+	var platform *inventory.Platform
+	if asset := p.MqlRuntime.Connection.(shared.Connection).Asset(); asset != nil {
+		platform = asset.Platform
+	}
+	return purl.NewPackageURL(platform, p.Format.Data, p.Name.Data, p.Version.Data, p.Arch.Data, origin.Data), nil
+}
+
+func (p *mqlPackage) cpes() ([]interface{}, error) {
+	origin := p.GetOrigin()
+	if origin.Error != nil {
+		return nil, origin.Error
+	}
+
+	cpes := cpe.NewPackageCpes(p.Format.Data, p.Name.Data, origin.Data, p.Version.Data)
+	res := make([]interface{}, len(cpes))
+	for i := range cpes {
+		res[i] = cpes[i]
+	}
+	return res, nil
+}
+
 type mqlPackagesInternal struct {
 	lock           sync.Mutex
 	packagesByName map[string]*mqlPackage
@@ -132,6 +231,10 @@ func (x *mqlPackages) list() ([]interface{}, error) {
 			return nil, err
 		}
 
+		mqlPkg := pkg.(*mqlPackage)
+		mqlPkg.pm = pm
+		mqlPkg.pkg = osPkg
+
 		pkgs[i] = pkg
 	}
 
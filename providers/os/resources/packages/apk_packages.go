@@ -7,6 +7,7 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"path"
 	"regexp"
 
 	"github.com/rs/zerolog/log"
@@ -27,6 +28,7 @@ func ParseApkDbPackages(input io.Reader) []Package {
 
 	var pkgVersion string
 	var pkgEpoch string
+	var pkgDir string
 
 	add := func(pkg Package) {
 		// merge version and epoch
@@ -58,6 +60,7 @@ func ParseApkDbPackages(input io.Reader) []Package {
 			// reset values
 			pkgEpoch = ""
 			pkgVersion = ""
+			pkgDir = ""
 			pkg = Package{}
 		}
 
@@ -86,6 +89,15 @@ func ParseApkDbPackages(input io.Reader) []Package {
 			pkg.Origin = m[2] // origin
 		case "T":
 			pkg.Description = m[2] // description
+		case "L":
+			pkg.Licenses = []string{m[2]} // license
+		case "m":
+			pkg.Vendor = maintainerName(m[2]) // maintainer
+		case "F":
+			pkgDir = "/" + m[2] // directory
+			pkg.Files = append(pkg.Files, pkgDir)
+		case "R":
+			pkg.Files = append(pkg.Files, path.Join("/", pkgDir, m[2])) // file in the last directory
 		}
 	}
 
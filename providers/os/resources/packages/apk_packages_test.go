@@ -33,9 +33,17 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Description: "the musl c library (libc) implementation",
 		Origin:      "musl",
 		Format:      packages.AlpinePkgFormat,
+		Vendor:      "Timo Teräs",
+		Licenses:    []string{"MIT"},
+		Files:       []string{"/lib", "/lib/libc.musl-x86_64.so.1", "/lib/ld-musl-x86_64.so.1", "/usr", "/usr/lib"},
 	}
 	assert.Contains(t, m, p, "musl detected")
 
+	// files are only compared for musl
+	for i := range m {
+		m[i].Files = nil
+	}
+
 	p = packages.Package{
 		Name:        "libressl2.6-libcrypto",
 		Version:     "1510257703:2.6.3-r0",
@@ -43,6 +51,8 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Description: "libressl libcrypto library",
 		Origin:      "libressl",
 		Format:      packages.AlpinePkgFormat,
+		Vendor:      "Orion",
+		Licenses:    []string{"custom"},
 	}
 	assert.Contains(t, m, p, "libcrypto detected")
 
@@ -53,6 +63,8 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Description: "libressl libssl library",
 		Origin:      "libressl",
 		Format:      packages.AlpinePkgFormat,
+		Vendor:      "Orion",
+		Licenses:    []string{"custom"},
 	}
 	assert.Contains(t, m, p, "libssl detected")
 
@@ -63,6 +75,8 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Description: "Alpine Package Keeper - package manager for alpine",
 		Origin:      "apk-tools",
 		Format:      packages.AlpinePkgFormat,
+		Vendor:      "Natanael Copa",
+		Licenses:    []string{"GPL2"},
 	}
 	assert.Contains(t, m, p, "apk-tools detected")
 
@@ -73,6 +87,8 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Description: "Size optimized toolbox of many common UNIX utilities",
 		Origin:      "busybox",
 		Format:      packages.AlpinePkgFormat,
+		Vendor:      "Natanael Copa",
+		Licenses:    []string{"GPL2"},
 	}
 	assert.Contains(t, m, p, "apk-tools detected")
 
@@ -83,6 +99,8 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Description: "Alpine base dir structure and init scripts",
 		Origin:      "alpine-baselayout",
 		Format:      packages.AlpinePkgFormat,
+		Vendor:      "Natanael Copa",
+		Licenses:    []string{"GPL2"},
 	}
 	assert.Contains(t, m, p, "apk-tools detected")
 }
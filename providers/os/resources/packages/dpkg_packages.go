@@ -68,6 +68,8 @@ func ParseDpkgPackages(input io.Reader) ([]Package, error) {
 			pkg.Arch = strings.TrimSpace(m[2])
 		case key == "Status":
 			pkg.Status = strings.TrimSpace(m[2])
+		case key == "Maintainer":
+			pkg.Vendor = maintainerName(m[2])
 		case key == "Source":
 			o := DPKG_ORIGIN_REGEX.FindStringSubmatch(m[2])
 			if o != nil && len(o) >= 1 {
@@ -91,6 +93,46 @@ func ParseDpkgPackages(input io.Reader) ([]Package, error) {
 	return pkgs, nil
 }
 
+// ParseDpkgFiles parses the list of files owned by a package, located in
+// /var/lib/dpkg/info/<name>.list
+func ParseDpkgFiles(input io.Reader) []string {
+	files := []string{}
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		// every list starts with the root directory
+		if line == "" || line == "/." {
+			continue
+		}
+		files = append(files, line)
+	}
+	return files
+}
+
+var DPKG_LICENSE_REGEX = regexp.MustCompile(`^License:\s*(.+)$`)
+
+// ParseDpkgCopyright extracts the licenses from a machine-readable copyright
+// file located in /usr/share/doc/<name>/copyright
+// See https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/
+func ParseDpkgCopyright(input io.Reader) []string {
+	licenses := []string{}
+	seen := map[string]struct{}{}
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		m := DPKG_LICENSE_REGEX.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		license := strings.TrimSpace(m[1])
+		if _, ok := seen[license]; ok {
+			continue
+		}
+		seen[license] = struct{}{}
+		licenses = append(licenses, license)
+	}
+	return licenses
+}
+
 var DPKG_UPDATE_REGEX = regexp.MustCompile(`^Inst\s([a-zA-Z0-9.\-_]+)\s\[([a-zA-Z0-9.\-\+]+)\]\s\(([a-zA-Z0-9.\-\+]+)\s*(.*)\)(.*)$`)
 
 func ParseDpkgUpdates(input io.Reader) (map[string]PackageUpdate, error) {
@@ -187,6 +229,36 @@ func (dpm *DebPkgManager) List() ([]Package, error) {
 	return pkgList, nil
 }
 
+// Details reads the files and licenses of a package, which dpkg stores
+// next to its status file
+func (dpm *DebPkgManager) Details(pkg *Package) error {
+	fs := dpm.conn.FileSystem()
+
+	// multi-arch packages include their arch in the list name
+	lists := []string{
+		"/var/lib/dpkg/info/" + pkg.Name + ".list",
+		"/var/lib/dpkg/info/" + pkg.Name + ":" + pkg.Arch + ".list",
+	}
+	for i := range lists {
+		f, err := fs.Open(lists[i])
+		if err != nil {
+			continue
+		}
+		pkg.Files = ParseDpkgFiles(f)
+		f.Close()
+		break
+	}
+
+	f, err := fs.Open("/usr/share/doc/" + pkg.Name + "/copyright")
+	if err != nil {
+		log.Debug().Err(err).Str("package", pkg.Name).Msg("mql[packages]> could not read copyright file")
+		return nil
+	}
+	defer f.Close()
+	pkg.Licenses = ParseDpkgCopyright(f)
+	return nil
+}
+
 func (dpm *DebPkgManager) Available() (map[string]PackageUpdate, error) {
 	// TODO: run this as a complete shell script in motor
 	// DEBIAN_FRONTEND=noninteractive apt-get update >/dev/null 2>&1
@@ -4,6 +4,7 @@
 package packages_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -42,6 +43,7 @@ The fdisk utility is the classical text-mode utility.
 The cfdisk utilitity gives a more userfriendly curses based interface.
 The sfdisk utility is mostly for automation and scripting uses.`,
 		Format: "deb",
+		Vendor: "Ubuntu Developers",
 	}
 	assert.Contains(t, m, p, "fdisk detected")
 
@@ -56,6 +58,7 @@ The audit-libs package contains the dynamic libraries needed for
 applications to use the audit framework. It is used to monitor systems for
 security related events.`,
 		Format: "deb",
+		Vendor: "Laurent Bigonville",
 	}
 	assert.Contains(t, m, p, "libaudit1 detected")
 }
@@ -82,6 +85,7 @@ several important miscellaneous files, such as /etc/debian_version,
 /etc/host.conf, /etc/issue, /etc/motd, /etc/profile, and others,
 and the text of several common licenses in use on Debian systems.`,
 		Format: "deb",
+		Vendor: "Santiago Vila",
 	}
 	assert.Contains(t, m, p, "fdisk detected")
 }
@@ -107,3 +111,38 @@ func TestDpkgUpdateParser(t *testing.T) {
 	assert.Equal(t, "6.1-1ubuntu1", update.Version, "pkg version detected")
 	assert.Equal(t, "6.1-1ubuntu1.18.04", update.Available, "pkg available version detected")
 }
+
+func TestDpkgFilesParser(t *testing.T) {
+	list := `/.
+/usr
+/usr/bin
+/usr/bin/fdisk
+/usr/share/doc/fdisk/copyright
+`
+	files := packages.ParseDpkgFiles(strings.NewReader(list))
+	assert.Equal(t, []string{"/usr", "/usr/bin", "/usr/bin/fdisk", "/usr/share/doc/fdisk/copyright"}, files)
+}
+
+func TestDpkgCopyrightParser(t *testing.T) {
+	copyright := `Format: https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/
+Upstream-Name: util-linux
+
+Files: *
+Copyright: 1990-2018 Karel Zak
+License: GPL-2+
+
+Files: libblkid/*
+Copyright: 2008-2018 Karel Zak
+License: LGPL-2.1+
+
+Files: disk-utils/*
+Copyright: 2012 Davidlohr Bueso
+License: GPL-2+
+
+License: GPL-2+
+ This program is free software; you can redistribute it and/or modify
+ it under the terms of the GNU General Public License.
+`
+	licenses := packages.ParseDpkgCopyright(strings.NewReader(copyright))
+	assert.Equal(t, []string{"GPL-2+", "LGPL-2.1+"}, licenses)
+}
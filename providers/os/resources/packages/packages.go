@@ -4,6 +4,9 @@
 package packages
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/cockroachdb/errors"
 	"go.mondoo.com/cnquery/providers/os/connection/shared"
 )
@@ -20,6 +23,12 @@ type Package struct {
 	// o 	Package Origin - https://wiki.alpinelinux.org/wiki/Apk_spec
 	Origin string `json:"origin"`
 	Format string `json:"format"`
+
+	// Vendor is the organization or maintainer that ships the package
+	Vendor   string   `json:"vendor,omitempty"`
+	Licenses []string `json:"licenses,omitempty"`
+	// Files lists all files and directories owned by the package
+	Files []string `json:"files,omitempty"`
 }
 
 // extends Package to store available version
@@ -37,6 +46,28 @@ type OperatingSystemPkgManager interface {
 	Available() (map[string]PackageUpdate, error)
 }
 
+// OperatingSystemPkgDetails is implemented by package managers that
+// store licenses, vendor and files outside of their package list. They are
+// only looked up when requested, since this may be expensive.
+type OperatingSystemPkgDetails interface {
+	Details(pkg *Package) error
+}
+
+// OperatingSystemPkgFiles is implemented by package managers that list
+// licenses and vendor with their packages, but need an extra lookup for the
+// files of a package. They are only looked up when requested.
+type OperatingSystemPkgFiles interface {
+	Files(pkg *Package) error
+}
+
+var maintainerEmail = regexp.MustCompile(`\s*<[^>]*>\s*$`)
+
+// maintainerName removes the email address from a maintainer, e.g.
+// "Ubuntu Developers <ubuntu-devel-discuss@lists.ubuntu.com>"
+func maintainerName(maintainer string) string {
+	return maintainerEmail.ReplaceAllString(strings.TrimSpace(maintainer), "")
+}
+
 // this will find the right package manager for the operating system
 func ResolveSystemPkgManager(conn shared.Connection) (OperatingSystemPkgManager, error) {
 	var pm OperatingSystemPkgManager
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"go.mondoo.com/cnquery/providers/os/connection/shared"
@@ -36,6 +37,28 @@ func ParsePacmanPackages(input io.Reader) []Package {
 	return pkgs
 }
 
+// ParsePacmanDesc parses the sections of the desc and files entries in the
+// local pacman database, e.g. /var/lib/pacman/local/<name>-<version>/desc
+// Every section starts with its %NAME% and ends with an empty line.
+func ParsePacmanDesc(input io.Reader) map[string][]string {
+	res := map[string][]string{}
+	scanner := bufio.NewScanner(input)
+	section := ""
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			section = ""
+		case section == "" && strings.HasPrefix(line, "%") && strings.HasSuffix(line, "%"):
+			section = strings.Trim(line, "%")
+			res[section] = []string{}
+		case section != "":
+			res[section] = append(res[section], line)
+		}
+	}
+	return res
+}
+
 // Arch, Manjaro
 type PacmanPkgManager struct {
 	conn shared.Connection
@@ -58,6 +81,37 @@ func (ppm *PacmanPkgManager) List() ([]Package, error) {
 	return ParsePacmanPackages(cmd.Stdout), nil
 }
 
+// Details reads the licenses, packager and files of a package from the
+// local pacman database
+func (ppm *PacmanPkgManager) Details(pkg *Package) error {
+	fs := ppm.conn.FileSystem()
+	dir := "/var/lib/pacman/local/" + pkg.Name + "-" + pkg.Version
+
+	f, err := fs.Open(dir + "/desc")
+	if err != nil {
+		return errors.Wrap(err, "could not read pacman package description")
+	}
+	desc := ParsePacmanDesc(f)
+	f.Close()
+
+	pkg.Licenses = desc["LICENSE"]
+	if packager := desc["PACKAGER"]; len(packager) != 0 {
+		pkg.Vendor = maintainerName(packager[0])
+	}
+
+	f, err = fs.Open(dir + "/files")
+	if err != nil {
+		return errors.Wrap(err, "could not read pacman package files")
+	}
+	defer f.Close()
+	files := ParsePacmanDesc(f)["FILES"]
+	pkg.Files = make([]string, len(files))
+	for i := range files {
+		pkg.Files[i] = "/" + strings.TrimSuffix(files[i], "/")
+	}
+	return nil
+}
+
 func (ppm *PacmanPkgManager) Available() (map[string]PackageUpdate, error) {
 	return nil, errors.New("Available() not implemented for pacman")
 }
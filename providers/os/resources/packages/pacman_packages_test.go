@@ -65,3 +65,32 @@ argon2 20190702-2`
 	}
 	assert.Contains(t, m, p, "pkg detected")
 }
+
+func TestPacmanDescParser(t *testing.T) {
+	desc := `%NAME%
+acl
+
+%VERSION%
+2.3.1-3
+
+%LICENSE%
+LGPL
+GPL
+
+%PACKAGER%
+Christian Hesse <eworm@archlinux.org>
+
+`
+	m := packages.ParsePacmanDesc(strings.NewReader(desc))
+	assert.Equal(t, []string{"acl"}, m["NAME"])
+	assert.Equal(t, []string{"LGPL", "GPL"}, m["LICENSE"])
+	assert.Equal(t, []string{"Christian Hesse <eworm@archlinux.org>"}, m["PACKAGER"])
+
+	files := `%FILES%
+usr/
+usr/bin/
+usr/bin/chacl
+`
+	m = packages.ParsePacmanDesc(strings.NewReader(files))
+	assert.Equal(t, []string{"usr/", "usr/bin/", "usr/bin/chacl"}, m["FILES"])
+}
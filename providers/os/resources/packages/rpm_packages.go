@@ -5,7 +5,6 @@ package packages
 
 import (
 	"bufio"
-	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -13,6 +12,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 	rpmdb "github.com/knqyf263/go-rpmdb/pkg"
@@ -29,6 +29,8 @@ const (
 var RPM_REGEX = regexp.MustCompile(`^([\w-+]*)\s(\d*|\(none\)):([\w\d-+.:]+)\s([\w\d]*|\(none\))\s(.*)$`)
 
 // ParseRpmPackages parses output from:
+// rpm -qa --queryformat '%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{VENDOR}\t%{LICENSE}\t%{SUMMARY}\n'
+// It also parses lines without vendor and license, e.g. from:
 // rpm -qa --queryformat '%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{SUMMARY}\n'
 func ParseRpmPackages(input io.Reader) []Package {
 	pkgs := []Package{}
@@ -51,18 +53,49 @@ func ParseRpmPackages(input io.Reader) []Package {
 				arch = ""
 			}
 
-			pkgs = append(pkgs, Package{
+			pkg := Package{
 				Name:        m[1],
 				Version:     version,
 				Arch:        arch,
 				Description: m[5],
 				Format:      RpmPkgFormat,
-			})
+			}
+			if fields := strings.SplitN(m[5], "\t", 3); len(fields) == 3 {
+				pkg.Vendor = rpmTag(fields[0])
+				if license := rpmTag(fields[1]); license != "" {
+					pkg.Licenses = []string{license}
+				}
+				pkg.Description = fields[2]
+			}
+			pkgs = append(pkgs, pkg)
 		}
 	}
 	return pkgs
 }
 
+// rpmTag returns the value of an rpm tag, which rpm prints as (none) if
+// the package doesn't set it
+func rpmTag(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "(none)" {
+		return ""
+	}
+	return value
+}
+
+// ParseRpmFiles parses the files of a package from:
+// rpm -q --queryformat '[%{FILENAMES}\n]' <package>
+func ParseRpmFiles(input io.Reader) []string {
+	files := []string{}
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		if line := rpmTag(scanner.Text()); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files
+}
+
 // RpmPkgManager is the package manager for Redhat, CentOS, Oracle, Photon and Suse
 // it support two modes: runtime where the rpm command is available and static analysis for images (e.g. container tar)
 // If the RpmPkgManager is used in static mode, it extracts the rpm database from the system and copies it to the local
@@ -73,6 +106,10 @@ type RpmPkgManager struct {
 	platform      *inventory.Platform
 	staticChecked bool
 	static        bool
+	// packages from the rpm database of static analysis, by their ID,
+	// so that their files are only read when they are needed
+	staticLock     sync.Mutex
+	staticPackages map[string]*rpmdb.PackageInfo
 }
 
 func (rpm *RpmPkgManager) Name() string {
@@ -133,14 +170,14 @@ func (rpm *RpmPkgManager) queryFormat() string {
 	// this format should work everywhere
 	// fall-back to epoch instead of epochnum for 6 ish platforms, latest 6 platforms also support epochnum, but we
 	// save 1 call by not detecting the available keyword via rpm --querytags
-	format := "%{NAME} %{EPOCH}:%{VERSION}-%{RELEASE} %{ARCH} %{SUMMARY}\\n"
+	format := "%{NAME} %{EPOCH}:%{VERSION}-%{RELEASE} %{ARCH} %{VENDOR}\\t%{LICENSE}\\t%{SUMMARY}\\n"
 
 	// ATTENTION: EPOCHNUM is only available since later version of rpm in RedHat 6 and Suse 12
 	// we can only expect if for rhel 7+, therefore we need to run an extra test
 	// be aware that this method is also used for non-redhat systems like suse
 	i, err := strconv.ParseInt(rpm.platform.Version, 0, 32)
 	if err == nil && (rpm.platform.Name == "centos" || rpm.platform.Name == "redhat") && i >= 7 {
-		format = "%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{SUMMARY}\\n"
+		format = "%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{VENDOR}\\t%{LICENSE}\\t%{SUMMARY}\\n"
 	}
 
 	return format
@@ -155,6 +192,32 @@ func (rpm *RpmPkgManager) runtimeList() ([]Package, error) {
 	return ParseRpmPackages(cmd.Stdout), nil
 }
 
+// Files looks up the files of a package. Vendor and license are already
+// part of the package list. Static analysis reads the files from the rpm
+// database that it listed packages from.
+func (rpm *RpmPkgManager) Files(pkg *Package) error {
+	if rpm.isStaticAnalysis() {
+		return rpm.staticFiles(pkg)
+	}
+
+	// the epoch is not part of the package name rpm expects
+	nevra := pkg.Name + "-" + pkg.Version
+	if _, version, ok := strings.Cut(pkg.Version, ":"); ok {
+		nevra = pkg.Name + "-" + version
+	}
+	if pkg.Arch != "" {
+		nevra += "." + pkg.Arch
+	}
+
+	command := fmt.Sprintf("rpm -q --queryformat '[%%{FILENAMES}\\n]' '%s'", nevra)
+	cmd, err := rpm.conn.RunCommand(command)
+	if err != nil {
+		return errors.Wrap(err, "could not read package files")
+	}
+	pkg.Files = ParseRpmFiles(cmd.Stdout)
+	return nil
+}
+
 // fetch all available packages, is that working with centos 6?
 func (rpm *RpmPkgManager) runtimeAvailable() (map[string]PackageUpdate, error) {
 	// python script:
@@ -226,7 +289,6 @@ func (rpm *RpmPkgManager) staticList() ([]Package, error) {
 
 	log.Debug().Str("rpmdb", rpmTmpDir).Msg("mql[packages]> cached rpm database locally")
 
-	packages := bytes.Buffer{}
 	db, err := rpmdb.Open(tmpRpmDBFile)
 	if err != nil {
 		return nil, err
@@ -235,11 +297,50 @@ func (rpm *RpmPkgManager) staticList() ([]Package, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	res := []Package{}
+	staticPackages := make(map[string]*rpmdb.PackageInfo, len(pkgList))
 	for _, pkg := range pkgList {
-		packages.WriteString(fmt.Sprintf("%s %d:%s-%s %s %s\n", pkg.Name, pkg.EpochNum(), pkg.Version, pkg.Release, pkg.Arch, pkg.Summary))
+		line := fmt.Sprintf("%s %d:%s-%s %s %s\n", pkg.Name, pkg.EpochNum(), pkg.Version, pkg.Release, pkg.Arch, pkg.Summary)
+		parsed := ParseRpmPackages(strings.NewReader(line))
+		if len(parsed) == 0 {
+			continue
+		}
+		parsed[0].Vendor = pkg.Vendor
+		if pkg.License != "" {
+			parsed[0].Licenses = []string{pkg.License}
+		}
+		res = append(res, parsed[0])
+		staticPackages[rpmPackageID(&parsed[0])] = pkg
 	}
 
-	return ParseRpmPackages(&packages), nil
+	rpm.staticLock.Lock()
+	rpm.staticPackages = staticPackages
+	rpm.staticLock.Unlock()
+
+	return res, nil
+}
+
+func rpmPackageID(pkg *Package) string {
+	return pkg.Name + "/" + pkg.Version + "/" + pkg.Arch
+}
+
+// staticFiles reads the files of a package from the rpm database that was
+// read by staticList
+func (rpm *RpmPkgManager) staticFiles(pkg *Package) error {
+	rpm.staticLock.Lock()
+	info, ok := rpm.staticPackages[rpmPackageID(pkg)]
+	rpm.staticLock.Unlock()
+	if !ok {
+		return errors.New("could not find package " + pkg.Name + " in the rpm database")
+	}
+
+	files, err := info.InstalledFileNames()
+	if err != nil {
+		return errors.Wrap(err, "could not read package files")
+	}
+	pkg.Files = files
+	return nil
 }
 
 // TODO: Available() not implemented for RpmFileSystemManager
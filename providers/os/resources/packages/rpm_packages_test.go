@@ -9,11 +9,13 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	rpmdb "github.com/knqyf263/go-rpmdb/pkg"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/providers/os/connection/mock"
 	"go.mondoo.com/cnquery/providers/os/resources/packages"
 )
@@ -24,7 +26,7 @@ func TestRedhat7Parser(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	c, err := mock.RunCommand("rpm -qa --queryformat '%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{SUMMARY}\\n'")
+	c, err := mock.RunCommand("rpm -qa --queryformat '%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{VENDOR}\\t%{LICENSE}\\t%{SUMMARY}\\n'")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -39,15 +41,28 @@ func TestRedhat7Parser(t *testing.T) {
 		Arch:        "noarch",
 		Description: "Descriptions of common terminals",
 		Format:      packages.RpmPkgFormat,
+		Vendor:      "CentOS",
 	}
 	assert.Contains(t, m, p, "ncurses-base")
 
+	p = packages.Package{
+		Name:        "bash",
+		Version:     "4.2.46-30.el7",
+		Arch:        "x86_64",
+		Description: "The GNU Bourne Again shell",
+		Format:      packages.RpmPkgFormat,
+		Vendor:      "CentOS",
+		Licenses:    []string{"GPLv3+"},
+	}
+	assert.Contains(t, m, p, "bash")
+
 	p = packages.Package{
 		Name:        "libstdc++",
 		Version:     "4.8.5-28.el7_5.1",
 		Arch:        "x86_64",
 		Description: "GNU Standard C++ Library",
 		Format:      packages.RpmPkgFormat,
+		Vendor:      "CentOS",
 	}
 	assert.Contains(t, m, p, "libstdc detected")
 
@@ -57,6 +72,7 @@ func TestRedhat7Parser(t *testing.T) {
 		Arch:        "x86_64",
 		Description: "Network monitoring tools including ping",
 		Format:      packages.RpmPkgFormat,
+		Vendor:      "CentOS",
 	}
 	assert.Contains(t, m, p, "gpg-pubkey detected")
 
@@ -66,6 +82,7 @@ func TestRedhat7Parser(t *testing.T) {
 		Arch:        "x86_64",
 		Description: "A general purpose cryptography library with TLS implementation",
 		Format:      packages.RpmPkgFormat,
+		Vendor:      "CentOS",
 	}
 	assert.Contains(t, m, p, "gpg-pubkey detected")
 
@@ -75,6 +92,7 @@ func TestRedhat7Parser(t *testing.T) {
 		Arch:        "x86_64",
 		Description: "Libraries for accessing D-BUS",
 		Format:      packages.RpmPkgFormat,
+		Vendor:      "CentOS",
 	}
 	assert.Contains(t, m, p, "gpg-pubkey detected")
 }
@@ -85,7 +103,7 @@ func TestRedhat6Parser(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	c, err := mock.RunCommand("rpm -qa --queryformat '%{NAME} %{EPOCH}:%{VERSION}-%{RELEASE} %{ARCH} %{SUMMARY}\\n'")
+	c, err := mock.RunCommand("rpm -qa --queryformat '%{NAME} %{EPOCH}:%{VERSION}-%{RELEASE} %{ARCH} %{VENDOR}\\t%{LICENSE}\\t%{SUMMARY}\\n'")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -196,3 +214,41 @@ func TestPhoton4ImageParser(t *testing.T) {
 	}
 	assert.Contains(t, m, p, "sqlite-libs")
 }
+
+func TestPhoton4ImageDetails(t *testing.T) {
+	conn, err := mock.New("./testdata/packages_photon_image.toml", &inventory.Asset{
+		Platform: &inventory.Platform{
+			Name: "photon",
+		},
+	})
+	require.NoError(t, err)
+
+	pkgManager, err := packages.ResolveSystemPkgManager(conn)
+	require.NoError(t, err)
+
+	pkgList, err := pkgManager.List()
+	require.NoError(t, err)
+
+	var bash packages.Package
+	for i := range pkgList {
+		if pkgList[i].Name == "bash" {
+			bash = pkgList[i]
+		}
+	}
+	require.Equal(t, "bash", bash.Name)
+	assert.Equal(t, "VMware, Inc.", bash.Vendor)
+	assert.Equal(t, []string{"GPLv3"}, bash.Licenses)
+	// files are only read from the rpm database when requested
+	assert.Empty(t, bash.Files)
+
+	require.NoError(t, pkgManager.(packages.OperatingSystemPkgFiles).Files(&bash))
+	assert.Contains(t, bash.Files, "/bin/bash")
+}
+
+func TestRpmFilesParser(t *testing.T) {
+	files := packages.ParseRpmFiles(strings.NewReader("/usr/bin/bash\n/usr/share/doc/bash\n"))
+	assert.Equal(t, []string{"/usr/bin/bash", "/usr/share/doc/bash"}, files)
+
+	files = packages.ParseRpmFiles(strings.NewReader("(none)\n"))
+	assert.Empty(t, files)
+}
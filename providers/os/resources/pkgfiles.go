@@ -0,0 +1,125 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"regexp"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+)
+
+// directories that are scanned for Go and Rust binaries by default
+var binaryDirectories = []string{
+	"/bin",
+	"/sbin",
+	"/usr/bin",
+	"/usr/sbin",
+	"/usr/local/bin",
+	"/usr/local/sbin",
+	"/usr/local/cargo/bin",
+	"/opt",
+	"/app",
+	"/ko-app",
+}
+
+// files larger than this are not read into memory while we look for packages
+const maxPackageFileSize = 512 << 20
+
+// magic bytes of ELF, PE and Mach-O executables
+var executableMagic = [][]byte{
+	{0x7f, 'E', 'L', 'F'},
+	{'M', 'Z'},
+	{0xfe, 0xed, 0xfa, 0xce},
+	{0xfe, 0xed, 0xfa, 0xcf},
+	{0xce, 0xfa, 0xed, 0xfe},
+	{0xcf, 0xfa, 0xed, 0xfe},
+}
+
+// findPackageFiles returns all regular files below a path which fully match
+// the regex, or all files if it is nil. It uses the file search of the
+// connection if it has one, e.g. for tar and docker images, and walks the
+// filesystem otherwise.
+func findPackageFiles(conn shared.Connection, from string, r *regexp.Regexp) ([]string, error) {
+	fs := conn.FileSystem()
+	if fsSearch, ok := fs.(shared.FileSearch); ok {
+		res, err := fsSearch.Find(from, r, "file")
+		if err == nil {
+			return res, nil
+		}
+		log.Debug().Err(err).Str("path", from).Msg("mql[packages]> file search failed, walk filesystem instead")
+	}
+
+	afs := &afero.Afero{Fs: fs}
+	if _, err := afs.Stat(from); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	res := []string{}
+	err := afs.Walk(from, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// skip everything we cannot read, e.g. due to permissions
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		if r != nil && r.FindString(path) != path {
+			return nil
+		}
+		res = append(res, path)
+		return nil
+	})
+	return res, err
+}
+
+// readPackageFile reads a file into memory. Not all connections support
+// random access to files, which is what binary and zip readers need.
+func readPackageFile(afs *afero.Afero, path string) ([]byte, error) {
+	f, err := afs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, maxPackageFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxPackageFileSize {
+		return nil, errors.New("file is too large: " + path)
+	}
+	return data, nil
+}
+
+// readExecutable reads a file if it is an executable and returns nil
+// otherwise, without reading more than its header
+func readExecutable(afs *afero.Afero, path string) ([]byte, error) {
+	f, err := afs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, 4)
+	_, err = io.ReadFull(f, header)
+	f.Close()
+	if err != nil {
+		// too short to be an executable
+		return nil, nil
+	}
+
+	for _, magic := range executableMagic {
+		if bytes.HasPrefix(header, magic) {
+			return readPackageFile(afs, path)
+		}
+	}
+	return nil, nil
+}
@@ -6,13 +6,17 @@ package resources
 import (
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/os/resources/lsof"
 	"go.mondoo.com/cnquery/providers/os/resources/processes"
 )
 
@@ -109,10 +113,232 @@ func (p *mqlProcess) gatherProcessInfo() error {
 	p.Executable = plugin.TValue[string]{Data: process.Executable, State: plugin.StateIsSet}
 	p.Command = plugin.TValue[string]{Data: process.Command, State: plugin.StateIsSet}
 	p.SocketInodes = plugin.TValue[[]int64]{Data: process.SocketInodes, State: plugin.StateIsSet}
+	p.setProcessInfo(process)
 
 	return nil
 }
 
+// setProcessInfo sets the fields that not all process managers can
+// determine, they are null if they are unknown
+func (p *mqlProcess) setProcessInfo(process *processes.OSProcess) {
+	p.Uid = intOrNull(process.Uid)
+	p.Ppid = intOrNull(process.PPid)
+	p.Memory = intOrNull(process.Memory)
+
+	if process.Capabilities != nil {
+		caps := llx.TArr2Raw(capabilityNamesFromMask(*process.Capabilities))
+		p.Capabilities = plugin.TValue[[]interface{}]{Data: caps, State: plugin.StateIsSet}
+	} else {
+		p.Capabilities = plugin.TValue[[]interface{}]{State: plugin.StateIsSet | plugin.StateIsNull}
+	}
+}
+
+func intOrNull(v int64) plugin.TValue[int64] {
+	if v < 0 {
+		return plugin.TValue[int64]{State: plugin.StateIsSet | plugin.StateIsNull}
+	}
+	return plugin.TValue[int64]{Data: v, State: plugin.StateIsSet}
+}
+
+func (p *mqlProcess) uid() (int64, error) {
+	return 0, p.gatherProcessInfo()
+}
+
+func (p *mqlProcess) ppid() (int64, error) {
+	return 0, p.gatherProcessInfo()
+}
+
+func (p *mqlProcess) memory() (int64, error) {
+	return 0, p.gatherProcessInfo()
+}
+
+func (p *mqlProcess) capabilities() ([]interface{}, error) {
+	return nil, p.gatherProcessInfo()
+}
+
+func (p *mqlProcess) user() (*mqlUser, error) {
+	uid := p.GetUid()
+	if uid.Error != nil {
+		return nil, uid.Error
+	}
+	if uid.State&plugin.StateIsNull != 0 {
+		p.User.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	raw, err := CreateResource(p.MqlRuntime, "users", nil)
+	if err != nil {
+		return nil, err
+	}
+	users := raw.(*mqlUsers)
+	if x := users.GetList(); x.Error != nil {
+		return nil, x.Error
+	}
+
+	// processes may run as users that are not known to the system, e.g. in
+	// containers, so they don't have a user
+	user, ok := users.usersByID[uid.Data]
+	if !ok {
+		p.User.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+	return user, nil
+}
+
+func (p *mqlProcess) parent() (*mqlProcess, error) {
+	ppid := p.GetPpid()
+	if ppid.Error != nil {
+		return nil, ppid.Error
+	}
+	// the first process and kernel threads have no parent
+	if ppid.State&plugin.StateIsNull != 0 || ppid.Data == 0 {
+		p.Parent.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	raw, err := CreateResource(p.MqlRuntime, "process", map[string]*llx.RawData{
+		"pid": llx.IntData(ppid.Data),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return raw.(*mqlProcess), nil
+}
+
+// processDetails returns the process manager if it can read details of
+// processes, which only some of them support
+func (p *mqlProcess) processDetails() (processes.OSProcessDetails, error) {
+	conn := p.MqlRuntime.Connection.(shared.Connection)
+	opm, err := processes.ResolveManager(conn)
+	if err != nil {
+		return nil, errors.New("cannot find process manager")
+	}
+
+	details, ok := opm.(processes.OSProcessDetails)
+	if !ok {
+		return nil, errors.New("process details are not supported by " + opm.Name())
+	}
+	return details, nil
+}
+
+func (p *mqlProcess) environment() (map[string]interface{}, error) {
+	pd, err := p.processDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := pd.Environment(p.Pid.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]interface{}, len(env))
+	for k, v := range env {
+		res[k] = v
+	}
+	return res, nil
+}
+
+func (p *mqlProcess) cwd() (string, error) {
+	pd, err := p.processDetails()
+	if err != nil {
+		return "", err
+	}
+	return pd.Cwd(p.Pid.Data)
+}
+
+func (p *mqlProcess) cgroup() ([]interface{}, error) {
+	pd, err := p.processDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	cgroup, err := pd.Cgroup(p.Pid.Data)
+	if err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(cgroup), nil
+}
+
+func (p *mqlProcess) namespaces() (map[string]interface{}, error) {
+	pd, err := p.processDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := pd.Namespaces(p.Pid.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]interface{}, len(namespaces))
+	for k, v := range namespaces {
+		res[k] = v
+	}
+	return res, nil
+}
+
+func (p *mqlProcess) startTime() (*time.Time, error) {
+	pd, err := p.processDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, err := pd.StartTime(p.Pid.Data)
+	if err != nil {
+		return nil, err
+	}
+	return MqlTime(startTime), nil
+}
+
+// openFiles reads the files of the process via lsof, sockets and other
+// files without a path are not included
+func (p *mqlProcess) openFiles() ([]interface{}, error) {
+	conn := p.MqlRuntime.Connection.(shared.Connection)
+	executedCmd, err := conn.RunCommand("lsof -nP -p " + strconv.FormatInt(p.Pid.Data, 10) + " -F")
+	if err != nil {
+		return nil, err
+	}
+	if executedCmd.ExitStatus != 0 {
+		stderr, _ := io.ReadAll(executedCmd.Stderr)
+		return nil, errors.New("could not read open files: " + strings.TrimSpace(string(stderr)))
+	}
+
+	lsofProcesses, err := lsof.Parse(executedCmd.Stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []interface{}{}
+	seen := map[string]struct{}{}
+	for i := range lsofProcesses {
+		if lsofProcesses[i].PID != strconv.FormatInt(p.Pid.Data, 10) {
+			continue
+		}
+
+		for _, fd := range lsofProcesses[i].FileDescriptors {
+			if !strings.HasPrefix(fd.Name, "/") {
+				continue
+			}
+			// lsof annotates some names, e.g. "/tmp/x (deleted)"
+			path, _, _ := strings.Cut(fd.Name, " (")
+			if _, ok := seen[path]; ok {
+				continue
+			}
+			seen[path] = struct{}{}
+
+			f, err := CreateResource(p.MqlRuntime, "file", map[string]*llx.RawData{
+				"path": llx.StringData(path),
+			})
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, f)
+		}
+	}
+	return res, nil
+}
+
 type mqlProcessesInternal struct {
 	ByPID      map[int64]*mqlProcess
 	BySocketID map[int64]*mqlProcess
@@ -155,6 +381,7 @@ func (p *mqlProcesses) list() ([]interface{}, error) {
 			Error: proc.SocketInodesError,
 			State: plugin.StateIsSet,
 		}
+		process.setProcessInfo(proc)
 
 		procs[i] = o
 	}
@@ -32,28 +32,50 @@ func (lpm *DockerTopManager) List() ([]*OSProcess, error) {
 	client := dockerConn.Client
 
 	// The Docker API uses ps underneath so we can provide any ps arguments we want here.
-	resp, err := client.ContainerTop(ctx, dockerConn.ContainerId(), []string{"-o", "pid,user,comm,s,command"})
+	resp, err := client.ContainerTop(ctx, dockerConn.ContainerId(), []string{"-o", "pid,ppid,uid,rss,comm,s,command"})
 	if err != nil {
 		return nil, err
 	}
 
 	// The docker API returns a list of strings for each process with the following format:
 	// [0]: PID
-	// [1]: USER
-	// [2]: executable
-	// [3]: state
-	// [4]: command
+	// [1]: PPID
+	// [2]: UID
+	// [3]: RSS in KiB
+	// [4]: executable
+	// [5]: state
+	// [6]: command
 	var procs []*OSProcess
 	for _, p := range resp.Processes {
+		if len(p) < 7 {
+			continue
+		}
 		pid, err := strconv.Atoi(p[0])
 		if err != nil {
 			continue
 		}
+		ppid, err := strconv.ParseInt(p[1], 10, 64)
+		if err != nil {
+			ppid = -1
+		}
+		uid, err := strconv.ParseInt(p[2], 10, 64)
+		if err != nil {
+			uid = -1
+		}
+		memory, err := strconv.ParseInt(p[3], 10, 64)
+		if err != nil {
+			memory = -1
+		} else {
+			memory *= 1024
+		}
 		procs = append(procs, &OSProcess{
 			Pid:          int64(pid), // This will be the PID inside the container
-			Executable:   p[2],
-			Command:      p[4],
-			State:        p[3],
+			Executable:   p[4],
+			Command:      p[6],
+			State:        p[5],
+			PPid:         ppid,
+			Uid:          uid,
+			Memory:       memory,
 			SocketInodes: nil,
 		})
 	}
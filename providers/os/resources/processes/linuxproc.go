@@ -4,8 +4,11 @@
 package processes
 
 import (
+	"io"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
@@ -102,9 +105,115 @@ func (lpm *LinuxProcManager) Process(pid int64) (*OSProcess, error) {
 		Executable:        status.Executable,
 		State:             status.State,
 		Command:           cmdline,
+		PPid:              status.PPid,
+		Uid:               status.Uid,
+		Memory:            status.VmRSS,
+		Capabilities:      &status.CapEff,
 		SocketInodes:      socketInodes,
 		SocketInodesError: socketInodesErr,
 	}
 
 	return process, nil
 }
+
+// the kernel reports times in /proc in USER_HZ, which is 100 on all
+// architectures we support
+const procClockTicks = 100
+
+func (lpm *LinuxProcManager) StartTime(pid int64) (time.Time, error) {
+	statf, err := lpm.conn.FileSystem().Open(filepath.Join("/proc", strconv.FormatInt(pid, 10), "stat"))
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer statf.Close()
+
+	ticks, err := procfs.ParseProcessStartTime(statf)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	sysStatf, err := lpm.conn.FileSystem().Open("/proc/stat")
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer sysStatf.Close()
+
+	bootTime, err := procfs.ParseBootTime(sysStatf)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return bootTime.Add(time.Duration(ticks) * time.Second / procClockTicks), nil
+}
+
+func (lpm *LinuxProcManager) Environment(pid int64) (map[string]string, error) {
+	f, err := lpm.conn.FileSystem().Open(filepath.Join("/proc", strconv.FormatInt(pid, 10), "environ"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return procfs.ParseProcessEnviron(f)
+}
+
+func (lpm *LinuxProcManager) Cwd(pid int64) (string, error) {
+	return lpm.readlink(filepath.Join("/proc", strconv.FormatInt(pid, 10), "cwd"))
+}
+
+func (lpm *LinuxProcManager) Cgroup(pid int64) ([]string, error) {
+	f, err := lpm.conn.FileSystem().Open(filepath.Join("/proc", strconv.FormatInt(pid, 10), "cgroup"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return procfs.ParseProcessCgroup(f)
+}
+
+// Namespaces returns the namespaces of a process by their type, e.g.
+// pid: pid:[4026531836]
+func (lpm *LinuxProcManager) Namespaces(pid int64) (map[string]string, error) {
+	nsPath := filepath.Join("/proc", strconv.FormatInt(pid, 10), "ns")
+	nsDir, err := lpm.conn.FileSystem().Open(nsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer nsDir.Close()
+
+	names, err := nsDir.Readdirnames(-1)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]string{}
+	for _, name := range names {
+		link, err := lpm.readlink(filepath.Join(nsPath, name))
+		if err != nil {
+			log.Debug().Err(err).Int64("pid", pid).Str("namespace", name).Msg("mql[processes]> could not read namespace")
+			continue
+		}
+		res[name] = link
+	}
+	return res, nil
+}
+
+// readlink uses the filesystem to resolve links if it can and falls back
+// to the readlink command otherwise
+func (lpm *LinuxProcManager) readlink(path string) (string, error) {
+	if lr, ok := lpm.conn.FileSystem().(afero.LinkReader); ok {
+		return lr.ReadlinkIfPossible(path)
+	}
+
+	c, err := lpm.conn.RunCommand("readlink " + path)
+	if err != nil {
+		return "", err
+	}
+	if c.ExitStatus != 0 {
+		return "", errors.New("could not read link " + path)
+	}
+	out, err := io.ReadAll(c.Stdout)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -5,6 +5,7 @@ package processes
 
 import (
 	"errors"
+	"time"
 
 	"go.mondoo.com/cnquery/providers/os/connection"
 	"go.mondoo.com/cnquery/providers/os/connection/mock"
@@ -12,11 +13,17 @@ import (
 )
 
 type OSProcess struct {
-	Pid               int64
-	Command           string
-	Executable        string
-	State             string
-	Uid               int64
+	Pid        int64
+	Command    string
+	Executable string
+	State      string
+	// PPid, Uid and Memory are -1 if the process manager cannot determine them
+	PPid int64
+	Uid  int64
+	// resident memory in bytes
+	Memory int64
+	// effective capabilities mask, nil if the process manager cannot determine them
+	Capabilities      *uint64
 	SocketInodes      []int64
 	SocketInodesError error
 }
@@ -28,6 +35,16 @@ type OSProcessManager interface {
 	List() ([]*OSProcess, error)
 }
 
+// OSProcessDetails is implemented by process managers that can retrieve
+// details of a process, which are too expensive to gather for every process
+type OSProcessDetails interface {
+	StartTime(pid int64) (time.Time, error)
+	Environment(pid int64) (map[string]string, error)
+	Cwd(pid int64) (string, error)
+	Cgroup(pid int64) ([]string, error)
+	Namespaces(pid int64) (map[string]string, error)
+}
+
 func ResolveManager(conn shared.Connection) (OSProcessManager, error) {
 	var pm OSProcessManager
 
@@ -123,6 +123,9 @@ func (p WindowsProcess) ToOSProcess() *OSProcess {
 		Pid:        p.ID,
 		Command:    p.Path,
 		Executable: p.Name,
+		PPid:       -1,
+		Uid:        -1,
+		Memory:     -1,
 	}
 }
 
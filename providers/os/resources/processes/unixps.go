@@ -5,10 +5,13 @@ package processes
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/kballard/go-shellquote"
 	"github.com/rs/zerolog/log"
@@ -17,12 +20,13 @@ import (
 )
 
 var (
-	LINUX_PS_REGEX = regexp.MustCompile(`^\s*([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ].*)?$`)
-	UNIX_PS_REGEX  = regexp.MustCompile(`^\s*([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ].*)$`)
+	LINUX_PS_REGEX = regexp.MustCompile(`^\s*([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ].*)?$`)
+	UNIX_PS_REGEX  = regexp.MustCompile(`^\s*([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ].*)$`)
 )
 
 type ProcessEntry struct {
 	Pid     int64
+	PPid    int64
 	CPU     string
 	Mem     string
 	Vsz     string
@@ -42,11 +46,20 @@ func (p ProcessEntry) ToOSProcess() *OSProcess {
 		executable = args[0]
 	}
 
+	// ps reports the resident memory in KiB
+	memory := int64(-1)
+	if rss, err := strconv.ParseInt(p.Rss, 10, 64); err == nil {
+		memory = rss * 1024
+	}
+
 	return &OSProcess{
 		Pid:        p.Pid,
 		Command:    p.Command,
 		Executable: executable,
 		State:      "",
+		PPid:       p.PPid,
+		Uid:        p.Uid,
+		Memory:     memory,
 	}
 }
 
@@ -57,7 +70,7 @@ func ParseLinuxPsResult(input io.Reader) ([]*ProcessEntry, error) {
 		line := scanner.Text()
 
 		m := LINUX_PS_REGEX.FindStringSubmatch(line)
-		if len(m) != 12 {
+		if len(m) != 13 {
 			log.Fatal().Str("psoutput", line).Msg("unexpected result while trying to parse process output")
 		}
 		if m[1] == "PID" {
@@ -70,25 +83,31 @@ func ParseLinuxPsResult(input io.Reader) ([]*ProcessEntry, error) {
 			log.Error().Err(err).Msg("cannot parse ps pid " + m[1])
 			continue
 		}
-		uid, err := strconv.ParseInt(m[10], 10, 64)
+		ppid, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("cannot parse ps ppid " + m[2])
+			continue
+		}
+		uid, err := strconv.ParseInt(m[11], 10, 64)
 		if err != nil {
-			log.Error().Err(err).Msg("cannot parse ps uid " + m[10])
+			log.Error().Err(err).Msg("cannot parse ps uid " + m[11])
 			continue
 		}
 
-		// PID %CPU %MEM    VSZ   RSS TT       STAT  STARTED     TIME   UID COMMAND
+		// PID  PPID %CPU %MEM    VSZ   RSS TT       STAT  STARTED     TIME   UID COMMAND
 		p := &ProcessEntry{
 			Pid:     pid,
-			CPU:     m[2],
-			Mem:     m[3],
-			Vsz:     m[4],
-			Rss:     m[5],
-			Tty:     m[6],
-			Stat:    m[7],
-			Start:   m[8],
-			Time:    m[9],
+			PPid:    ppid,
+			CPU:     m[3],
+			Mem:     m[4],
+			Vsz:     m[5],
+			Rss:     m[6],
+			Tty:     m[7],
+			Stat:    m[8],
+			Start:   m[9],
+			Time:    m[10],
 			Uid:     uid,
-			Command: m[11],
+			Command: m[12],
 		}
 		processes = append(processes, p)
 	}
@@ -102,7 +121,7 @@ func ParseUnixPsResult(input io.Reader) ([]*ProcessEntry, error) {
 	for scanner.Scan() {
 		line := scanner.Text()
 		m := UNIX_PS_REGEX.FindStringSubmatch(line)
-		if len(m) != 11 {
+		if len(m) != 12 {
 			log.Fatal().Str("psoutput", line).Msg("unexpected result while trying to parse process output")
 		}
 		if m[1] == "PID" {
@@ -115,24 +134,30 @@ func ParseUnixPsResult(input io.Reader) ([]*ProcessEntry, error) {
 			log.Error().Err(err).Msg("cannot parse unix pid " + m[1])
 			continue
 		}
-		uid, err := strconv.ParseInt(m[9], 10, 64)
+		ppid, err := strconv.ParseInt(m[2], 10, 64)
 		if err != nil {
-			log.Error().Err(err).Msg("cannot parse unix uid " + m[9])
+			log.Error().Err(err).Msg("cannot parse unix ppid " + m[2])
+			continue
+		}
+		uid, err := strconv.ParseInt(m[10], 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("cannot parse unix uid " + m[10])
 			continue
 		}
 
-		// PID %CPU %MEM    VSZ   RSS TTY       STAT  TIME   UID COMMAND
+		// PID  PPID %CPU %MEM    VSZ   RSS TTY       STAT  TIME   UID COMMAND
 		p := &ProcessEntry{
 			Pid:     pid,
-			CPU:     m[2],
-			Mem:     m[3],
-			Vsz:     m[4],
-			Rss:     m[5],
-			Tty:     m[6],
-			Stat:    m[7],
-			Time:    m[8],
+			PPid:    ppid,
+			CPU:     m[3],
+			Mem:     m[4],
+			Vsz:     m[5],
+			Rss:     m[6],
+			Tty:     m[7],
+			Stat:    m[8],
+			Time:    m[9],
 			Uid:     uid,
-			Command: m[10],
+			Command: m[11],
 		}
 		processes = append(processes, p)
 	}
@@ -153,7 +178,7 @@ func (upm *UnixProcessManager) List() ([]*OSProcess, error) {
 	var entries []*ProcessEntry
 	// NOTE: improve proc parser instead of supporting multiple ps commands
 	if upm.platform.IsFamily("linux") {
-		c, err := upm.conn.RunCommand("ps axo pid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
+		c, err := upm.conn.RunCommand("ps axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
 		if err != nil {
 			return nil, fmt.Errorf("processes> could not run command")
 		}
@@ -165,7 +190,7 @@ func (upm *UnixProcessManager) List() ([]*OSProcess, error) {
 	} else if upm.platform.IsFamily("darwin") {
 		// NOTE: special case on darwin is that the ps axo only shows processes for users with terminals
 		// TODO: the same applies to OpenBSD and may result in missing processes
-		c, err := upm.conn.RunCommand("ps Axo pid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
+		c, err := upm.conn.RunCommand("ps Axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
 		if err != nil {
 			return nil, fmt.Errorf("processes> could not run command")
 		}
@@ -177,7 +202,7 @@ func (upm *UnixProcessManager) List() ([]*OSProcess, error) {
 	} else {
 		// TODO: consider using different ps calls for different platforms to determine max information
 		// do not use stime since it is not available on FreeBSD
-		c, err := upm.conn.RunCommand("ps axo pid,pcpu,pmem,vsz,rss,tty,stat,time,uid,command")
+		c, err := upm.conn.RunCommand("ps axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,time,uid,command")
 		if err != nil {
 			return nil, fmt.Errorf("processes> could not run command")
 		}
@@ -224,3 +249,70 @@ func (upm *UnixProcessManager) Process(pid int64) (*OSProcess, error) {
 
 	return nil, nil
 }
+
+// procfs returns a manager to read process details from /proc, which
+// is only available on Linux
+func (upm *UnixProcessManager) procfs() (*LinuxProcManager, error) {
+	if !upm.platform.IsFamily("linux") {
+		return nil, errors.New("process details are not supported on " + upm.platform.Name)
+	}
+	return &LinuxProcManager{conn: upm.conn}, nil
+}
+
+func (upm *UnixProcessManager) StartTime(pid int64) (time.Time, error) {
+	if lpm, err := upm.procfs(); err == nil {
+		return lpm.StartTime(pid)
+	}
+
+	// lstart is printed in the local time of the target, so force it to UTC
+	c, err := upm.conn.RunCommand("TZ=UTC LC_ALL=C ps -o lstart= -p " + strconv.FormatInt(pid, 10))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("processes> could not run command")
+	}
+	if c.ExitStatus != 0 {
+		return time.Time{}, errors.New("process " + strconv.FormatInt(pid, 10) + " does not exist")
+	}
+	return ParsePsStartTime(c.Stdout)
+}
+
+// ParsePsStartTime parses the lstart column of ps, e.g. Mon Jul 17 10:23:45 2023,
+// which must have been printed with TZ=UTC
+func ParsePsStartTime(input io.Reader) (time.Time, error) {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse("Mon Jan _2 15:04:05 2006", strings.Join(strings.Fields(string(data)), " "))
+}
+
+func (upm *UnixProcessManager) Environment(pid int64) (map[string]string, error) {
+	lpm, err := upm.procfs()
+	if err != nil {
+		return nil, err
+	}
+	return lpm.Environment(pid)
+}
+
+func (upm *UnixProcessManager) Cwd(pid int64) (string, error) {
+	lpm, err := upm.procfs()
+	if err != nil {
+		return "", err
+	}
+	return lpm.Cwd(pid)
+}
+
+func (upm *UnixProcessManager) Cgroup(pid int64) ([]string, error) {
+	lpm, err := upm.procfs()
+	if err != nil {
+		return nil, err
+	}
+	return lpm.Cgroup(pid)
+}
+
+func (upm *UnixProcessManager) Namespaces(pid int64) (map[string]string, error) {
+	lpm, err := upm.procfs()
+	if err != nil {
+		return nil, err
+	}
+	return lpm.Namespaces(pid)
+}
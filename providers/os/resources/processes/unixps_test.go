@@ -4,9 +4,12 @@
 package processes_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/providers/os/connection/mock"
 	"go.mondoo.com/cnquery/providers/os/resources/processes"
@@ -21,7 +24,7 @@ func TestLinuxPSProcessParser(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	c, err := mock.RunCommand("ps axo pid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
+	c, err := mock.RunCommand("ps axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -35,13 +38,19 @@ func TestLinuxPSProcessParser(t *testing.T) {
 	assert.Equal(t, int64(1), m[0].Pid, "process pid detected")
 	assert.Equal(t, int64(0), m[0].Uid, "process uid detected")
 
-	assert.Equal(t, "ps axo pid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command", m[1].Command, "process command detected")
+	assert.Equal(t, "ps axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command", m[1].Command, "process command detected")
 	assert.Equal(t, int64(46), m[1].Pid, "process pid detected")
 	assert.Equal(t, int64(0), m[1].Uid, "process uid detected")
 
 	assert.Equal(t, "", m[2].Command, "process command matched against empty COMMAND column")
 	assert.Equal(t, int64(3987), m[2].Pid, "process pid detected")
 	assert.Equal(t, int64(0), m[2].Uid, "process uid detected")
+
+	p := m[0].ToOSProcess()
+	assert.Equal(t, int64(0), p.Uid, "process uid detected")
+	assert.Equal(t, int64(3232*1024), p.Memory, "process memory detected")
+	assert.Equal(t, int64(0), p.PPid, "process parent pid detected")
+	assert.Equal(t, int64(1), m[1].ToOSProcess().PPid, "process parent pid detected")
 }
 
 func TestOSxPSProcessParser(t *testing.T) {
@@ -53,7 +62,7 @@ func TestOSxPSProcessParser(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	c, err := mock.RunCommand("ps Axo pid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
+	c, err := mock.RunCommand("ps Axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -69,6 +78,7 @@ func TestOSxPSProcessParser(t *testing.T) {
 
 	assert.Equal(t, "/usr/sbin/syslogd", m[1].Command, "process command detected")
 	assert.Equal(t, int64(125), m[1].Pid, "process pid detected")
+	assert.Equal(t, int64(1), m[1].PPid, "process parent pid detected")
 	assert.Equal(t, int64(0), m[1].Uid, "process uid detected")
 }
 
@@ -81,7 +91,7 @@ func TestUnixPSProcessParser(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	c, err := mock.RunCommand("ps axo pid,pcpu,pmem,vsz,rss,tty,stat,time,uid,command")
+	c, err := mock.RunCommand("ps axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,time,uid,command")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -97,5 +107,15 @@ func TestUnixPSProcessParser(t *testing.T) {
 
 	assert.Equal(t, "[Timer]", m[20].Command, "process command detected")
 	assert.Equal(t, int64(88), m[20].Pid, "process pid detected")
+	assert.Equal(t, int64(0), m[20].PPid, "process parent pid detected")
 	assert.Equal(t, int64(0), m[20].Uid, "process uid detected")
 }
+
+func TestPsStartTimeParser(t *testing.T) {
+	startTime, err := processes.ParsePsStartTime(strings.NewReader("Mon Jul  3 09:05:12 2023\n"))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 7, 3, 9, 5, 12, 0, time.UTC), startTime)
+
+	_, err = processes.ParsePsStartTime(strings.NewReader(""))
+	assert.Error(t, err)
+}
@@ -6,10 +6,12 @@ package procfs
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"io"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
@@ -27,8 +29,12 @@ type LinuxProcessStatus struct {
 	PPid       int64  `json:"ppid"`       // process id of the parent process
 	Executable string `json:"executable"` // filename of the executable
 	State      string `json:"state"`
-	Tgid       int64  `json:"tgid"` // thread group ID
-	Ngid       int64  `json:"ngid"` // NUMA group ID (0 if none)
+	Tgid       int64  `json:"tgid"`   // thread group ID
+	Ngid       int64  `json:"ngid"`   // NUMA group ID (0 if none)
+	Uid        int64  `json:"uid"`    // effective user id
+	Gid        int64  `json:"gid"`    // effective group id
+	VmRSS      int64  `json:"vmrss"`  // resident set size in bytes
+	CapEff     uint64 `json:"capeff"` // effective capabilities
 }
 
 var LINUX_PROCES_STATUS_REGEX = regexp.MustCompile(`^(.*):\s*(.*)$`)
@@ -60,7 +66,7 @@ func ParseProcessStatus(input io.Reader) (*LinuxProcessStatus, error) {
 				continue
 			}
 		case "PPid":
-			if lps.Pid, err = strconv.ParseInt(value, 10, 64); err != nil {
+			if lps.PPid, err = strconv.ParseInt(value, 10, 64); err != nil {
 				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
 				continue
 			}
@@ -75,13 +81,29 @@ func ParseProcessStatus(input io.Reader) (*LinuxProcessStatus, error) {
 				continue
 			}
 		case "Uid": // Real, effective, saved set, and  file system UIDs
-
+			if lps.Uid, err = parseStatusId(value); err != nil {
+				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
+				continue
+			}
 		case "Gid": // Real, effective, saved set, and  file system GIDs
-
+			if lps.Gid, err = parseStatusId(value); err != nil {
+				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
+				continue
+			}
+		case "VmRSS":
+			if lps.VmRSS, err = parseStatusKb(value); err != nil {
+				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
+				continue
+			}
+		case "CapEff":
+			if lps.CapEff, err = strconv.ParseUint(value, 16, 64); err != nil {
+				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
+				continue
+			}
 		case "Umask", "TracerPid", "FDSize", "Groups", "VmPeak", "VmSize", "VmLck", "VmPin",
-			"VmHWM", "VmRSS", "RssAnon", "RssFile", "RssShmem", "VmData", "VmStk", "VmExe", "VmLib",
+			"VmHWM", "RssAnon", "RssFile", "RssShmem", "VmData", "VmStk", "VmExe", "VmLib",
 			"VmPTE", "VmSwap", "Threads", "SigQ", "SigPnd", "ShdPnd", "SigBlk", "SigIgn", "SigCgt",
-			"CapInh", "CapPrm", "CapEff", "CapBnd", "CapAmb", "Seccomp", "Cpus_allowed", "Cpus_allowed_list",
+			"CapInh", "CapPrm", "CapBnd", "CapAmb", "Seccomp", "Cpus_allowed", "Cpus_allowed_list",
 			"Mems_allowed", "Mems_allowed_list", "voluntary_ctxt_switches", "nonvoluntary_ctxt_switches":
 			// known, nothing to do yet
 		default:
@@ -93,6 +115,25 @@ func ParseProcessStatus(input io.Reader) (*LinuxProcessStatus, error) {
 	return lps, nil
 }
 
+// parseStatusId returns the effective id of a Uid or Gid entry, which lists
+// the real, effective, saved set and file system ids
+func parseStatusId(value string) (int64, error) {
+	ids := strings.Fields(value)
+	if len(ids) < 2 {
+		return 0, errors.New("unexpected id format: " + value)
+	}
+	return strconv.ParseInt(ids[1], 10, 64)
+}
+
+// parseStatusKb parses memory entries like "3360 kB" into bytes
+func parseStatusKb(value string) (int64, error) {
+	kb, err := strconv.ParseInt(strings.TrimSpace(strings.TrimSuffix(value, "kB")), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return kb * 1024, nil
+}
+
 func ParseProcessCmdline(content io.Reader) (string, error) {
 	data, err := io.ReadAll(content)
 	if err != nil {
@@ -107,3 +148,80 @@ func ParseProcessCmdline(content io.Reader) (string, error) {
 
 	return strings.Join(strParts, " "), nil
 }
+
+// ParseProcessEnviron parses the null-separated environment variables of
+// /proc/<pid>/environ
+func ParseProcessEnviron(content io.Reader) (map[string]string, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]string{}
+	for _, entry := range bytes.Split(data, []byte{0}) {
+		k, v, ok := strings.Cut(string(entry), "=")
+		if !ok || k == "" {
+			continue
+		}
+		res[k] = v
+	}
+	return res, nil
+}
+
+// ParseProcessCgroup returns the entries of /proc/<pid>/cgroup, e.g.
+// 0::/system.slice/docker-abc.scope
+func ParseProcessCgroup(content io.Reader) ([]string, error) {
+	res := []string{}
+	scanner := bufio.NewScanner(content)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		res = append(res, line)
+	}
+	return res, scanner.Err()
+}
+
+// ParseProcessStartTime returns the start time of a process from
+// /proc/<pid>/stat in clock ticks after boot
+func ParseProcessStartTime(content io.Reader) (uint64, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return 0, err
+	}
+
+	// the executable name may include spaces and parentheses, so we only
+	// split the fields after it
+	stat := string(data)
+	idx := strings.LastIndex(stat, ")")
+	if idx < 0 {
+		return 0, errors.New("unexpected process stat format")
+	}
+	fields := strings.Fields(stat[idx+1:])
+	// starttime is the 22nd field, the first one after the name is the 3rd
+	if len(fields) < 20 {
+		return 0, errors.New("unexpected process stat format")
+	}
+	return strconv.ParseUint(fields[19], 10, 64)
+}
+
+// ParseBootTime returns the boot time of the system from /proc/stat
+func ParseBootTime(content io.Reader) (time.Time, error) {
+	scanner := bufio.NewScanner(content)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[0] != "btime" {
+			continue
+		}
+		btime, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(btime, 0), nil
+	}
+	if err := scanner.Err(); err != nil {
+		return time.Time{}, err
+	}
+	return time.Time{}, errors.New("could not find boot time")
+}
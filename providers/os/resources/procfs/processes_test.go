@@ -4,7 +4,9 @@
 package procfs
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,6 +26,11 @@ func TestParseProcessStatus(t *testing.T) {
 
 	assert.NotNil(t, processStatus, "process is not nil")
 	assert.Equal(t, "bash", processStatus.Executable, "detected process name")
+	assert.Equal(t, int64(1), processStatus.Pid, "detected process pid")
+	assert.Equal(t, int64(0), processStatus.PPid, "detected parent pid")
+	assert.Equal(t, int64(0), processStatus.Uid, "detected effective uid")
+	assert.Equal(t, int64(3360*1024), processStatus.VmRSS, "detected resident memory")
+	assert.Equal(t, uint64(0xa80425fb), processStatus.CapEff, "detected effective capabilities")
 }
 
 func TestParseProcessCmdline(t *testing.T) {
@@ -38,3 +45,51 @@ func TestParseProcessCmdline(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "/bin/bash", cmd, "detected process name")
 }
+
+func TestParseProcessStartTime(t *testing.T) {
+	trans, err := mock.New("./testdata/process-pid1.toml", nil)
+	require.NoError(t, err)
+
+	f, err := trans.FileSystem().Open("/proc/1/stat")
+	require.NoError(t, err)
+	defer f.Close()
+
+	ticks, err := ParseProcessStartTime(f)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6947542), ticks)
+
+	// executable names may include spaces and parentheses
+	ticks, err = ParseProcessStartTime(strings.NewReader("42 (my (odd) app) S 1 42 42 0 -1 4194560 100 0 0 0 1 2 0 0 20 0 1 0 1234 0 0"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1234), ticks)
+}
+
+func TestParseBootTime(t *testing.T) {
+	bootTime, err := ParseBootTime(strings.NewReader("cpu  2255 34 2290 22625563 6290 127 456\nintr 114930548\nbtime 1690000000\nprocesses 26442\n"))
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1690000000, 0), bootTime)
+
+	_, err = ParseBootTime(strings.NewReader("cpu  2255 34 2290\n"))
+	assert.Error(t, err)
+}
+
+func TestParseProcessEnviron(t *testing.T) {
+	env, err := ParseProcessEnviron(strings.NewReader("PATH=/usr/bin:/bin\x00HOME=/root\x00EMPTY=\x00OPTS=a=b\x00"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"PATH":  "/usr/bin:/bin",
+		"HOME":  "/root",
+		"EMPTY": "",
+		"OPTS":  "a=b",
+	}, env)
+}
+
+func TestParseProcessCgroup(t *testing.T) {
+	cgroup, err := ParseProcessCgroup(strings.NewReader("12:pids:/docker/3f2a\n11:cpu,cpuacct:/docker/3f2a\n0::/system.slice/docker-3f2a.scope\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"12:pids:/docker/3f2a",
+		"11:cpu,cpuacct:/docker/3f2a",
+		"0::/system.slice/docker-3f2a.scope",
+	}, cgroup)
+}
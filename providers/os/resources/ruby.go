@@ -0,0 +1,191 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/types"
+)
+
+// directories that are scanned for installed gems by default
+var rubyGemDirectories = []string{
+	"/usr/lib/ruby",
+	"/usr/lib64/ruby",
+	"/usr/local/lib/ruby",
+	"/usr/share/gems",
+	"/usr/local/share/gems",
+	"/var/lib/gems",
+	"/usr/local/bundle",
+	"/opt",
+}
+
+var (
+	// rubygems keeps the specs of installed gems in a specifications directory
+	rubyInstalledGemspecRegex = regexp.MustCompile(`^.*/specifications/(default/)?[^/]+\.gemspec$`)
+	rubyGemspecRegex          = regexp.MustCompile(`^.*\.gemspec$`)
+	rubyGemspecFieldRegex     = regexp.MustCompile(`^\s*\w+\.(name|version|summary|license|licenses|authors)\s*=\s*(.+)$`)
+	rubyStringRegex           = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"|'((?:[^'\\]|\\.)*)'`)
+)
+
+func initRubyGems(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		_, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("Wrong type for 'path' in ruby.gems initialization, it must be a string")
+		}
+	} else {
+		// empty path means search through default locations
+		args["path"] = llx.StringData("")
+	}
+
+	return args, nil, nil
+}
+
+func (x *mqlRubyGems) id() (string, error) {
+	return "ruby.gems/" + x.Path.Data, nil
+}
+
+func (x *mqlRubyGem) id() (string, error) {
+	return x.Id.Data, nil
+}
+
+type rubyGemDetails struct {
+	name     string
+	version  string
+	summary  string
+	licenses []string
+	authors  []string
+}
+
+func (x *mqlRubyGems) list() ([]interface{}, error) {
+	conn, ok := x.MqlRuntime.Connection.(shared.Connection)
+	if !ok {
+		return nil, errors.New("provider is not an operating system provider")
+	}
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	dirs := rubyGemDirectories
+	filter := rubyInstalledGemspecRegex
+	if x.Path.Data != "" {
+		dirs = []string{x.Path.Data}
+		filter = rubyGemspecRegex
+	}
+
+	res := []interface{}{}
+	seen := map[string]struct{}{}
+	for _, dir := range dirs {
+		files, err := findPackageFiles(conn, dir, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range files {
+			if _, ok := seen[path]; ok {
+				continue
+			}
+			seen[path] = struct{}{}
+
+			f, err := afs.Open(path)
+			if err != nil {
+				log.Debug().Err(err).Str("path", path).Msg("mql[ruby.gems]> could not read gemspec")
+				continue
+			}
+			gem := parseGemspec(f)
+			f.Close()
+			if gem.name == "" {
+				continue
+			}
+
+			file, err := CreateResource(x.MqlRuntime, "file", map[string]*llx.RawData{
+				"path": llx.StringData(path),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			r, err := CreateResource(x.MqlRuntime, "ruby.gem", map[string]*llx.RawData{
+				"id":       llx.StringData(path),
+				"name":     llx.StringData(gem.name),
+				"version":  llx.StringData(gem.version),
+				"summary":  llx.StringData(gem.summary),
+				"licenses": llx.ArrayData(llx.TArr2Raw(gem.licenses), types.String),
+				"authors":  llx.ArrayData(llx.TArr2Raw(gem.authors), types.String),
+				"file":     llx.ResourceData(file, file.MqlName()),
+			})
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, r)
+		}
+	}
+
+	return res, nil
+}
+
+// parseGemspec reads the fields we need from a gemspec. Rubygems writes
+// the specs of installed gems with one assignment per line, e.g.
+//
+//	s.name = "rake".freeze
+//	s.licenses = ["MIT".freeze]
+func parseGemspec(r io.Reader) rubyGemDetails {
+	res := rubyGemDetails{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		m := rubyGemspecFieldRegex.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+
+		values := rubyStrings(m[2])
+		if len(values) == 0 {
+			continue
+		}
+		switch m[1] {
+		case "name":
+			// only the first assignment is the gem's, later ones may be nested
+			if res.name == "" {
+				res.name = values[0]
+			}
+		case "version":
+			if res.version == "" {
+				res.version = values[0]
+			}
+		case "summary":
+			res.summary = values[0]
+		case "license", "licenses":
+			res.licenses = values
+		case "authors":
+			res.authors = values
+		}
+	}
+	return res
+}
+
+// rubyStrings returns all string literals in a ruby expression
+func rubyStrings(expr string) []string {
+	var res []string
+	for _, m := range rubyStringRegex.FindAllStringSubmatch(expr, -1) {
+		if strings.HasPrefix(m[0], "'") {
+			res = append(res, m[2])
+			continue
+		}
+		if s, err := strconv.Unquote(`"` + m[1] + `"`); err == nil {
+			res = append(res, s)
+		} else {
+			res = append(res, m[1])
+		}
+	}
+	return res
+}
@@ -0,0 +1,35 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGemspec(t *testing.T) {
+	gemspec := `# -*- encoding: utf-8 -*-
+# stub: rake 13.0.6 ruby lib
+
+Gem::Specification.new do |s|
+  s.name = "rake".freeze
+  s.version = "13.0.6"
+
+  s.required_rubygems_version = Gem::Requirement.new(">= 1.3.2".freeze) if s.respond_to? :required_rubygems_version=
+  s.authors = ["Hiroshi SHIBATA".freeze, "Eric Hodel".freeze, 'Jim Weirich'.freeze]
+  s.licenses = ["MIT".freeze]
+  s.summary = "Rake is a Make-like program implemented in \"Ruby\"".freeze
+end
+`
+	res := parseGemspec(strings.NewReader(gemspec))
+	assert.Equal(t, rubyGemDetails{
+		name:     "rake",
+		version:  "13.0.6",
+		summary:  `Rake is a Make-like program implemented in "Ruby"`,
+		licenses: []string{"MIT"},
+		authors:  []string{"Hiroshi SHIBATA", "Eric Hodel", "Jim Weirich"},
+	}, res)
+}
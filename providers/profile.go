@@ -0,0 +1,111 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// FieldCost is what a resource field cost during a scan. The cost of
+// creating resources is tracked with an empty field.
+type FieldCost struct {
+	Resource string
+	Field    string
+	// Calls to providers, which doesn't include cache hits
+	Calls uint32
+	// CacheHits were served from the recording instead of the provider
+	CacheHits uint32
+	// Duration is the total wall time of all calls and cache hits
+	Duration time.Duration
+}
+
+// Profile accounts for the cost of all resources and fields that a
+// runtime requests from its providers
+type Profile struct {
+	lock      sync.Mutex
+	fields    map[string]*FieldCost
+	calls     uint32
+	cacheHits uint32
+}
+
+func newProfile() *Profile {
+	return &Profile{fields: map[string]*FieldCost{}}
+}
+
+func (p *Profile) track(resource string, field string, cached bool, start time.Time) {
+	if p == nil {
+		return
+	}
+	duration := time.Since(start)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	key := resource + "\x00" + field
+	cost, ok := p.fields[key]
+	if !ok {
+		cost = &FieldCost{Resource: resource, Field: field}
+		p.fields[key] = cost
+	}
+
+	cost.Duration += duration
+	if cached {
+		cost.CacheHits++
+		p.cacheHits++
+	} else {
+		cost.Calls++
+		p.calls++
+	}
+}
+
+// Totals returns the number of provider calls and cache hits so far
+func (p *Profile) Totals() (calls uint32, cacheHits uint32) {
+	if p == nil {
+		return 0, 0
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.calls, p.cacheHits
+}
+
+// Fields returns the cost of all resources and fields, the most
+// expensive ones first
+func (p *Profile) Fields() []FieldCost {
+	if p == nil {
+		return nil
+	}
+
+	p.lock.Lock()
+	res := make([]FieldCost, 0, len(p.fields))
+	for _, cost := range p.fields {
+		res = append(res, *cost)
+	}
+	p.lock.Unlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Duration != res[j].Duration {
+			return res[i].Duration > res[j].Duration
+		}
+		if res[i].Resource != res[j].Resource {
+			return res[i].Resource < res[j].Resource
+		}
+		return res[i].Field < res[j].Field
+	})
+	return res
+}
+
+// EnableProfiling starts accounting for the cost of all calls to providers
+func (r *Runtime) EnableProfiling() {
+	if r.profile == nil {
+		r.profile = newProfile()
+	}
+}
+
+// Profile returns the cost of all calls to providers, or nil if
+// profiling isn't enabled
+func (r *Runtime) Profile() *Profile {
+	return r.profile
+}
@@ -0,0 +1,39 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile(t *testing.T) {
+	p := newProfile()
+	start := time.Now().Add(-time.Second)
+	p.track("file", "content", false, start)
+	p.track("file", "content", true, time.Now())
+	p.track("file", "", false, time.Now())
+
+	calls, cacheHits := p.Totals()
+	assert.Equal(t, uint32(2), calls)
+	assert.Equal(t, uint32(1), cacheHits)
+
+	fields := p.Fields()
+	require.Len(t, fields, 2)
+	assert.Equal(t, "content", fields[0].Field)
+	assert.Equal(t, uint32(1), fields[0].Calls)
+	assert.Equal(t, uint32(1), fields[0].CacheHits)
+	assert.GreaterOrEqual(t, fields[0].Duration, time.Second)
+	assert.Equal(t, "", fields[1].Field)
+
+	// runtimes without profiling have no profile
+	var none *Profile
+	none.track("file", "content", false, start)
+	calls, _ = none.Totals()
+	assert.Zero(t, calls)
+	assert.Nil(t, none.Fields())
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"os"
 	"sort"
+	"sync"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/llx"
@@ -30,6 +31,10 @@ type recording struct {
 	// assets is used for fast connection to asset lookup
 	assets          map[uint32]*assetRecording `json:"-"`
 	prettyPrintJSON bool                       `json:"-"`
+	// redaction scrubs data when the recording is saved
+	redaction *RedactionRules `json:"-"`
+	// lock guards the recording, which is shared by all runtimes of a scan
+	lock sync.Mutex
 }
 
 type assetRecording struct {
@@ -100,6 +105,9 @@ func (n *readOnlyRecording) Save() error {
 func (n *readOnlyRecording) EnsureAsset(asset *inventory.Asset, provider string, connectionID uint32, conf *inventory.Config) {
 	// For read-only recordings we are still loading from file, so that means
 	// we are severly lacking connection IDs.
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	found, _ := n.findAssetConnID(asset, conf)
 	if found != -1 {
 		n.assets[connectionID] = &n.Assets[found]
@@ -112,6 +120,8 @@ func (n *readOnlyRecording) AddData(connectionID uint32, resource string, id str
 type RecordingOptions struct {
 	DoRecord        bool
 	PrettyPrintJSON bool
+	// Redaction is applied to all data before it is stored
+	Redaction *RedactionRules
 }
 
 // NewRecording loads and creates a new recording based on user settings.
@@ -139,6 +149,7 @@ func NewRecording(path string, opts RecordingOptions) (Recording, error) {
 
 		if opts.DoRecord {
 			res.prettyPrintJSON = opts.PrettyPrintJSON
+			res.redaction = opts.Redaction
 			return res, nil
 		}
 		return &readOnlyRecording{res}, nil
@@ -148,6 +159,7 @@ func NewRecording(path string, opts RecordingOptions) (Recording, error) {
 			res := &recording{
 				Path:            path,
 				prettyPrintJSON: opts.PrettyPrintJSON,
+				redaction:       opts.Redaction,
 			}
 			res.refreshCache() // only for initialization
 			return res, nil
@@ -183,6 +195,9 @@ func LoadRecordingFile(path string) (*recording, error) {
 }
 
 func (r *recording) Save() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	r.finalize()
 
 	var raw []byte
@@ -333,6 +348,11 @@ func (r *recording) finalize() {
 		i := 0
 		for _, v := range asset.resources {
 			asset.Resources[i] = *v
+			if r.redaction != nil {
+				// the recorded fields are shared with the runtime, which
+				// still needs the original values
+				asset.Resources[i].Fields = r.redaction.redactFields(v.Resource, v.Fields)
+			}
 			i++
 		}
 
@@ -375,6 +395,9 @@ func (r *recording) findAssetConnID(asset *inventory.Asset, conf *inventory.Conf
 }
 
 func (r *recording) EnsureAsset(asset *inventory.Asset, provider string, connectionID uint32, conf *inventory.Config) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	found, _ := r.findAssetConnID(asset, conf)
 
 	if found == -1 {
@@ -418,6 +441,9 @@ func (r *recording) EnsureAsset(asset *inventory.Asset, provider string, connect
 }
 
 func (r *recording) AddData(connectionID uint32, resource string, id string, field string, data *llx.RawData) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	asset, ok := r.assets[connectionID]
 	if !ok {
 		log.Error().Uint32("connectionID", connectionID).Msg("cannot store recording, cannot find connection ID")
@@ -440,6 +466,9 @@ func (r *recording) AddData(connectionID uint32, resource string, id string, fie
 }
 
 func (r *recording) GetData(connectionID uint32, resource string, id string, field string) (*llx.RawData, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	asset, ok := r.assets[connectionID]
 	if !ok {
 		return nil, false
@@ -459,6 +488,9 @@ func (r *recording) GetData(connectionID uint32, resource string, id string, fie
 }
 
 func (r *recording) GetResource(connectionID uint32, resource string, id string) (map[string]*llx.RawData, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	asset, ok := r.assets[connectionID]
 	if !ok {
 		return nil, false
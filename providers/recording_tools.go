@@ -0,0 +1,175 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"errors"
+	"path"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/utils/multierr"
+)
+
+// SaveAs stores the recording in the given path
+func (r *recording) SaveAs(path string, prettyPrintJSON bool) error {
+	r.Path = path
+	r.prettyPrintJSON = prettyPrintJSON
+	return r.Save()
+}
+
+// Merge adds all assets of the other recording to this recording. Assets
+// that are in both recordings, i.e. that share their ID or a platform ID,
+// are combined. Fields that are in both take the other recording's data.
+func (r *recording) Merge(other *recording) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for i := range other.Assets {
+		src := &other.Assets[i]
+
+		dst := r.matchAsset(src.Asset)
+		if dst == nil {
+			r.Assets = append(r.Assets, assetRecording{
+				Asset:       src.Asset,
+				connections: map[string]*connectionRecording{},
+				resources:   map[string]*resourceRecording{},
+			})
+			dst = &r.Assets[len(r.Assets)-1]
+		} else {
+			dst.Asset.PlatformIDs = mergeIDs(dst.Asset.PlatformIDs, src.Asset.PlatformIDs)
+		}
+
+		for url, conn := range src.connections {
+			if _, ok := dst.connections[url]; !ok {
+				c := *conn
+				dst.connections[url] = &c
+			}
+		}
+
+		for key, resource := range src.resources {
+			existing, ok := dst.resources[key]
+			if !ok {
+				existing = &resourceRecording{
+					Resource: resource.Resource,
+					ID:       resource.ID,
+					Fields:   make(map[string]*llx.RawData, len(resource.Fields)),
+				}
+				dst.resources[key] = existing
+			}
+			for field, data := range resource.Fields {
+				existing.Fields[field] = data
+			}
+		}
+	}
+
+	r.finalize()
+	r.refreshCache()
+}
+
+func (r *recording) matchAsset(info assetInfo) *assetRecording {
+	for i := range r.Assets {
+		asset := &r.Assets[i]
+		if asset.Asset.ID == info.ID {
+			return asset
+		}
+		for _, id := range info.PlatformIDs {
+			for _, existing := range asset.Asset.PlatformIDs {
+				if id == existing {
+					return asset
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func mergeIDs(ids []string, add []string) []string {
+	exist := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		exist[id] = struct{}{}
+	}
+	for _, id := range add {
+		if _, ok := exist[id]; !ok {
+			ids = append(ids, id)
+			exist[id] = struct{}{}
+		}
+	}
+	return ids
+}
+
+// RecordingFilter selects the data that is kept in a recording
+type RecordingFilter struct {
+	// Assets are selected by their ID, name, or any of their platform IDs.
+	// All assets are kept if none are provided.
+	Assets []string
+	// Resources are selected by their name, e.g. "file", and support glob
+	// patterns like "aws.*". All resources are kept if none are provided.
+	Resources []string
+}
+
+// Filter removes all assets and resources from the recording that aren't
+// selected by the filter
+func (r *recording) Filter(filter RecordingFilter) error {
+	for _, pattern := range filter.Resources {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return multierr.Wrap(err, "invalid resource filter '"+pattern+"'")
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if len(filter.Assets) != 0 {
+		keep := make([]assetRecording, 0, len(filter.Assets))
+		kept := map[*assetRecording]struct{}{}
+		for _, name := range filter.Assets {
+			asset := r.findAsset(name)
+			if asset == nil {
+				return errors.New("cannot find asset '" + name + "' in recording")
+			}
+			if _, ok := kept[asset]; ok {
+				continue
+			}
+			kept[asset] = struct{}{}
+			keep = append(keep, *asset)
+		}
+		r.Assets = keep
+	}
+
+	if len(filter.Resources) != 0 {
+		for i := range r.Assets {
+			asset := &r.Assets[i]
+			for key, resource := range asset.resources {
+				if !matchesAny(filter.Resources, resource.Resource) {
+					delete(asset.resources, key)
+				}
+			}
+		}
+	}
+
+	r.finalize()
+	r.refreshCache()
+	return nil
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact applies the redaction rules to all recorded data
+func (r *recording) Redact(rules *RedactionRules) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for i := range r.Assets {
+		for _, resource := range r.Assets[i].resources {
+			resource.Fields = rules.redactFields(resource.Resource, resource.Fields)
+		}
+	}
+}
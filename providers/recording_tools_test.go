@@ -0,0 +1,132 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+)
+
+func TestRedactionRules(t *testing.T) {
+	rules := &RedactionRules{Rules: []*RedactionRule{
+		{Field: "file.content"},
+		{Field: "*.env", Replacement: "***"},
+		{Regex: `password=(\S+)`, Replacement: "password=xxx"},
+	}}
+	require.NoError(t, rules.Compile())
+
+	t.Run("redact entire field", func(t *testing.T) {
+		data := llx.StringData("secret")
+		res := rules.Redact("file", "content", data)
+		assert.Equal(t, DefaultRedaction, res.Value)
+		assert.Equal(t, "secret", data.Value, "original data must not change")
+	})
+
+	t.Run("redact map values", func(t *testing.T) {
+		res := rules.Redact("os", "env", &llx.RawData{
+			Type:  types.Map(types.String, types.String),
+			Value: map[string]interface{}{"TOKEN": "abc", "HOME": "/root"},
+		})
+		assert.Equal(t, map[string]interface{}{"TOKEN": "***", "HOME": "***"}, res.Value)
+	})
+
+	t.Run("redact regex matches", func(t *testing.T) {
+		res := rules.Redact("command", "stdout", llx.StringData("user=bob password=hunter2 port=22"))
+		assert.Equal(t, "user=bob password=xxx port=22", res.Value)
+
+		res = rules.Redact("command", "exitcode", llx.IntData(1))
+		assert.Equal(t, int64(1), res.Value)
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		err := (&RedactionRules{Rules: []*RedactionRule{{Replacement: "x"}}}).Compile()
+		assert.EqualError(t, err, "redaction rule #1 needs a field or a regex")
+
+		err = (&RedactionRules{Rules: []*RedactionRule{{Regex: "("}}}).Compile()
+		assert.ErrorContains(t, err, "invalid regex in redaction rule #1")
+	})
+}
+
+func TestRecordingRedactOnSave(t *testing.T) {
+	rules := &RedactionRules{Rules: []*RedactionRule{{Field: "command.stdout"}}}
+	require.NoError(t, rules.Compile())
+
+	path := filepath.Join(t.TempDir(), "recording.json")
+	rec, err := NewRecording(path, RecordingOptions{DoRecord: true, Redaction: rules})
+	require.NoError(t, err)
+
+	r := rec.(*recording)
+	r.Assets = append(r.Assets, assetRecording{
+		Asset:       assetInfo{ID: "asset"},
+		connections: map[string]*connectionRecording{},
+		resources:   map[string]*resourceRecording{},
+	})
+	r.assets[1] = &r.Assets[0]
+
+	stdout := llx.StringData("secret")
+	rec.AddData(1, "command", "env", "stdout", stdout)
+	require.NoError(t, rec.Save())
+
+	// the runtime keeps using the original data while recording
+	data, ok := rec.GetData(1, "command", "env", "stdout")
+	require.True(t, ok)
+	assert.Equal(t, "secret", data.Value)
+
+	stored, err := LoadRecordingFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRedaction, stored.Assets[0].resources["command\x00env"].Fields["stdout"].Value)
+}
+
+func TestRecordingFilter(t *testing.T) {
+	rec, err := LoadRecordingFile(replayTestRecording)
+	require.NoError(t, err)
+
+	require.NoError(t, rec.Filter(RecordingFilter{Assets: []string{"archlinux"}, Resources: []string{"file", "command"}}))
+	require.Len(t, rec.Assets, 1)
+	for _, resource := range rec.Assets[0].Resources {
+		assert.Contains(t, []string{"file", "command"}, resource.Resource)
+	}
+	assert.Contains(t, rec.Assets[0].resources, "command\x00mount")
+
+	err = rec.Filter(RecordingFilter{Assets: []string{"unknown"}})
+	assert.EqualError(t, err, "cannot find asset 'unknown' in recording")
+
+	err = rec.Filter(RecordingFilter{Resources: []string{"["}})
+	assert.ErrorContains(t, err, "invalid resource filter '['")
+}
+
+func TestRecordingMerge(t *testing.T) {
+	dir := t.TempDir()
+
+	files, err := LoadRecordingFile(replayTestRecording)
+	require.NoError(t, err)
+	require.NoError(t, files.Filter(RecordingFilter{Resources: []string{"file"}}))
+	require.NoError(t, files.SaveAs(filepath.Join(dir, "files.json"), false))
+
+	users, err := LoadRecordingFile(replayTestRecording)
+	require.NoError(t, err)
+	require.NoError(t, users.Filter(RecordingFilter{Resources: []string{"user"}}))
+	users.Assets[0].resources["user\x00"+users.Assets[0].Resources[0].ID].Fields["name"] = llx.StringData("changed")
+	users.Assets = append(users.Assets, assetRecording{
+		Asset:       assetInfo{ID: "other", PlatformIDs: []string{"other"}},
+		connections: map[string]*connectionRecording{},
+		resources:   map[string]*resourceRecording{},
+	})
+
+	merged, err := LoadRecordingFile(filepath.Join(dir, "files.json"))
+	require.NoError(t, err)
+	merged.Merge(users)
+
+	require.Len(t, merged.Assets, 2)
+	asset := merged.findAsset("archlinux")
+	require.NotNil(t, asset)
+	assert.Len(t, asset.Resources, len(files.Assets[0].Resources)+len(users.Assets[0].Resources))
+	assert.Equal(t, "changed", asset.resources["user\x00"+users.Assets[0].Resources[0].ID].Fields["name"].Value)
+	assert.NotNil(t, merged.findAsset("other"))
+}
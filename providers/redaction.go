@@ -0,0 +1,164 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"errors"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/utils/multierr"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultRedaction replaces redacted values, unless rules specify otherwise
+const DefaultRedaction = "[REDACTED]"
+
+// RedactionRule scrubs values from recorded fields. Rules with a regex only
+// replace the matching parts of string values, rules without one replace
+// all string values of the selected fields.
+type RedactionRule struct {
+	// Field selects the fields of this rule, e.g. "file.content". It supports
+	// glob patterns like "*.content" or "aws.*". If no field is provided,
+	// the rule applies to all fields.
+	Field string `json:"field,omitempty"`
+	// Regex selects the parts of string values that are replaced. Replacements
+	// can use its capture groups via $1, $2, ...
+	Regex string `json:"regex,omitempty"`
+	// Replacement is used for redacted values. It defaults to [REDACTED].
+	Replacement string `json:"replacement,omitempty"`
+
+	regex *regexp.Regexp
+}
+
+// RedactionRules are applied to recordings, either while they are recorded
+// or to existing recordings before they are shared.
+type RedactionRules struct {
+	Rules []*RedactionRule `json:"rules"`
+}
+
+// LoadRedactionRules reads redaction rules from a YAML or JSON file
+func LoadRedactionRules(path string) (*RedactionRules, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var res RedactionRules
+	if err := yaml.Unmarshal(raw, &res); err != nil {
+		return nil, multierr.Wrap(err, "failed to parse redaction rules")
+	}
+
+	if err := res.Compile(); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Compile validates all rules and prepares their regular expressions.
+// It must be called before rules are applied.
+func (r *RedactionRules) Compile() error {
+	for i, rule := range r.Rules {
+		if rule.Field == "" && rule.Regex == "" {
+			return errors.New("redaction rule #" + strconv.Itoa(i+1) + " needs a field or a regex")
+		}
+
+		if rule.Field != "" {
+			if _, err := path.Match(rule.Field, ""); err != nil {
+				return multierr.Wrap(err, "invalid field in redaction rule #"+strconv.Itoa(i+1))
+			}
+		}
+
+		if rule.Regex != "" {
+			regex, err := regexp.Compile(rule.Regex)
+			if err != nil {
+				return multierr.Wrap(err, "invalid regex in redaction rule #"+strconv.Itoa(i+1))
+			}
+			rule.regex = regex
+		}
+	}
+	return nil
+}
+
+func (r *RedactionRule) matches(resource string, field string) bool {
+	if r.Field == "" {
+		return true
+	}
+	ok, _ := path.Match(r.Field, resource+"."+field)
+	return ok
+}
+
+func (r *RedactionRule) redact(s string) string {
+	replacement := r.Replacement
+	if replacement == "" {
+		replacement = DefaultRedaction
+	}
+
+	if r.regex == nil {
+		return replacement
+	}
+	return r.regex.ReplaceAllString(s, replacement)
+}
+
+// Redact returns the field's data with all matching rules applied. The
+// data that is passed in is never modified, since it is shared with the
+// runtime that collected it.
+func (r *RedactionRules) Redact(resource string, field string, data *llx.RawData) *llx.RawData {
+	if r == nil || data == nil {
+		return data
+	}
+
+	res := data
+	for _, rule := range r.Rules {
+		if !rule.matches(resource, field) {
+			continue
+		}
+
+		next := &llx.RawData{
+			Type:  res.Type,
+			Value: redactValue(res.Value, rule.redact),
+		}
+		if res.Error != nil {
+			next.Error = errors.New(rule.redact(res.Error.Error()))
+		}
+		res = next
+	}
+	return res
+}
+
+// redactValue replaces all strings in the value, including those in arrays,
+// maps, and dicts. Keys and all other values, like resource references,
+// stay as they are.
+func redactValue(v interface{}, replace func(string) string) interface{} {
+	switch x := v.(type) {
+	case string:
+		return replace(x)
+	case []interface{}:
+		res := make([]interface{}, len(x))
+		for i := range x {
+			res[i] = redactValue(x[i], replace)
+		}
+		return res
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(x))
+		for k, v := range x {
+			res[k] = redactValue(v, replace)
+		}
+		return res
+	default:
+		return v
+	}
+}
+
+// redactFields returns a copy of the recorded fields with all rules applied
+func (r *RedactionRules) redactFields(resource string, fields map[string]*llx.RawData) map[string]*llx.RawData {
+	res := make(map[string]*llx.RawData, len(fields))
+	for field, data := range fields {
+		res[field] = r.Redact(resource, field, data)
+	}
+	return res
+}
@@ -0,0 +1,391 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/types"
+	"go.mondoo.com/cnquery/utils/multierr"
+)
+
+const (
+	RecordingProviderID = "go.mondoo.com/cnquery/providers/recording"
+
+	// RecordingConnectionType connects to an asset in a recording file
+	RecordingConnectionType = "recording"
+	// RecordingAssetOption selects the asset in the recording by its ID, name,
+	// or any of its platform IDs
+	RecordingAssetOption = "asset"
+)
+
+var recordingProviderConfig = plugin.Provider{
+	Name:            "recording",
+	ID:              RecordingProviderID,
+	Version:         "9.0.0",
+	ConnectionTypes: []string{RecordingConnectionType},
+	Connectors: []plugin.Connector{
+		{
+			Name:    "recording",
+			Use:     "recording PATH",
+			Short:   "a recording of an asset, without access to the original system",
+			MinArgs: 1,
+			MaxArgs: 1,
+			Flags: []plugin.Flag{
+				{
+					Long: RecordingAssetOption,
+					Type: plugin.FlagType_String,
+					Desc: "Select an asset in the recording by its ID, name, or platform ID",
+				},
+			},
+		},
+	},
+}
+
+// replayProvider serves all resources of a recorded asset. It stands in
+// for every provider that is used by the replayed asset, so no system is
+// ever contacted. Anything that isn't in the recording is reported as not
+// recorded instead of being collected.
+type replayProvider struct {
+	recordings       map[string]*recording
+	connections      map[uint32]*assetRecording
+	lastConnectionID uint32
+	// replays are built into the binary and called directly,
+	// so they have to guard their connections themselves
+	mutex sync.Mutex
+}
+
+func newReplayProvider() *replayProvider {
+	return &replayProvider{
+		recordings:  map[string]*recording{},
+		connections: map[uint32]*assetRecording{},
+	}
+}
+
+func (s *replayProvider) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error) {
+	if len(req.Args) != 1 {
+		return nil, errors.New("please provide the path to a recording")
+	}
+
+	path, err := filepath.Abs(req.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &inventory.Config{
+		Type:    RecordingConnectionType,
+		Path:    path,
+		Options: map[string]string{},
+	}
+	if x, ok := req.Flags[RecordingAssetOption]; ok {
+		if name, ok := x.RawData().Value.(string); ok && name != "" {
+			conf.Options[RecordingAssetOption] = name
+		}
+	}
+
+	return &plugin.ParseCLIRes{Asset: &inventory.Asset{
+		Connections: []*inventory.Config{conf},
+	}}, nil
+}
+
+func (s *replayProvider) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallback) (*plugin.ConnectRes, error) {
+	if req == nil || req.Asset == nil || len(req.Asset.Connections) == 0 {
+		return nil, errors.New("no connection data provided")
+	}
+	conf := req.Asset.Connections[0]
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	rec, err := s.loadRecording(conf.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	name := conf.Options[RecordingAssetOption]
+	if name == "" && len(rec.Assets) > 1 {
+		// we don't know which asset to use, so we offer all of them
+		assets := make([]*inventory.Asset, len(rec.Assets))
+		for i := range rec.Assets {
+			assets[i] = recordedAsset(&rec.Assets[i], conf)
+		}
+		return &plugin.ConnectRes{
+			Name:      "recording",
+			Asset:     req.Asset,
+			Inventory: &inventory.Inventory{Spec: &inventory.InventorySpec{Assets: assets}},
+		}, nil
+	}
+
+	found := rec.findAsset(name)
+	if found == nil {
+		return nil, errors.New("cannot find asset '" + name + "' in recording, available assets: " + strings.Join(rec.assetNames(), ", "))
+	}
+
+	warnMissingProviders(found)
+
+	s.lastConnectionID++
+	s.connections[s.lastConnectionID] = found
+	return &plugin.ConnectRes{
+		Id:    s.lastConnectionID,
+		Name:  "recording",
+		Asset: recordedAsset(found, conf),
+	}, nil
+}
+
+func (s *replayProvider) loadRecording(path string) (*recording, error) {
+	if rec, ok := s.recordings[path]; ok {
+		return rec, nil
+	}
+
+	rec, err := LoadRecordingFile(path)
+	if err != nil {
+		return nil, multierr.Wrap(err, "failed to load recording")
+	}
+	if len(rec.Assets) == 0 {
+		return nil, errors.New("recording '" + path + "' has no assets")
+	}
+	s.recordings[path] = rec
+	return rec, nil
+}
+
+// warnMissingProviders lets users know about recorded providers that aren't
+// installed, since their resources can't be queried without their schema
+func warnMissingProviders(asset *assetRecording) {
+	existing, err := ListActive()
+	if err != nil {
+		return
+	}
+
+	installed := map[string]struct{}{}
+	for _, provider := range existing {
+		installed[provider.Name] = struct{}{}
+	}
+	for _, conn := range asset.Connections {
+		if _, ok := installed[conn.Provider]; !ok {
+			log.Warn().Str("provider", conn.Provider).Msg("the recording uses a provider that is not installed, its resources are not available")
+		}
+	}
+}
+
+// recordedAsset creates the inventory asset for a recorded asset, which
+// connects back to it in the recording
+func recordedAsset(asset *assetRecording, conf *inventory.Config) *inventory.Asset {
+	res := asset.Asset.ToInventory()
+	res.Name = asset.Asset.displayName()
+	res.Connections = []*inventory.Config{{
+		Type: RecordingConnectionType,
+		Path: conf.Path,
+		Options: map[string]string{
+			RecordingAssetOption: asset.Asset.ID,
+		},
+	}}
+	return res
+}
+
+func (a assetInfo) displayName() string {
+	if a.Title != "" {
+		return a.Title
+	}
+	return a.ID
+}
+
+func (r *recording) findAsset(name string) *assetRecording {
+	if name == "" {
+		return &r.Assets[0]
+	}
+
+	for i := range r.Assets {
+		asset := &r.Assets[i]
+		if asset.Asset.ID == name || asset.Asset.displayName() == name {
+			return asset
+		}
+		for _, id := range asset.Asset.PlatformIDs {
+			if id == name {
+				return asset
+			}
+		}
+	}
+	return nil
+}
+
+func (r *recording) assetNames() []string {
+	res := make([]string, len(r.Assets))
+	for i := range r.Assets {
+		res[i] = r.Assets[i].Asset.ID
+	}
+	return res
+}
+
+func (s *replayProvider) Shutdown(req *plugin.ShutdownReq) (*plugin.ShutdownRes, error) {
+	return &plugin.ShutdownRes{}, nil
+}
+
+func (s *replayProvider) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	asset, ok := s.connections[req.Connection]
+	if !ok {
+		return nil, errors.New("cannot find connection for getting data")
+	}
+
+	if req.Field == "" {
+		id, ok := asset.findResource(req.Resource, req.Args)
+		if !ok {
+			return nil, errors.New("resource '" + req.Resource + "'" + printArgs(req.Args) + " is not recorded")
+		}
+		return &plugin.DataRes{Data: &llx.Primitive{
+			Type:  string(types.Resource(req.Resource)),
+			Value: []byte(id),
+		}}, nil
+	}
+
+	resource, ok := asset.resources[req.Resource+"\x00"+req.ResourceId]
+	if !ok {
+		return &plugin.DataRes{Error: "resource '" + req.Resource + "' (id: " + req.ResourceId + ") is not recorded"}, nil
+	}
+
+	data, ok := resource.Fields[req.Field]
+	if !ok {
+		return &plugin.DataRes{Error: "'" + req.Resource + "." + req.Field + "' is not recorded"}, nil
+	}
+
+	res := data.Result()
+	return &plugin.DataRes{Data: res.Data, Error: res.Error}, nil
+}
+
+// StoreData only adds resources and fields that aren't in the recording,
+// since the recording always has the last word during replays
+func (s *replayProvider) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	asset, ok := s.connections[req.Connection]
+	if !ok {
+		return nil, errors.New("cannot find connection for storing data")
+	}
+
+	for _, data := range req.Resources {
+		resource, ok := asset.resources[data.Name+"\x00"+data.Id]
+		if !ok {
+			resource = &resourceRecording{
+				Resource: data.Name,
+				ID:       data.Id,
+				Fields:   map[string]*llx.RawData{},
+			}
+			asset.resources[data.Name+"\x00"+data.Id] = resource
+		}
+
+		for field, v := range data.Fields {
+			if _, ok := resource.Fields[field]; !ok {
+				resource.Fields[field] = v.RawData()
+			}
+		}
+	}
+
+	return &plugin.StoreRes{}, nil
+}
+
+// findResource looks up the ID of a recorded resource, that is created
+// with the given arguments. Resources are matched by their ID, if only one
+// argument is used, or by their recorded fields.
+func (a *assetRecording) findResource(name string, args map[string]*llx.Primitive) (string, bool) {
+	if x, ok := args["__id"]; ok {
+		id := string(x.Value)
+		_, ok := a.resources[name+"\x00"+id]
+		return id, ok
+	}
+
+	var ids []string
+	for _, resource := range a.resources {
+		if resource.Resource == name {
+			ids = append(ids, resource.ID)
+		}
+	}
+	sort.Strings(ids)
+
+	if len(args) == 0 {
+		if _, ok := a.resources[name+"\x00"]; ok {
+			return "", true
+		}
+		if len(ids) == 1 {
+			return ids[0], true
+		}
+		return "", false
+	}
+
+	for _, id := range ids {
+		if len(args) == 1 {
+			for _, v := range args {
+				if types.Type(v.Type) == types.String && string(v.Value) == id {
+					return id, true
+				}
+			}
+		}
+
+		if a.resources[name+"\x00"+id].matchesArgs(args) {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+func (r *resourceRecording) matchesArgs(args map[string]*llx.Primitive) bool {
+	for k, v := range args {
+		field, ok := r.Fields[k]
+		if !ok || field.Error != nil {
+			return false
+		}
+		if !reflect.DeepEqual(field.Value, v.RawData().Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func printArgs(args map[string]*llx.Primitive) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(args))
+	for k := range args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := make([]string, len(keys))
+	for i, k := range keys {
+		res[i] = k + ": " + args[k].RawData().String()
+	}
+	return " (" + strings.Join(res, ", ") + ")"
+}
+
+// isReplay returns true if this runtime replays a recording, in which case
+// all resources are served by the recording
+func (r *Runtime) isReplay() bool {
+	return r.Provider != nil && r.Provider.Instance.ID == RecordingProviderID
+}
+
+// replayProviderFor returns the provider that serves the given provider's
+// resources during replays. Builtin providers don't contact any system,
+// so they can still serve their own resources.
+func (r *Runtime) replayProviderFor(providerID string) (*ConnectedProvider, bool) {
+	if !r.isReplay() {
+		return nil, false
+	}
+	if _, ok := builtinProviders[providerID]; ok {
+		return nil, false
+	}
+	return r.Provider, true
+}
@@ -0,0 +1,119 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+)
+
+const replayTestRecording = "../providers-sdk/v1/testutils/testdata/arch.json"
+
+func connectReplay(t *testing.T, asset string) (*replayProvider, *plugin.ConnectRes) {
+	replay := newReplayProvider()
+	flags := map[string]*llx.Primitive{}
+	if asset != "" {
+		flags[RecordingAssetOption] = llx.StringPrimitive(asset)
+	}
+
+	cli, err := replay.ParseCLI(&plugin.ParseCLIReq{
+		Connector: "recording",
+		Args:      []string{replayTestRecording},
+		Flags:     flags,
+	})
+	require.NoError(t, err)
+
+	res, err := replay.Connect(&plugin.ConnectReq{Asset: cli.Asset}, nil)
+	require.NoError(t, err)
+	return replay, res
+}
+
+func TestReplayConnect(t *testing.T) {
+	_, res := connectReplay(t, "")
+	assert.Equal(t, "Arch Linux", res.Asset.Name)
+	assert.Equal(t, []string{"archlinux"}, res.Asset.PlatformIds)
+	assert.Equal(t, "arch", res.Asset.Platform.Name)
+	assert.Equal(t, RecordingConnectionType, res.Asset.Connections[0].Type)
+
+	_, res = connectReplay(t, "archlinux")
+	assert.Equal(t, "Arch Linux", res.Asset.Name)
+
+	replay := newReplayProvider()
+	cli, err := replay.ParseCLI(&plugin.ParseCLIReq{
+		Args:  []string{replayTestRecording},
+		Flags: map[string]*llx.Primitive{RecordingAssetOption: llx.StringPrimitive("unknown")},
+	})
+	require.NoError(t, err)
+	_, err = replay.Connect(&plugin.ConnectReq{Asset: cli.Asset}, nil)
+	assert.EqualError(t, err, "cannot find asset 'unknown' in recording, available assets: Arch Linux")
+}
+
+func TestReplayGetData(t *testing.T) {
+	replay, conn := connectReplay(t, "")
+
+	res, err := replay.GetData(&plugin.DataReq{
+		Connection: conn.Id,
+		Resource:   "file",
+		Args:       map[string]*llx.Primitive{"path": llx.StringPrimitive("/etc/login.defs")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/login.defs", string(res.Data.Value))
+
+	res, err = replay.GetData(&plugin.DataReq{
+		Connection: conn.Id,
+		Resource:   "file",
+		ResourceId: "/etc/login.defs",
+		Field:      "path",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.Error)
+	assert.Equal(t, "/etc/login.defs", res.Data.RawData().Value)
+
+	res, err = replay.GetData(&plugin.DataReq{
+		Connection: conn.Id,
+		Resource:   "file",
+		ResourceId: "/etc/login.defs",
+		Field:      "group",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "'file.group' is not recorded", res.Error)
+
+	_, err = replay.GetData(&plugin.DataReq{
+		Connection: conn.Id,
+		Resource:   "file",
+		Args:       map[string]*llx.Primitive{"path": llx.StringPrimitive("/does/not/exist")},
+	})
+	assert.EqualError(t, err, "resource 'file' (path: \"/does/not/exist\") is not recorded")
+}
+
+func TestReplayRuntime(t *testing.T) {
+	runtime := Coordinator.NewRuntime()
+	runtime.AddSchema("os", MustLoadSchemaFromFile("os", "os.resources.json"))
+	require.NoError(t, runtime.UseProvider(RecordingProviderID))
+
+	replay := runtime.Provider.Instance.Plugin
+	cli, err := replay.ParseCLI(&plugin.ParseCLIReq{Args: []string{replayTestRecording}})
+	require.NoError(t, err)
+	require.NoError(t, runtime.Connect(&plugin.ConnectReq{Asset: cli.Asset}))
+
+	// os resources are served by the recording instead of the os provider
+	resource, err := runtime.CreateResource("command", map[string]*llx.Primitive{
+		"command": llx.StringPrimitive("mount"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "mount", resource.MqlID())
+
+	raw, err := runtime.watchAndUpdate(context.Background(), "command", "mount", "exitcode", "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), raw.Value)
+
+	raw, err = runtime.watchAndUpdate(context.Background(), "command", "mount", "command", "")
+	require.NoError(t, err)
+	assert.EqualError(t, raw.Error, "'command.command' is not recorded")
+}
@@ -4,6 +4,7 @@
 package providers
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
@@ -36,7 +37,10 @@ type Runtime struct {
 	// schema aggregates all resources executable on this asset
 	schema          extensibleSchema
 	isClosed        bool
+	isConnected     bool
 	shutdownTimeout time.Duration
+	// profile is only set when profiling is enabled
+	profile *Profile
 }
 
 type ConnectedProvider struct {
@@ -93,6 +97,28 @@ func (r *Runtime) Close() {
 		log.Error().Err(err).Msg("failed to save recording")
 	}
 
+	// we hold the coordinator's lock while deciding to shut the provider
+	// down, so that it can't be handed out to another runtime meanwhile
+	instance := r.Provider.Instance
+	r.coordinator.mutex.Lock()
+	instance.lock.Lock()
+	if r.isConnected {
+		instance.connections--
+	}
+	inUse := instance.connections > 0
+	instance.lock.Unlock()
+	if !inUse {
+		r.coordinator.unlist(instance)
+	}
+	r.coordinator.mutex.Unlock()
+
+	// other runtimes are still connected via this provider,
+	// so we can't shut it down yet
+	if inUse {
+		r.schema.Close()
+		return
+	}
+
 	response := make(chan shutdownResult, 1)
 	go func() {
 		response <- r.tryShutdown()
@@ -106,8 +132,9 @@ func (r *Runtime) Close() {
 		}
 	}
 
-	// TODO: ideally, we try to close the provider here but only if there are no more assets that need it
-	// r.coordinator.Close(r.Provider.Instance)
+	if instance.Client != nil {
+		instance.Client.Kill()
+	}
 	r.schema.Close()
 }
 
@@ -139,20 +166,10 @@ func (r *Runtime) AddConnectedProvider(c *ConnectedProvider) {
 }
 
 func (r *Runtime) addProvider(id string) (*ConnectedProvider, error) {
-	var running *RunningProvider
-	for _, p := range r.coordinator.Running {
-		if p.ID == id {
-			running = p
-			break
-		}
-	}
-
-	if running == nil {
-		var err error
-		running, err = r.coordinator.Start(id, r.AutoUpdate)
-		if err != nil {
-			return nil, err
-		}
+	// the coordinator re-uses providers that are already running
+	running, err := r.coordinator.Start(id, r.AutoUpdate)
+	if err != nil {
+		return nil, err
 	}
 
 	res := &ConnectedProvider{Instance: running}
@@ -180,7 +197,10 @@ func (r *Runtime) DetectProvider(asset *inventory.Asset) error {
 			continue
 		}
 
+		// providers may get installed here, which changes the list of providers
+		r.coordinator.mutex.Lock()
 		provider, err := EnsureProvider(r.coordinator.Providers, "", conn.Type, true)
+		r.coordinator.mutex.Unlock()
 		if err != nil {
 			errs.Add(err)
 			continue
@@ -217,11 +237,21 @@ func (r *Runtime) Connect(req *plugin.ConnectReq) error {
 	if err != nil {
 		return err
 	}
+	if !r.isConnected {
+		r.isConnected = true
+		r.Provider.Instance.lock.Lock()
+		r.Provider.Instance.connections++
+		r.Provider.Instance.lock.Unlock()
+	}
 	r.Recording.EnsureAsset(r.Provider.Connection.Asset, r.Provider.Instance.Name, r.Provider.Connection.Id, asset.Connections[0])
 	return nil
 }
 
 func (r *Runtime) CreateResource(name string, args map[string]*llx.Primitive) (llx.Resource, error) {
+	return r.createResource(context.Background(), name, args)
+}
+
+func (r *Runtime) createResource(ctx context.Context, name string, args map[string]*llx.Primitive) (llx.Resource, error) {
 	provider, info, err := r.lookupResourceProvider(name)
 	if err != nil {
 		return nil, err
@@ -240,11 +270,13 @@ func (r *Runtime) CreateResource(name string, args map[string]*llx.Primitive) (l
 		return nil, errors.New("no connection to provider")
 	}
 
-	res, err := provider.Instance.Plugin.GetData(&plugin.DataReq{
+	start := time.Now()
+	res, err := getData(ctx, provider, &plugin.DataReq{
 		Connection: provider.Connection.Id,
 		Resource:   name,
 		Args:       args,
 	})
+	r.profile.track(name, "", false, start)
 	if err != nil {
 		return nil, err
 	}
@@ -291,14 +323,14 @@ func fieldUID(resource string, id string, field string) string {
 
 // WatchAndUpdate a resource field and call the function if it changes with its current value
 func (r *Runtime) WatchAndUpdate(resource llx.Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
-	raw, err := r.watchAndUpdate(resource.MqlName(), resource.MqlID(), field, watcherUID)
+	raw, err := r.watchAndUpdate(context.Background(), resource.MqlName(), resource.MqlID(), field, watcherUID)
 	if raw != nil {
 		callback(raw.Value, raw.Error)
 	}
 	return err
 }
 
-func (r *Runtime) watchAndUpdate(resource string, resourceID string, field string, watcherUID string) (*llx.RawData, error) {
+func (r *Runtime) watchAndUpdate(ctx context.Context, resource string, resourceID string, field string, watcherUID string) (*llx.RawData, error) {
 	provider, info, fieldInfo, err := r.lookupFieldProvider(resource, field)
 	if err != nil {
 		return nil, err
@@ -322,16 +354,19 @@ func (r *Runtime) watchAndUpdate(resource string, resourceID string, field strin
 		}
 	}
 
+	start := time.Now()
 	if cached, ok := r.Recording.GetData(provider.Connection.Id, resource, resourceID, field); ok {
+		r.profile.track(resource, field, true, start)
 		return cached, nil
 	}
 
-	data, err := provider.Instance.Plugin.GetData(&plugin.DataReq{
+	data, err := getData(ctx, provider, &plugin.DataReq{
 		Connection: provider.Connection.Id,
 		Resource:   resource,
 		ResourceId: resourceID,
 		Field:      field,
 	})
+	r.profile.track(resource, field, false, start)
 	if err != nil {
 		return nil, err
 	}
@@ -347,6 +382,68 @@ func (r *Runtime) watchAndUpdate(resource string, resourceID string, field strin
 	return raw, nil
 }
 
+// contextRuntime runs all calls to providers within a context, so that
+// they can be canceled, e.g. when queries time out
+type contextRuntime struct {
+	*Runtime
+	ctx context.Context
+}
+
+// WithContext returns a runtime that cancels all calls to providers once
+// the context is done. Calls return the context's cause in this case.
+func (r *Runtime) WithContext(ctx context.Context) llx.Runtime {
+	return &contextRuntime{Runtime: r, ctx: ctx}
+}
+
+func (r *contextRuntime) CreateResource(name string, args map[string]*llx.Primitive) (llx.Resource, error) {
+	return r.createResource(r.ctx, name, args)
+}
+
+func (r *contextRuntime) WatchAndUpdate(resource llx.Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
+	raw, err := r.watchAndUpdate(r.ctx, resource.MqlName(), resource.MqlID(), field, watcherUID)
+	if raw != nil {
+		callback(raw.Value, raw.Error)
+	}
+	return err
+}
+
+// getData requests data from the provider, unless the context is done
+// before the provider responds
+func getData(ctx context.Context, provider *ConnectedProvider, req *plugin.DataReq) (*plugin.DataRes, error) {
+	if ctx.Done() == nil {
+		return provider.Instance.Plugin.GetData(req)
+	}
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
+
+	type result struct {
+		res *plugin.DataRes
+		err error
+	}
+	// buffered, so the call can finish after we stop waiting for it
+	done := make(chan result, 1)
+	go func() {
+		var x result
+		if p, ok := provider.Instance.Plugin.(plugin.ContextProviderPlugin); ok {
+			x.res, x.err = p.GetDataWithContext(ctx, req)
+		} else {
+			x.res, x.err = provider.Instance.Plugin.GetData(req)
+		}
+		done <- x
+	}()
+
+	select {
+	case x := <-done:
+		if x.err != nil && ctx.Err() != nil {
+			return nil, context.Cause(ctx)
+		}
+		return x.res, x.err
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	}
+}
+
 type providerCallbacks struct {
 	recording *assetRecording
 	runtime   *Runtime
@@ -385,7 +482,7 @@ func (p *providerCallbacks) GetData(req *plugin.DataReq) (*plugin.DataRes, error
 		}, nil
 	}
 
-	raw, err := p.runtime.watchAndUpdate(req.Resource, req.ResourceId, req.Field, "")
+	raw, err := p.runtime.watchAndUpdate(context.Background(), req.Resource, req.ResourceId, req.Field, "")
 	if raw == nil {
 		return nil, err
 	}
@@ -466,6 +563,9 @@ func (r *Runtime) lookupResourceProvider(resource string) (*ConnectedProvider, *
 	if provider := r.providers[info.Provider]; provider != nil {
 		return provider, info, nil
 	}
+	if provider, ok := r.replayProviderFor(info.Provider); ok {
+		return provider, info, nil
+	}
 
 	res, err := r.addProvider(info.Provider)
 	if err != nil {
@@ -497,6 +597,9 @@ func (r *Runtime) lookupFieldProvider(resource string, field string) (*Connected
 	if provider := r.providers[fieldInfo.Provider]; provider != nil {
 		return provider, resourceInfo, fieldInfo, nil
 	}
+	if provider, ok := r.replayProviderFor(fieldInfo.Provider); ok {
+		return provider, resourceInfo, fieldInfo, nil
+	}
 
 	res, err := r.addProvider(fieldInfo.Provider)
 	if err != nil {
@@ -516,6 +619,26 @@ func (r *Runtime) lookupFieldProvider(resource string, field string) (*Connected
 	return res, resourceInfo, fieldInfo, nil
 }
 
+// LookupProvider returns the name of the provider that handles a resource,
+// or the resource's field if it is set. Providers are started if necessary.
+func (r *Runtime) LookupProvider(resource string, field string) (string, error) {
+	var provider *ConnectedProvider
+	var err error
+	if field == "" {
+		provider, _, err = r.lookupResourceProvider(resource)
+	} else {
+		provider, _, _, err = r.lookupFieldProvider(resource, field)
+	}
+	if err != nil {
+		return "", err
+	}
+	// resources that only extend others don't have a provider
+	if provider == nil || provider.Instance == nil {
+		return "", nil
+	}
+	return provider.Instance.Name, nil
+}
+
 func (r *Runtime) Schema() llx.Schema {
 	return &r.schema
 }
@@ -0,0 +1,49 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+)
+
+// hangingPlugin never answers data requests
+type hangingPlugin struct {
+	plugin.ProviderPlugin
+	release chan struct{}
+}
+
+func (p *hangingPlugin) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
+	<-p.release
+	return &plugin.DataRes{Data: llx.StringPrimitive("too late")}, nil
+}
+
+func TestGetDataTimeout(t *testing.T) {
+	p := &hangingPlugin{release: make(chan struct{})}
+	defer close(p.release)
+	provider := &ConnectedProvider{
+		Instance:   &RunningProvider{Plugin: p},
+		Connection: &plugin.ConnectRes{Id: 1},
+	}
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	time.AfterFunc(10*time.Millisecond, func() {
+		cancel(&llx.QueryTimeoutError{Timeout: 10 * time.Millisecond})
+	})
+
+	_, err := getData(ctx, provider, &plugin.DataReq{Resource: "command", ResourceId: "sleep", Field: "stdout"})
+	var timeoutErr *llx.QueryTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, "query timed out after 10ms", err.Error())
+
+	// once the context is done, providers aren't called anymore
+	_, err = getData(ctx, provider, &plugin.DataReq{Resource: "command", ResourceId: "sleep", Field: "stderr"})
+	assert.ErrorAs(t, err, &timeoutErr)
+}
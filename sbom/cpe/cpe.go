@@ -0,0 +1,99 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package cpe creates CPE 2.3 names, see https://nvlpubs.nist.gov/nistpubs/Legacy/IR/nistir7695.pdf
+package cpe
+
+import (
+	"strings"
+)
+
+// targetSoftware maps package formats to the target software of their CPEs
+var targetSoftware = map[string]string{
+	"pypi": "python",
+	"npm":  "node.js",
+}
+
+// NewPackageCpes guesses the CPE 2.3 names of a package. This is a heuristic:
+// packages rarely name their vendor the way the NVD does, so we use the
+// product name for it, which is how most of them are listed.
+//
+// OS packages are named after the upstream project they were built from,
+// which is their origin (if known). Their versions contain an epoch and a
+// distro revision, which are not part of the upstream version, so we remove
+// them. Packages of other formats don't get CPEs, since we can't guess them
+// with any confidence.
+func NewPackageCpes(format string, name string, origin string, version string) []string {
+	if name == "" || version == "" {
+		return nil
+	}
+
+	product := name
+	vendor := name
+	switch format {
+	case "npm":
+		// npm scopes are the closest we have to a vendor
+		if scope, rest, ok := strings.Cut(product, "/"); ok && strings.HasPrefix(scope, "@") {
+			vendor = strings.TrimPrefix(scope, "@")
+			product = rest
+		}
+	case "pypi":
+	case "deb", "rpm", "apk":
+		if origin != "" {
+			product = origin
+			vendor = origin
+		}
+		version = upstreamVersion(version)
+	default:
+		return nil
+	}
+
+	targetSw, ok := targetSoftware[format]
+	if !ok {
+		targetSw = "*"
+	}
+
+	return []string{New("a", vendor, product, version, targetSw)}
+}
+
+// upstreamVersion removes the epoch and the distro revision from the
+// version of an OS package, e.g. 1:1.1.1k-9.el8_7 becomes 1.1.1k
+func upstreamVersion(version string) string {
+	if _, rest, ok := strings.Cut(version, ":"); ok {
+		version = rest
+	}
+	if i := strings.LastIndex(version, "-"); i > 0 {
+		version = version[:i]
+	}
+	return version
+}
+
+// New creates a CPE 2.3 formatted string. All other attributes are ANY.
+func New(part string, vendor string, product string, version string, targetSw string) string {
+	return "cpe:2.3:" + part + ":" +
+		escape(vendor) + ":" +
+		escape(product) + ":" +
+		escape(version) + ":*:*:*:*:" +
+		escape(targetSw) + ":*:*"
+}
+
+// escape lowercases a CPE attribute and escapes its special characters
+func escape(s string) string {
+	if s == "" || s == "*" {
+		return "*"
+	}
+
+	var res strings.Builder
+	for _, c := range strings.ToLower(s) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '_', c == '-', c == '.':
+			res.WriteRune(c)
+		case c == ' ':
+			res.WriteRune('_')
+		default:
+			res.WriteRune('\\')
+			res.WriteRune(c)
+		}
+	}
+	return res.String()
+}
@@ -0,0 +1,30 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cpe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCpes(t *testing.T) {
+	assert.Equal(t,
+		[]string{"cpe:2.3:a:openssl:openssl:1.1.1k:*:*:*:*:*:*:*"},
+		NewPackageCpes("rpm", "openssl", "", "1:1.1.1k-9.el8_7"))
+	assert.Equal(t,
+		[]string{"cpe:2.3:a:glibc:glibc:2.31:*:*:*:*:*:*:*"},
+		NewPackageCpes("deb", "libc6", "glibc", "2.31-13+deb11u5"))
+	assert.Equal(t,
+		[]string{"cpe:2.3:a:busybox:busybox:1.36.1:*:*:*:*:*:*:*"},
+		NewPackageCpes("apk", "busybox", "", "1.36.1-r2"))
+	assert.Equal(t,
+		[]string{"cpe:2.3:a:angular:cli:16.1.0:*:*:*:*:node.js:*:*"},
+		NewPackageCpes("npm", "@angular/cli", "", "16.1.0"))
+	assert.Equal(t,
+		[]string{"cpe:2.3:a:django:django:4.2.4:*:*:*:*:python:*:*"},
+		NewPackageCpes("pypi", "Django", "", "4.2.4"))
+	assert.Nil(t, NewPackageCpes("deb", "unknown", "", ""))
+	assert.Nil(t, NewPackageCpes("macos", "Safari", "", "16.5"))
+}
@@ -0,0 +1,245 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/utils/sortx"
+)
+
+// CycloneDX 1.5 JSON, see https://cyclonedx.org/docs/1.5/json/
+type cdxBom struct {
+	BomFormat    string          `json:"bomFormat"`
+	SpecVersion  string          `json:"specVersion"`
+	SerialNumber string          `json:"serialNumber"`
+	Version      int             `json:"version"`
+	Metadata     cdxMetadata     `json:"metadata"`
+	Components   []*cdxComponent `json:"components"`
+}
+
+type cdxMetadata struct {
+	Timestamp string        `json:"timestamp"`
+	Tools     cdxTools      `json:"tools"`
+	Component *cdxComponent `json:"component,omitempty"`
+}
+
+type cdxTools struct {
+	Components []*cdxComponent `json:"components"`
+}
+
+type cdxComponent struct {
+	BomRef      string        `json:"bom-ref,omitempty"`
+	Type        string        `json:"type"`
+	Supplier    *cdxSupplier  `json:"supplier,omitempty"`
+	Author      string        `json:"author,omitempty"`
+	Name        string        `json:"name"`
+	Version     string        `json:"version,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Licenses    []cdxLicense  `json:"licenses,omitempty"`
+	Cpe         string        `json:"cpe,omitempty"`
+	Purl        string        `json:"purl,omitempty"`
+	Properties  []cdxProperty `json:"properties,omitempty"`
+	Evidence    *cdxEvidence  `json:"evidence,omitempty"`
+	Pedigree    *cdxPedigree  `json:"pedigree,omitempty"`
+}
+
+type cdxSupplier struct {
+	Name string `json:"name"`
+}
+
+type cdxLicense struct {
+	License cdxLicenseName `json:"license"`
+}
+
+type cdxLicenseName struct {
+	Name string `json:"name"`
+}
+
+type cdxProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type cdxEvidence struct {
+	Occurrences []cdxOccurrence `json:"occurrences"`
+}
+
+type cdxOccurrence struct {
+	Location string `json:"location"`
+}
+
+type cdxPedigree struct {
+	Ancestors []cdxComponent `json:"ancestors"`
+}
+
+// WriteCycloneDX encodes the SBOM as CycloneDX 1.5 JSON
+func (s *Sbom) WriteCycloneDX(w io.Writer) error {
+	bom := cdxBom{
+		BomFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + uuid.Must(uuid.NewV4()).String(),
+		Version:      1,
+		Metadata: cdxMetadata{
+			Timestamp: s.Timestamp.UTC().Format(time.RFC3339),
+			Tools: cdxTools{
+				Components: []*cdxComponent{{
+					Type:    "application",
+					Author:  "Mondoo, Inc.",
+					Name:    "cnquery",
+					Version: cnquery.GetVersion(),
+				}},
+			},
+			Component: cdxAsset(s.Asset),
+		},
+		Components: make([]*cdxComponent, len(s.Packages)),
+	}
+
+	ids := s.packageIDs()
+	for i := range s.Packages {
+		pkg := s.Packages[i]
+		c := &cdxComponent{
+			BomRef:  ids[i],
+			Type:    "library",
+			Name:    pkg.Name,
+			Version: pkg.Version,
+			Purl:    pkg.Purl,
+		}
+		if len(pkg.Cpes) != 0 {
+			c.Cpe = pkg.Cpes[0]
+		}
+		if pkg.Vendor != "" {
+			c.Supplier = &cdxSupplier{Name: pkg.Vendor}
+		}
+		for _, license := range pkg.Licenses {
+			c.Licenses = append(c.Licenses, cdxLicense{License: cdxLicenseName{Name: license}})
+		}
+		c.Properties = cdxProperties(
+			"mondoo:format", pkg.Format,
+			"mondoo:arch", pkg.Arch,
+			"mondoo:origin", pkg.Origin,
+		)
+		// CycloneDX only has one CPE per component
+		for j := 1; j < len(pkg.Cpes); j++ {
+			c.Properties = append(c.Properties, cdxProperty{Name: "mondoo:cpe", Value: pkg.Cpes[j]})
+		}
+		if pkg.Origin != "" && pkg.Origin != pkg.Name {
+			c.Pedigree = &cdxPedigree{Ancestors: []cdxComponent{{Type: "library", Name: pkg.Origin}}}
+		}
+		if pkg.Location != "" {
+			c.Evidence = &cdxEvidence{Occurrences: []cdxOccurrence{{Location: pkg.Location}}}
+		}
+		bom.Components[i] = c
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(bom)
+}
+
+// cdxAsset describes the asset as the component the SBOM is about
+func cdxAsset(asset *inventory.Asset) *cdxComponent {
+	if asset == nil {
+		return nil
+	}
+
+	res := &cdxComponent{
+		BomRef: assetID(asset),
+		Type:   "device",
+		Name:   asset.Name,
+	}
+
+	props := []string{"mondoo:mrn", asset.Mrn}
+	for i := range asset.PlatformIds {
+		props = append(props, "mondoo:platform-id", asset.PlatformIds[i])
+	}
+
+	if p := asset.Platform; p != nil {
+		res.Type = cdxAssetType(p)
+		if res.Name == "" {
+			res.Name = p.Name
+		}
+		res.Version = p.Version
+		res.Description = p.Title
+		props = append(props,
+			"mondoo:platform", p.Name,
+			"mondoo:arch", p.Arch,
+			"mondoo:family", strings.Join(p.Family, ","),
+			"mondoo:build", p.Build,
+			"mondoo:kind", p.Kind,
+			"mondoo:runtime", p.Runtime,
+		)
+	}
+
+	for _, k := range sortx.Keys(asset.Labels) {
+		props = append(props, "mondoo:label:"+k, asset.Labels[k])
+	}
+	res.Properties = cdxProperties(props...)
+
+	return res
+}
+
+func cdxAssetType(platform *inventory.Platform) string {
+	switch platform.Kind {
+	case "container", "container-image", "container_image":
+		return "container"
+	}
+	for _, family := range platform.Family {
+		if family == "os" {
+			return "operating-system"
+		}
+	}
+	return "device"
+}
+
+// cdxProperties creates properties from name and value pairs, skipping
+// all properties that have no value
+func cdxProperties(pairs ...string) []cdxProperty {
+	var res []cdxProperty
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			continue
+		}
+		res = append(res, cdxProperty{Name: pairs[i], Value: pairs[i+1]})
+	}
+	return res
+}
+
+var invalidIDChars = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+// packageIDs creates a unique ID for every package, which works for
+// CycloneDX and SPDX
+func (s *Sbom) packageIDs() []string {
+	res := make([]string, len(s.Packages))
+	seen := map[string]int{}
+	for i := range s.Packages {
+		pkg := s.Packages[i]
+		id := invalidIDChars.ReplaceAllString(pkg.Format+"-"+pkg.Name+"-"+pkg.Version, "-")
+		id = "Package-" + strings.Trim(id, "-")
+		if n, ok := seen[id]; ok {
+			seen[id] = n + 1
+			id += "-" + strconv.Itoa(n+1)
+		} else {
+			seen[id] = 1
+		}
+		res[i] = id
+	}
+	return res
+}
+
+func assetID(asset *inventory.Asset) string {
+	name := asset.Name
+	if name == "" && asset.Platform != nil {
+		name = asset.Platform.Name
+	}
+	return "Asset-" + strings.Trim(invalidIDChars.ReplaceAllString(name, "-"), "-")
+}
@@ -0,0 +1,115 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package purl creates package URLs, see https://github.com/package-url/purl-spec
+package purl
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+)
+
+// purl types, see https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst
+const (
+	TypeDebian  = "deb"
+	TypeRPM     = "rpm"
+	TypeApk     = "apk"
+	TypeAlpm    = "alpm"
+	TypePypi    = "pypi"
+	TypeNpm     = "npm"
+	TypeGeneric = "generic"
+)
+
+// types maps package formats to their purl type
+var types = map[string]string{
+	"deb":    TypeDebian,
+	"rpm":    TypeRPM,
+	"apk":    TypeApk,
+	"pacman": TypeAlpm,
+	"pypi":   TypePypi,
+	"npm":    TypeNpm,
+}
+
+// Type returns the purl type for a package format. Formats without
+// a purl type are generic.
+func Type(format string) string {
+	if typ, ok := types[format]; ok {
+		return typ
+	}
+	return TypeGeneric
+}
+
+// NewPackageURL creates the package URL for a package. OS packages are
+// namespaced by the platform they are installed on and carry its distro
+// and their architecture as qualifiers.
+func NewPackageURL(platform *inventory.Platform, format string, name string, version string, arch string, origin string) string {
+	typ := Type(format)
+	namespace := ""
+	qualifiers := map[string]string{}
+
+	switch typ {
+	case TypeDebian, TypeRPM, TypeApk, TypeAlpm:
+		if platform != nil {
+			namespace = platform.Name
+			if platform.Version != "" {
+				qualifiers["distro"] = platform.Name + "-" + platform.Version
+			}
+		}
+		qualifiers["arch"] = arch
+		if origin != "" && origin != name {
+			qualifiers["upstream"] = origin
+		}
+		// rpm versions carry the epoch as prefix, purls use a qualifier instead
+		if typ == TypeRPM {
+			if epoch, rest, ok := strings.Cut(version, ":"); ok {
+				qualifiers["epoch"] = epoch
+				version = rest
+			}
+		}
+	case TypePypi:
+		// pypi names are case-insensitive and treat _ and - the same
+		name = strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	case TypeNpm:
+		if scope, rest, ok := strings.Cut(name, "/"); ok && strings.HasPrefix(scope, "@") {
+			namespace = scope
+			name = rest
+		}
+	}
+
+	var res strings.Builder
+	res.WriteString("pkg:" + typ + "/")
+	if namespace != "" {
+		res.WriteString(escape(namespace) + "/")
+	}
+	res.WriteString(escape(name))
+	if version != "" {
+		res.WriteString("@" + escape(version))
+	}
+
+	keys := make([]string, 0, len(qualifiers))
+	for k, v := range qualifiers {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i == 0 {
+			res.WriteString("?")
+		} else {
+			res.WriteString("&")
+		}
+		res.WriteString(k + "=" + url.QueryEscape(qualifiers[k]))
+	}
+
+	return res.String()
+}
+
+// escape percent-encodes a purl component, including the @ that
+// separates the version
+func escape(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+}
@@ -0,0 +1,83 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package purl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+)
+
+var debian = &inventory.Platform{
+	Name:    "debian",
+	Version: "11",
+	Arch:    "x86_64",
+	Title:   "Debian GNU/Linux 11 (bullseye)",
+	Family:  []string{"debian", "linux", "unix", "os"},
+}
+
+type pkg struct {
+	name    string
+	version string
+	arch    string
+	format  string
+	origin  string
+}
+
+func TestPurl(t *testing.T) {
+	tests := []struct {
+		pkg      pkg
+		platform *inventory.Platform
+		purl     string
+	}{
+		{
+			pkg{name: "libc6", version: "2.31-13+deb11u5", arch: "amd64", format: "deb", origin: "glibc"},
+			debian,
+			"pkg:deb/debian/libc6@2.31-13+deb11u5?arch=amd64&distro=debian-11&upstream=glibc",
+		},
+		{
+			pkg{name: "openssl", version: "1:1.1.1k-9.el8_7", arch: "x86_64", format: "rpm"},
+			&inventory.Platform{Name: "redhat", Version: "8.7"},
+			"pkg:rpm/redhat/openssl@1.1.1k-9.el8_7?arch=x86_64&distro=redhat-8.7&epoch=1",
+		},
+		{
+			pkg{name: "musl", version: "1.2.4-r1", arch: "x86_64", format: "apk", origin: "musl"},
+			&inventory.Platform{Name: "alpine", Version: "3.18.3"},
+			"pkg:apk/alpine/musl@1.2.4-r1?arch=x86_64&distro=alpine-3.18.3",
+		},
+		{
+			pkg{name: "acl", version: "2.3.1-3", arch: "x86_64", format: "pacman"},
+			&inventory.Platform{Name: "arch"},
+			"pkg:alpm/arch/acl@2.3.1-3?arch=x86_64",
+		},
+		{
+			pkg{name: "PyYAML", version: "6.0", format: "pypi"},
+			debian,
+			"pkg:pypi/pyyaml@6.0",
+		},
+		{
+			pkg{name: "typing_extensions", version: "4.7.1", format: "pypi"},
+			nil,
+			"pkg:pypi/typing-extensions@4.7.1",
+		},
+		{
+			pkg{name: "@angular/cli", version: "16.1.0", format: "npm"},
+			nil,
+			"pkg:npm/%40angular/cli@16.1.0",
+		},
+		{
+			pkg{name: "Google Chrome", version: "116.0", format: "macos"},
+			nil,
+			"pkg:generic/Google%20Chrome@116.0",
+		},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.purl, func(t *testing.T) {
+			assert.Equal(t, cur.purl, NewPackageURL(cur.platform, cur.pkg.format, cur.pkg.name, cur.pkg.version, cur.pkg.arch, cur.pkg.origin))
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package sbom creates software bills of materials (SBOMs) from the packages
+// that are discovered on an asset and exports them as CycloneDX and SPDX.
+package sbom
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mql"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/sbom/cpe"
+	"go.mondoo.com/cnquery/sbom/purl"
+)
+
+// Package formats of language ecosystems. OS packages use the format
+// that their package manager reports (e.g. deb, rpm, apk).
+const (
+	PypiFormat = "pypi"
+	NpmFormat  = "npm"
+)
+
+// Sbom is the software bill of materials for one asset
+type Sbom struct {
+	Asset     *inventory.Asset
+	Packages  []*Package
+	Timestamp time.Time
+}
+
+// Package is a software component that was found on the asset
+type Package struct {
+	Name    string
+	Version string
+	Arch    string
+	// Format of the package, e.g. deb, rpm, apk, pypi, npm
+	Format string
+	// Origin is the source package this package was built from (optional)
+	Origin   string
+	Vendor   string
+	Licenses []string
+	// Location of the file that this package was discovered in (optional)
+	Location string
+	Purl     string
+	Cpes     []string
+}
+
+// source is a query that discovers packages, together with the resource
+// it needs and the format of the packages it returns
+type source struct {
+	resource string
+	format   string
+	query    string
+}
+
+var sources = []source{
+	{resource: "packages", query: "packages { name version arch format origin vendor purl cpes licenses }"},
+	{resource: "python", format: PypiFormat, query: "python.packages { name version license file.path }"},
+	{resource: "npm.packages", format: NpmFormat, query: "npm.packages { name version file.path }"},
+}
+
+// Collect runs all package queries on the asset's runtime. Queries for
+// resources that the runtime doesn't support, or that fail on the asset,
+// are skipped.
+func Collect(runtime llx.Runtime, asset *inventory.Asset, features cnquery.Features) (*Sbom, error) {
+	res := &Sbom{
+		Asset:     asset,
+		Timestamp: time.Now().UTC(),
+	}
+
+	schema := runtime.Schema()
+	for i := range sources {
+		src := sources[i]
+		if schema.Lookup(src.resource) == nil {
+			continue
+		}
+
+		raw, err := exec(runtime, src.query, features)
+		if err != nil {
+			return nil, errors.New("failed to collect " + src.resource + ": " + err.Error())
+		}
+		if raw.Error != nil {
+			// e.g. assets without a supported package manager
+			log.Warn().Err(raw.Error).Str("resource", src.resource).Msg("sbom> could not collect packages")
+			continue
+		}
+
+		entries, _ := raw.Value.([]interface{})
+		for j := range entries {
+			fields, ok := entries[j].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			pkg := &Package{
+				Name:     llx.TRaw2T[string](fields["name"]),
+				Version:  llx.TRaw2T[string](fields["version"]),
+				Arch:     llx.TRaw2T[string](fields["arch"]),
+				Format:   llx.TRaw2T[string](fields["format"]),
+				Origin:   llx.TRaw2T[string](fields["origin"]),
+				Vendor:   llx.TRaw2T[string](fields["vendor"]),
+				Licenses: llx.TRaw2TArr[string](fields["licenses"]),
+				Location: llx.TRaw2T[string](fields["file.path"]),
+				Purl:     llx.TRaw2T[string](fields["purl"]),
+				Cpes:     llx.TRaw2TArr[string](fields["cpes"]),
+			}
+			if license := llx.TRaw2T[string](fields["license"]); license != "" {
+				pkg.Licenses = []string{license}
+			}
+			if src.format != "" {
+				pkg.Format = src.format
+			}
+			if pkg.Name == "" {
+				continue
+			}
+			res.Packages = append(res.Packages, pkg)
+		}
+	}
+
+	res.finalize()
+	return res, nil
+}
+
+// exec runs a query that returns a single value, e.g. a list of packages
+func exec(runtime llx.Runtime, query string, features cnquery.Features) (*llx.RawData, error) {
+	bundle, err := mqlc.Compile(query, nil, mqlc.NewConfig(runtime.Schema(), features))
+	if err != nil {
+		return nil, errors.New("failed to compile: " + err.Error())
+	}
+
+	raw, err := mql.ExecuteCode(runtime, bundle, nil, features)
+	if err != nil {
+		return nil, err
+	}
+
+	results := llx.ReturnValuesV2(bundle, func(checksum string) (*llx.RawResult, bool) {
+		res, ok := raw[checksum]
+		return res, ok
+	})
+	if len(results) != 1 {
+		return nil, errors.New("expected one result for query")
+	}
+
+	return results[0].Data.Dereference(results[0].CodeID, bundle)
+}
+
+// finalize sorts all packages and adds their purl and CPEs
+func (s *Sbom) finalize() {
+	var platform *inventory.Platform
+	if s.Asset != nil {
+		platform = s.Asset.Platform
+	}
+
+	for i := range s.Packages {
+		pkg := s.Packages[i]
+		if pkg.Purl == "" {
+			pkg.Purl = purl.NewPackageURL(platform, pkg.Format, pkg.Name, pkg.Version, pkg.Arch, pkg.Origin)
+		}
+		if len(pkg.Cpes) == 0 {
+			pkg.Cpes = cpe.NewPackageCpes(pkg.Format, pkg.Name, pkg.Origin, pkg.Version)
+		}
+	}
+
+	sort.SliceStable(s.Packages, func(i, j int) bool {
+		a, b := s.Packages[i], s.Packages[j]
+		if a.Format != b.Format {
+			return a.Format < b.Format
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Version < b.Version
+	})
+}
+
+// Output formats that an SBOM can be written in
+const (
+	FormatCycloneDxJson = "cyclonedx-json"
+	FormatSpdxJson      = "spdx-json"
+)
+
+// AllFormats lists all supported output formats
+func AllFormats() string {
+	return strings.Join([]string{FormatCycloneDxJson, FormatSpdxJson}, ", ")
+}
+
+// Write encodes the SBOM in the given format
+func (s *Sbom) Write(format string, w io.Writer) error {
+	switch format {
+	case FormatCycloneDxJson:
+		return s.WriteCycloneDX(w)
+	case FormatSpdxJson:
+		return s.WriteSPDX(w)
+	default:
+		return errors.New("unsupported SBOM format '" + format + "', please use one of: " + AllFormats())
+	}
+}
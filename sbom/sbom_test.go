@@ -0,0 +1,130 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/testutils"
+)
+
+func testSbom() *Sbom {
+	res := &Sbom{
+		Asset: &inventory.Asset{
+			Name:        "debian-host",
+			Mrn:         "//assets.api.mondoo.app/assets/1",
+			PlatformIds: []string{"//platformid.api.mondoo.app/hostname/debian-host"},
+			Platform: &inventory.Platform{
+				Name:    "debian",
+				Version: "11",
+				Arch:    "x86_64",
+				Title:   "Debian GNU/Linux 11 (bullseye)",
+				Family:  []string{"debian", "linux", "unix", "os"},
+			},
+			Labels: map[string]string{"env": "prod"},
+		},
+		Packages: []*Package{
+			{Name: "libc6", Version: "2.31-13+deb11u5", Arch: "amd64", Format: "deb", Origin: "glibc", Vendor: "GNU Libc Maintainers", Licenses: []string{"LGPL-2.1", "GPL-2.0"}},
+			{Name: "requests", Version: "2.31.0", Format: PypiFormat, Licenses: []string{"Apache 2.0"}, Location: "/usr/lib/python3/dist-packages/requests-2.31.0.dist-info/METADATA"},
+			{Name: "express", Version: "4.18.2", Format: NpmFormat, Location: "/app/package-lock.json"},
+			{Name: "express", Version: "4.18.2", Format: NpmFormat, Location: "/srv/package-lock.json"},
+		},
+		Timestamp: time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC),
+	}
+	res.finalize()
+	return res
+}
+
+func TestCycloneDX(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, testSbom().Write(FormatCycloneDxJson, &out))
+
+	var bom cdxBom
+	require.NoError(t, json.Unmarshal(out.Bytes(), &bom))
+	assert.Equal(t, "CycloneDX", bom.BomFormat)
+	assert.Equal(t, "1.5", bom.SpecVersion)
+	assert.Regexp(t, "^urn:uuid:[0-9a-f-]{36}$", bom.SerialNumber)
+	assert.Equal(t, "2023-09-01T12:00:00Z", bom.Metadata.Timestamp)
+
+	asset := bom.Metadata.Component
+	require.NotNil(t, asset)
+	assert.Equal(t, "operating-system", asset.Type)
+	assert.Equal(t, "debian-host", asset.Name)
+	assert.Equal(t, "11", asset.Version)
+	assert.Contains(t, asset.Properties, cdxProperty{Name: "mondoo:label:env", Value: "prod"})
+
+	require.Len(t, bom.Components, 4)
+	libc := bom.Components[0]
+	assert.Equal(t, "Package-deb-libc6-2.31-13-deb11u5", libc.BomRef)
+	assert.Equal(t, "pkg:deb/debian/libc6@2.31-13+deb11u5?arch=amd64&distro=debian-11&upstream=glibc", libc.Purl)
+	assert.Equal(t, "cpe:2.3:a:glibc:glibc:2.31:*:*:*:*:*:*:*", libc.Cpe)
+	assert.Contains(t, libc.Properties, cdxProperty{Name: "mondoo:arch", Value: "amd64"})
+	assert.Contains(t, libc.Properties, cdxProperty{Name: "mondoo:origin", Value: "glibc"})
+	assert.Equal(t, &cdxSupplier{Name: "GNU Libc Maintainers"}, libc.Supplier)
+	assert.Len(t, libc.Licenses, 2)
+
+	// packages with the same name and version still get unique refs
+	assert.Equal(t, "Package-npm-express-4.18.2", bom.Components[1].BomRef)
+	assert.Equal(t, "Package-npm-express-4.18.2-2", bom.Components[2].BomRef)
+	assert.Equal(t, "/srv/package-lock.json", bom.Components[2].Evidence.Occurrences[0].Location)
+
+	requests := bom.Components[3]
+	assert.Equal(t, []cdxLicense{{License: cdxLicenseName{Name: "Apache 2.0"}}}, requests.Licenses)
+}
+
+func TestSPDX(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, testSbom().Write(FormatSpdxJson, &out))
+
+	var doc spdxDocument
+	require.NoError(t, json.Unmarshal(out.Bytes(), &doc))
+	assert.Equal(t, "SPDX-2.3", doc.SpdxVersion)
+	assert.Equal(t, "CC0-1.0", doc.DataLicense)
+	assert.Equal(t, "debian-host", doc.Name)
+	assert.Equal(t, "2023-09-01T12:00:00Z", doc.CreationInfo.Created)
+
+	require.Len(t, doc.Packages, 5)
+	asset := doc.Packages[0]
+	assert.Equal(t, "SPDXRef-Asset-debian-host", asset.SPDXID)
+	assert.Equal(t, "OPERATING-SYSTEM", asset.PrimaryPackagePurpose)
+	assert.Contains(t, asset.Comment, "mrn: //assets.api.mondoo.app/assets/1")
+
+	libc := doc.Packages[1]
+	assert.Equal(t, "built from source package glibc", libc.SourceInfo)
+	assert.Equal(t, "arch: amd64", libc.Comment)
+	assert.Equal(t, "(LGPL-2.1 AND GPL-2.0)", libc.LicenseDeclared)
+	assert.Equal(t, "Organization: GNU Libc Maintainers", libc.Supplier)
+	assert.Equal(t, []spdxExternalRef{
+		{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: "pkg:deb/debian/libc6@2.31-13+deb11u5?arch=amd64&distro=debian-11&upstream=glibc"},
+		{ReferenceCategory: "SECURITY", ReferenceType: "cpe23Type", ReferenceLocator: "cpe:2.3:a:glibc:glibc:2.31:*:*:*:*:*:*:*"},
+	}, libc.ExternalRefs)
+
+	requests := doc.Packages[4]
+	assert.Equal(t, "NOASSERTION", requests.LicenseDeclared)
+	assert.Equal(t, "declared licenses: Apache 2.0", requests.LicenseComments)
+
+	require.Len(t, doc.Relationships, 5)
+	assert.Equal(t, spdxRelationship{SpdxElementID: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSpdxElement: "SPDXRef-Asset-debian-host"}, doc.Relationships[0])
+	assert.Equal(t, spdxRelationship{SpdxElementID: "SPDXRef-Asset-debian-host", RelationshipType: "CONTAINS", RelatedSpdxElement: "SPDXRef-Package-deb-libc6-2.31-13-deb11u5"}, doc.Relationships[1])
+}
+
+func TestCollect(t *testing.T) {
+	runtime := testutils.LinuxMock()
+	asset := &inventory.Asset{Name: "arch", Platform: &inventory.Platform{Name: "arch", Family: []string{"arch", "linux", "unix", "os"}}}
+
+	res, err := Collect(runtime, asset, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Packages)
+	assert.Equal(t, "acl", res.Packages[0].Name)
+	assert.Equal(t, "1.2.3", res.Packages[0].Version)
+	assert.Equal(t, "pkg:generic/acl@1.2.3", res.Packages[0].Purl)
+
+	assert.Error(t, res.Write("xml", &bytes.Buffer{}))
+}
@@ -0,0 +1,254 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/utils/sortx"
+)
+
+// SPDX 2.3 JSON, see https://spdx.github.io/spdx-spec/v2.3/
+type spdxDocument struct {
+	SpdxVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []*spdxPackage     `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID                string            `json:"SPDXID"`
+	Name                  string            `json:"name"`
+	VersionInfo           string            `json:"versionInfo,omitempty"`
+	Supplier              string            `json:"supplier,omitempty"`
+	DownloadLocation      string            `json:"downloadLocation"`
+	FilesAnalyzed         bool              `json:"filesAnalyzed"`
+	SourceInfo            string            `json:"sourceInfo,omitempty"`
+	LicenseConcluded      string            `json:"licenseConcluded"`
+	LicenseDeclared       string            `json:"licenseDeclared"`
+	LicenseComments       string            `json:"licenseComments,omitempty"`
+	CopyrightText         string            `json:"copyrightText"`
+	Description           string            `json:"description,omitempty"`
+	Comment               string            `json:"comment,omitempty"`
+	ExternalRefs          []spdxExternalRef `json:"externalRefs,omitempty"`
+	PrimaryPackagePurpose string            `json:"primaryPackagePurpose,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SpdxElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSpdxElement string `json:"relatedSpdxElement"`
+}
+
+const (
+	spdxNoAssertion = "NOASSERTION"
+	spdxDocumentID  = "SPDXRef-DOCUMENT"
+)
+
+// simple SPDX license identifiers, more complex license expressions and
+// free-text licenses are reported as comments
+var spdxLicenseID = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.+-]*$`)
+
+// WriteSPDX encodes the SBOM as SPDX 2.3 JSON
+func (s *Sbom) WriteSPDX(w io.Writer) error {
+	name := "sbom"
+	if s.Asset != nil && s.Asset.Name != "" {
+		name = s.Asset.Name
+	}
+	id := uuid.Must(uuid.NewV4()).String()
+
+	doc := spdxDocument{
+		SpdxVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            spdxDocumentID,
+		Name:              name,
+		DocumentNamespace: "https://mondoo.com/spdx/" + invalidIDChars.ReplaceAllString(name, "-") + "-" + id,
+		CreationInfo: spdxCreationInfo{
+			Created: s.Timestamp.UTC().Format(time.RFC3339),
+			Creators: []string{
+				"Organization: Mondoo, Inc.",
+				"Tool: cnquery-" + cnquery.GetVersion(),
+			},
+		},
+		Packages: make([]*spdxPackage, 0, len(s.Packages)+1),
+	}
+
+	// the asset is the package that the document describes, it contains
+	// all other packages
+	root := spdxDocumentID
+	if asset := spdxAsset(s.Asset); asset != nil {
+		root = asset.SPDXID
+		doc.Packages = append(doc.Packages, asset)
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SpdxElementID:      spdxDocumentID,
+			RelationshipType:   "DESCRIBES",
+			RelatedSpdxElement: root,
+		})
+	}
+
+	ids := s.packageIDs()
+	for i := range s.Packages {
+		pkg := s.Packages[i]
+		p := &spdxPackage{
+			SPDXID:                "SPDXRef-" + ids[i],
+			Name:                  pkg.Name,
+			VersionInfo:           pkg.Version,
+			DownloadLocation:      spdxNoAssertion,
+			LicenseConcluded:      spdxNoAssertion,
+			CopyrightText:         spdxNoAssertion,
+			PrimaryPackagePurpose: "LIBRARY",
+		}
+		p.LicenseDeclared, p.LicenseComments = spdxLicense(pkg.Licenses)
+		if pkg.Vendor != "" {
+			p.Supplier = "Organization: " + pkg.Vendor
+		}
+		if pkg.Origin != "" && pkg.Origin != pkg.Name {
+			p.SourceInfo = "built from source package " + pkg.Origin
+		}
+
+		var comments []string
+		if pkg.Arch != "" {
+			comments = append(comments, "arch: "+pkg.Arch)
+		}
+		if pkg.Location != "" {
+			comments = append(comments, "location: "+pkg.Location)
+		}
+		p.Comment = strings.Join(comments, ", ")
+
+		if pkg.Purl != "" {
+			p.ExternalRefs = append(p.ExternalRefs, spdxExternalRef{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  pkg.Purl,
+			})
+		}
+		for _, cpe := range pkg.Cpes {
+			p.ExternalRefs = append(p.ExternalRefs, spdxExternalRef{
+				ReferenceCategory: "SECURITY",
+				ReferenceType:     "cpe23Type",
+				ReferenceLocator:  cpe,
+			})
+		}
+
+		doc.Packages = append(doc.Packages, p)
+		relation := "CONTAINS"
+		if root == spdxDocumentID {
+			relation = "DESCRIBES"
+		}
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SpdxElementID:      root,
+			RelationshipType:   relation,
+			RelatedSpdxElement: p.SPDXID,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// spdxLicense returns the declared license expression for a list of
+// licenses. If any of them isn't a valid SPDX identifier, they are
+// reported as license comment instead.
+func spdxLicense(licenses []string) (string, string) {
+	if len(licenses) == 0 {
+		return spdxNoAssertion, ""
+	}
+	for _, license := range licenses {
+		if !spdxLicenseID.MatchString(license) {
+			return spdxNoAssertion, "declared licenses: " + strings.Join(licenses, ", ")
+		}
+	}
+	if len(licenses) == 1 {
+		return licenses[0], ""
+	}
+	return "(" + strings.Join(licenses, " AND ") + ")", ""
+}
+
+// spdxAsset describes the asset as the package the SBOM is about
+func spdxAsset(asset *inventory.Asset) *spdxPackage {
+	if asset == nil {
+		return nil
+	}
+
+	res := &spdxPackage{
+		SPDXID:           "SPDXRef-" + assetID(asset),
+		Name:             asset.Name,
+		DownloadLocation: spdxNoAssertion,
+		LicenseConcluded: spdxNoAssertion,
+		LicenseDeclared:  spdxNoAssertion,
+		CopyrightText:    spdxNoAssertion,
+		Supplier:         spdxNoAssertion,
+	}
+
+	comments := []string{}
+	if asset.Mrn != "" {
+		comments = append(comments, "mrn: "+asset.Mrn)
+	}
+	if len(asset.PlatformIds) != 0 {
+		comments = append(comments, "platform ids: "+strings.Join(asset.PlatformIds, " "))
+	}
+
+	if p := asset.Platform; p != nil {
+		res.PrimaryPackagePurpose = spdxAssetPurpose(p)
+		if res.Name == "" {
+			res.Name = p.Name
+		}
+		res.VersionInfo = p.Version
+		res.Description = p.Title
+		comments = append(comments, "platform: "+p.Name)
+		if p.Arch != "" {
+			comments = append(comments, "arch: "+p.Arch)
+		}
+		if p.Build != "" {
+			comments = append(comments, "build: "+p.Build)
+		}
+		if p.Kind != "" {
+			comments = append(comments, "kind: "+p.Kind)
+		}
+		if p.Runtime != "" {
+			comments = append(comments, "runtime: "+p.Runtime)
+		}
+	}
+
+	for _, k := range sortx.Keys(asset.Labels) {
+		comments = append(comments, "label "+k+": "+asset.Labels[k])
+	}
+	res.Comment = strings.Join(comments, ", ")
+
+	return res
+}
+
+func spdxAssetPurpose(platform *inventory.Platform) string {
+	switch cdxAssetType(platform) {
+	case "container":
+		return "CONTAINER"
+	case "operating-system":
+		return "OPERATING-SYSTEM"
+	default:
+		return "DEVICE"
+	}
+}
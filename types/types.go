@@ -52,6 +52,8 @@ const (
 	byteFunction
 	byteStringSlice
 	byteRange
+	byteVersion
+	byteIP
 )
 
 // Empty type is one whose type information is not available at all
@@ -107,6 +109,13 @@ const (
 	// or lines and columns combined. We use a special type for a very
 	// efficient storage and transmission structure.
 	Range = Type(rune(byteRange))
+
+	// Version of software or packages. Versions are compared via the rules
+	// of their format, e.g. deb, rpm, apk, or semver.
+	Version = Type(rune(byteVersion))
+
+	// IP address or network in CIDR notation, for both IPv4 and IPv6
+	IP = Type(rune(byteIP))
 )
 
 // IsEmpty returns true if the type has no information
@@ -253,6 +262,8 @@ var labels = map[byte]string{
 	byteBlock:       "block",
 	byteStringSlice: "stringslice",
 	byteRange:       "range",
+	byteVersion:     "version",
+	byteIP:          "ip",
 }
 
 var labelfun map[byte]func(Type) string
@@ -315,3 +326,28 @@ var Equal = map[Type]func(interface{}, interface{}) bool{
 		return left.(int32) == right.(int32)
 	},
 }
+
+// Less provides a set of functions for a range of types to test if the left
+// value is smaller than the right one, which is used to sort values
+var Less = map[Type]func(interface{}, interface{}) bool{
+	Bool: func(left, right interface{}) bool {
+		return !left.(bool) && right.(bool)
+	},
+	Int: func(left, right interface{}) bool {
+		return left.(int64) < right.(int64)
+	},
+	Float: func(left, right interface{}) bool {
+		return left.(float64) < right.(float64)
+	},
+	String: func(left, right interface{}) bool {
+		return left.(string) < right.(string)
+	},
+	Time: func(left, right interface{}) bool {
+		l := left.(*time.Time)
+		r := right.(*time.Time)
+		if l == nil || r == nil {
+			return r != nil
+		}
+		return l.Before(*r)
+	},
+}
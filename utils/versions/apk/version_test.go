@@ -7,8 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-	"go.mondoo.com/cnquery/providers/core/resources/versions/apk"
-	"go.mondoo.com/cnquery/providers/core/resources/versions/generic"
+	"go.mondoo.com/cnquery/utils/versions/apk"
+	"go.mondoo.com/cnquery/utils/versions/generic"
 )
 
 const (
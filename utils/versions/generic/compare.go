@@ -4,15 +4,18 @@
 package generic
 
 import (
-	"errors"
 	"strings"
 
-	"go.mondoo.com/cnquery/providers/core/resources/versions/apk"
-	"go.mondoo.com/cnquery/providers/core/resources/versions/deb"
-	"go.mondoo.com/cnquery/providers/core/resources/versions/rpm"
-	"go.mondoo.com/cnquery/providers/core/resources/versions/semver"
+	"go.mondoo.com/cnquery/utils/versions/apk"
+	"go.mondoo.com/cnquery/utils/versions/deb"
+	"go.mondoo.com/cnquery/utils/versions/rpm"
+	"go.mondoo.com/cnquery/utils/versions/semver"
 )
 
+// Compare compares two versions via the rules of the given format, e.g.
+// deb, rpm, apk, or semver. It returns -1, 0, or 1 if a is older than, the
+// same as, or newer than b. Versions without a known format are compared as
+// semantic versions if possible and as plain strings otherwise.
 func Compare(format, a, b string) (int, error) {
 	var cmp int
 	var err error
@@ -20,21 +23,22 @@ func Compare(format, a, b string) (int, error) {
 	case "rpm":
 		var parser rpm.Parser
 		cmp, err = parser.Compare(a, b)
-	case "pacman":
-		var parser deb.Parser
-		cmp, err = parser.Compare(a, b)
-	case "deb":
+	case "deb", "pacman", "opkg":
 		var parser deb.Parser
 		cmp, err = parser.Compare(a, b)
 	case "apk":
 		var parser apk.Parser
 		// for apk versions, we need to remove the epoch, since it is the build version for alpine
 		cmp, err = parser.Compare(VersionWithoutEpoch(a), VersionWithoutEpoch(b))
-	case "npm":
+	case "npm", "semver":
 		var parser semver.Parser
 		cmp, err = parser.Compare(a, b)
 	default:
-		err = errors.New("unsupported pkg comparison for " + format)
+		// formats without their own rules, e.g. macos or windows packages
+		var parser semver.Parser
+		if cmp, err = parser.Compare(a, b); err != nil {
+			cmp, err = strings.Compare(a, b), nil
+		}
 	}
 	return cmp, err
 }
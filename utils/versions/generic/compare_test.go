@@ -0,0 +1,53 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package generic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoEpoch(t *testing.T) {
+	r := VersionWithoutEpoch("1632431095:1.2.2-r7")
+	assert.Equal(t, "1.2.2-r7", r)
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		format string
+		a      string
+		b      string
+		cmp    int
+	}{
+		{"deb", "1:1.2.3-1", "1.9", 1},
+		{"rpm", "3.0.7-16.el9", "3.0.7-2.el9", 1},
+		{"apk", "3.0.7-r0", "3.0.10-r0", -1},
+		{"semver", "1.2.3-beta", "1.2.3", -1},
+		{"npm", "2.0.0", "2.0.0", 0},
+		{"", "13.10", "13.9", 1},
+		{"", "1.2", "1.2.0", 0},
+		{"", "2023a", "2023b", -1},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.format+" "+test.a+" "+test.b, func(t *testing.T) {
+			cmp, err := Compare(test.format, test.a, test.b)
+			require.NoError(t, err)
+			assert.Equal(t, test.cmp, cmp)
+		})
+	}
+}
+
+func TestCompareUnknownFormat(t *testing.T) {
+	cmp, err := Compare("macos", "13.10", "13.9")
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmp)
+
+	cmp, err = Compare("windows", "10.0.19041", "10.0.19041")
+	require.NoError(t, err)
+	assert.Equal(t, 0, cmp)
+}